@@ -54,6 +54,34 @@ Environment variables:
   UNIFI_VERIFY_SSL  Verify SSL certificates (default: true)
   UNIFI_LOG_LEVEL   go-unifi log level: disabled|trace|debug|info|warn|error (default: "error")
   UNIFI_TOOL_MODE   Tool registration mode: lazy|eager (default: "lazy")
+  UNIFI_STALE_MAX_AGE
+                    Max age of cached results served while the controller is
+                    unreachable, e.g. 30m (default: 24h, 0 disables)
+  UNIFI_MAX_RETRIES Retries for transient read failures (default: 2, 0 disables)
+  UNIFI_RATE_LIMIT  Controller requests per second (default: 10, 0 disables)
+  UNIFI_RATE_BURST  Requests allowed in a burst above the rate (default: 10)
+  UNIFI_MAX_CONCURRENCY
+                    Max controller requests in flight (default: 4, 0 disables)
+  UNIFI_IDEMPOTENCY_WINDOW
+                    How long create results are remembered by idempotency_key,
+                    e.g. 15m (default: 1h)
+  UNIFI_RESOLVE_RULES
+                    Path to a YAML file extending the ID resolution rules
+  UNIFI_OUTPUT_FORMAT
+                    Default output of list and get tools:
+                    json|json_compact|yaml|csv|markdown (default: "json")
+  UNIFI_MAX_TOKENS  Approximate token budget of list results; longer lists
+                    return the items that fit and a summary (default: 25000,
+                    0 disables)
+  UNIFI_STORED_RESULTS
+                    List results kept per session for query_result when
+                    listed with store_result (default: 10, 0 disables)
+  UNIFI_STORED_RESULTS_MAX_MB
+                    Megabytes of stored results kept per session (default: 50)
+  UNIFI_HUMANIZE    Add readable times, durations and sizes next to raw values
+                    unless a call sets humanize (default: false)
+  UNIFI_TIMEZONE    Time zone of humanized timestamps, e.g. Europe/Berlin
+                    (default: "UTC")
 `)
 }
 
@@ -113,8 +141,27 @@ func runWith(r runner) error {
 
 	// Create MCP server
 	s, err := r.newServer(server.Options{
-		Client:   client,
-		LogLevel: cfg.LogLevel,
+		Client:      client,
+		LogLevel:    cfg.LogLevel,
+		StaleMaxAge: cfg.StaleMaxAge,
+		MaxRetries:  cfg.MaxRetries,
+		Relogin:     !cfg.UseAPIKey() && cfg.UseUserPass(),
+
+		RateLimit:      cfg.RateLimit,
+		RateBurst:      cfg.RateBurst,
+		MaxConcurrency: cfg.MaxConcurrency,
+
+		IdempotencyWindow: cfg.IdempotencyWindow,
+
+		ResolveRules: cfg.ResolveRules,
+		OutputFormat: cfg.OutputFormat,
+		MaxTokens:    cfg.MaxTokens,
+
+		StoredResults:         cfg.StoredResults,
+		StoredResultsMaxBytes: cfg.StoredResultsMaxMB << 20,
+
+		Humanize: cfg.Humanize,
+		TimeZone: cfg.TimeZone,
 	})
 	if err != nil {
 		return err
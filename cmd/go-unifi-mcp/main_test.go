@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/claytono/go-unifi-mcp/internal/config"
 	"github.com/claytono/go-unifi-mcp/internal/server"
@@ -71,6 +72,58 @@ func TestRunSuccess(t *testing.T) {
 	require.True(t, called)
 }
 
+func TestRunPassesConfigToServer(t *testing.T) {
+	r := baseRunner()
+	r.loadConfig = func() (*config.Config, error) {
+		return &config.Config{
+			LogLevel:    "debug",
+			StaleMaxAge: time.Hour,
+			MaxRetries:  4,
+			Username:    "admin",
+			Password:    "secret",
+
+			RateLimit:      2.5,
+			RateBurst:      5,
+			MaxConcurrency: 3,
+
+			IdempotencyWindow: 15 * time.Minute,
+
+			ResolveRules: "/etc/go-unifi-mcp/resolve.yaml",
+			OutputFormat: "markdown",
+			MaxTokens:    5000,
+
+			StoredResults:      4,
+			StoredResultsMaxMB: 2,
+
+			Humanize: true,
+			TimeZone: "Europe/Berlin",
+		}, nil
+	}
+	var got server.Options
+	r.newServer = func(opts server.Options) (*mcpserver.MCPServer, error) {
+		got = opts
+		return nil, nil
+	}
+
+	err := runWith(r)
+	require.NoError(t, err)
+	assert.Equal(t, "debug", got.LogLevel)
+	assert.Equal(t, time.Hour, got.StaleMaxAge)
+	assert.Equal(t, 4, got.MaxRetries)
+	assert.True(t, got.Relogin)
+	assert.InDelta(t, 2.5, got.RateLimit, 0)
+	assert.Equal(t, 5, got.RateBurst)
+	assert.Equal(t, 3, got.MaxConcurrency)
+	assert.Equal(t, 15*time.Minute, got.IdempotencyWindow)
+	assert.Equal(t, "/etc/go-unifi-mcp/resolve.yaml", got.ResolveRules)
+	assert.Equal(t, "markdown", got.OutputFormat)
+	assert.Equal(t, 5000, got.MaxTokens)
+	assert.Equal(t, 4, got.StoredResults)
+	assert.Equal(t, 2<<20, got.StoredResultsMaxBytes)
+	assert.True(t, got.Humanize)
+	assert.Equal(t, "Europe/Berlin", got.TimeZone)
+}
+
 func TestMainLogsAndExitsOnError(t *testing.T) {
 	expectedErr := errors.New("boom")
 	r := baseRunner()
@@ -139,6 +192,15 @@ func TestHelpFlag(t *testing.T) {
 	assert.Contains(t, output, "UNIFI_API_KEY")
 	assert.Contains(t, output, "UNIFI_LOG_LEVEL")
 	assert.Contains(t, output, "UNIFI_TOOL_MODE")
+	assert.Contains(t, output, "UNIFI_STALE_MAX_AGE")
+	assert.Contains(t, output, "UNIFI_MAX_RETRIES")
+	assert.Contains(t, output, "UNIFI_RATE_LIMIT")
+	assert.Contains(t, output, "UNIFI_MAX_CONCURRENCY")
+	assert.Contains(t, output, "UNIFI_IDEMPOTENCY_WINDOW")
+	assert.Contains(t, output, "UNIFI_STORED_RESULTS")
+	assert.Contains(t, output, "UNIFI_HUMANIZE")
+	assert.Contains(t, output, "UNIFI_TIMEZONE")
+	assert.Contains(t, output, "UNIFI_RESOLVE_RULES")
 }
 
 func TestUnknownFlagExitsWithCode2(t *testing.T) {
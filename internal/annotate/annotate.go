@@ -0,0 +1,131 @@
+// Package annotate collects per-call metadata from client interceptors and
+// attaches it to tool results. Interceptors record values such as staleness
+// markers on the Annotations stored in the request context; WrapHandler then
+// wraps the handler's output, structured or JSON text, in an envelope
+// carrying those values.
+package annotate
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/iancoleman/orderedmap"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DataKey is the envelope key holding the original tool output.
+const DataKey = payload.EnvelopeKey
+
+type contextKey struct{}
+
+// Annotations holds metadata recorded during a single tool call.
+// It is safe for concurrent use.
+type Annotations struct {
+	mu     sync.Mutex
+	values *orderedmap.OrderedMap
+}
+
+// NewContext returns a context carrying a fresh Annotations collector.
+func NewContext(ctx context.Context) (context.Context, *Annotations) {
+	a := &Annotations{values: orderedmap.New()}
+	return context.WithValue(ctx, contextKey{}, a), a
+}
+
+// FromContext returns the Annotations stored in ctx, or nil if there are none.
+// All methods on a nil *Annotations are no-ops.
+func FromContext(ctx context.Context) *Annotations {
+	a, _ := ctx.Value(contextKey{}).(*Annotations)
+	return a
+}
+
+// Set records a value under key, keeping the position of the first Set.
+func (a *Annotations) Set(key string, value any) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.values.Set(key, value)
+}
+
+// Get returns the value recorded under key.
+func (a *Annotations) Get(key string) (any, bool) {
+	if a == nil {
+		return nil, false
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.values.Get(key)
+}
+
+// Len returns the number of recorded values.
+func (a *Annotations) Len() int {
+	if a == nil {
+		return 0
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.values.Keys())
+}
+
+// WrapHandler decorates a tool handler so that any annotations recorded during
+// the call are returned alongside its output as
+// {"<key>": <value>, ..., "data": <original output>}.
+// Results without annotations, error results and non-JSON output are returned unchanged.
+func WrapHandler(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx, annotations := NewContext(ctx)
+
+		result, err := handler(ctx, req)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		if annotations.Len() == 0 {
+			return result, nil
+		}
+
+		// Structured output is enveloped without rendering it.
+		if v, ok := payload.FromResult(result); ok {
+			annotations.mu.Lock()
+			keys := slices.Clone(annotations.values.Keys())
+			values := make(map[string]any, len(keys))
+			for _, key := range keys {
+				values[key], _ = annotations.values.Get(key)
+			}
+			annotations.mu.Unlock()
+			return payload.NewResult(ctx, payload.Envelope(keys, values, v)), nil
+		}
+		if len(result.Content) == 0 {
+			return result, nil
+		}
+
+		textContent, ok := result.Content[0].(mcp.TextContent)
+		if !ok {
+			return result, nil
+		}
+
+		var data json.RawMessage
+		if err := json.Unmarshal([]byte(textContent.Text), &data); err != nil {
+			return result, nil
+		}
+
+		annotations.mu.Lock()
+		envelope := orderedmap.New()
+		for _, key := range annotations.values.Keys() {
+			value, _ := annotations.values.Get(key)
+			envelope.Set(key, value)
+		}
+		annotations.mu.Unlock()
+		envelope.Set(DataKey, data)
+
+		out, err := json.MarshalIndent(envelope, "", "  ")
+		if err != nil {
+			return result, nil
+		}
+		return mcp.NewToolResultText(string(out)), nil
+	}
+}
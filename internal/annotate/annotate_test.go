@@ -0,0 +1,109 @@
+package annotate
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContext_Missing(t *testing.T) {
+	a := FromContext(context.Background())
+	assert.Nil(t, a)
+
+	// Methods on a nil collector are no-ops.
+	a.Set("key", "value")
+	_, ok := a.Get("key")
+	assert.False(t, ok)
+	assert.Equal(t, 0, a.Len())
+}
+
+func TestAnnotations_SetGet(t *testing.T) {
+	ctx, a := NewContext(context.Background())
+	assert.Same(t, a, FromContext(ctx))
+
+	a.Set("first", 1)
+	a.Set("second", "two")
+	a.Set("first", 3)
+
+	v, ok := a.Get("first")
+	require.True(t, ok)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, 2, a.Len())
+}
+
+func TestWrapHandler_NoAnnotations(t *testing.T) {
+	handler := WrapHandler(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(`[{"name":"a"}]`), nil
+	})
+
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, `[{"name":"a"}]`, result.Content[0].(mcp.TextContent).Text)
+}
+
+func TestWrapHandler_Envelope(t *testing.T) {
+	handler := WrapHandler(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		FromContext(ctx).Set("stale", true)
+		FromContext(ctx).Set("note", "cached")
+		return mcp.NewToolResultText(`[{"name":"a"}]`), nil
+	})
+
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	text := result.Content[0].(mcp.TextContent).Text
+
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal([]byte(text), &envelope))
+	assert.Equal(t, true, envelope["stale"])
+	assert.Equal(t, "cached", envelope["note"])
+	assert.Equal(t, []any{map[string]any{"name": "a"}}, envelope[DataKey])
+
+	// Annotations come first, data last.
+	assert.Less(t, strings.Index(text, `"stale"`), strings.Index(text, `"note"`))
+	assert.Less(t, strings.Index(text, `"note"`), strings.Index(text, `"data"`))
+}
+
+func TestWrapHandler_StructuredEnvelope(t *testing.T) {
+	data, err := payload.FromJSON([]byte(`[{"name":"a","_id":"1"}]`))
+	require.NoError(t, err)
+	handler := payload.Render(WrapHandler(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		FromContext(ctx).Set("stale", true)
+		return payload.NewResult(ctx, data), nil
+	}))
+
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	text := result.Content[0].(mcp.TextContent).Text
+	assert.JSONEq(t, `{"stale":true,"data":[{"name":"a","_id":"1"}]}`, text)
+	assert.Less(t, strings.Index(text, `"stale"`), strings.Index(text, `"data"`))
+	assert.Less(t, strings.Index(text, `"name"`), strings.Index(text, `"_id"`))
+}
+
+func TestWrapHandler_ErrorResultUnchanged(t *testing.T) {
+	handler := WrapHandler(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		FromContext(ctx).Set("stale", true)
+		return mcp.NewToolResultError("boom"), nil
+	})
+
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, "boom", result.Content[0].(mcp.TextContent).Text)
+}
+
+func TestWrapHandler_NonJSONUnchanged(t *testing.T) {
+	handler := WrapHandler(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		FromContext(ctx).Set("stale", true)
+		return mcp.NewToolResultText("plain text"), nil
+	})
+
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "plain text", result.Content[0].(mcp.TextContent).Text)
+}
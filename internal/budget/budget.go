@@ -0,0 +1,223 @@
+// Package budget keeps list results within an approximate token budget.
+// When the items of a list would exceed the budget, only as many whole items
+// as fit are returned, and the result is annotated with what was omitted and
+// how to narrow the query, rather than letting the client cut the output off
+// mid-item.
+//
+// Token counts are estimated as one token per four characters, rounded up, of
+// the items rendered in the format of the call, so that indentation, YAML
+// keys and table headers count against the budget. The estimate is
+// deterministic. At least one item is always returned.
+package budget
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/claytono/go-unifi-mcp/internal/query"
+	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// KeyTruncated is the annotation describing a truncated result.
+const KeyTruncated = "truncated"
+
+// Limits on the hints of a truncated result.
+const (
+	maxHintFields    = 3  // fields suggested for each hint
+	maxFilterChoices = 20 // distinct values of a field worth filtering on
+)
+
+// Estimate returns the approximate number of tokens of v: one per four
+// characters of its compact JSON encoding, rounded up.
+func Estimate(v any) int {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return 0
+	}
+	return EstimateText(raw)
+}
+
+// EstimateText returns the approximate number of tokens of text.
+func EstimateText(text []byte) int {
+	return (utf8.RuneCount(text) + 3) / 4
+}
+
+// Fit returns how many of count items fit in maxTokens, given the size in
+// tokens of the first n items, which must not decrease as n grows.
+func Fit(count, maxTokens int, size func(n int) int) int {
+	return sort.Search(count+1, func(n int) bool { return size(n) > maxTokens }) - 1
+}
+
+// WrapHandler decorates a list tool handler to truncate its items to the
+// "max_tokens" argument, or to def when there is none. Zero means no limit.
+// Sizes are measured in the "format" argument, or in format when there is
+// none. It must run inside annotate.WrapHandler.
+func WrapHandler(handler server.ToolHandlerFunc, def int, format payload.Format) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		maxTokens := def
+		if arg, ok := req.GetArguments()["max_tokens"]; ok {
+			n, ok := arg.(float64)
+			if !ok || n < 0 || n != float64(int(n)) {
+				return mcp.NewToolResultError("max_tokens must be a non-negative integer"), nil
+			}
+			maxTokens = int(n)
+		}
+
+		// RenderFormat has already rejected an invalid format.
+		f := format
+		if name, ok := req.GetArguments()["format"].(string); ok {
+			f, _ = payload.ParseFormat(name)
+		}
+
+		result, err := handler(ctx, req)
+		if err != nil || maxTokens <= 0 {
+			return result, err
+		}
+		v, ok := payload.FromResult(result)
+		if !ok {
+			return result, nil
+		}
+
+		var data any
+		switch items := v.Data.(type) {
+		case []map[string]any:
+			data = truncate(ctx, req, v, items, maxTokens, f)
+		case []any:
+			data = truncate(ctx, req, v, items, maxTokens, f)
+		default:
+			return result, nil
+		}
+		if data == nil {
+			return result, nil
+		}
+		return payload.NewResult(ctx, v.WithData(data)), nil
+	}
+}
+
+// truncate returns the items of v that fit in maxTokens when rendered in
+// format, but at least one, recording the truncation and correcting the page
+// annotations. It returns nil if all items fit.
+func truncate[T any](ctx context.Context, req mcp.CallToolRequest, v *payload.Value, items []T, maxTokens int, format payload.Format) []T {
+	size := func(n int) int {
+		raw, err := v.WithData(items[:n]).Marshal(format)
+		if err != nil {
+			return 0
+		}
+		return EstimateText(raw)
+	}
+	total := size(len(items))
+	if total <= maxTokens {
+		return nil
+	}
+	n := Fit(len(items), maxTokens, size)
+	oversized := n == 0
+	n = max(n, 1)
+
+	a := annotate.FromContext(ctx)
+	if _, ok := a.Get(generated.KeyReturned); ok {
+		// The list handler has already rejected an invalid offset.
+		opts, _ := query.ParseOptions(req.GetArguments())
+		next := opts.Offset + n
+		a.Set(generated.KeyReturned, n)
+		a.Set(generated.KeyNextOffset, next)
+	}
+	a.Set(KeyTruncated, summary(items, n, total, maxTokens, oversized))
+	return items[:n]
+}
+
+// summary describes what a truncation omitted and how to narrow the query:
+// the largest fields, to leave out with fields, and fields with few distinct
+// values, to filter on. oversized reports that the first item alone exceeds
+// maxTokens.
+func summary[T any](items []T, n, total, maxTokens int, oversized bool) *payload.Value {
+	largest, filterable := fieldStats(items)
+
+	hint := fmt.Sprintf("Showing %d of %d items, which take about %d tokens in all, within max_tokens %d.",
+		n, len(items), total, maxTokens)
+	if oversized {
+		hint = fmt.Sprintf("Showing 1 of %d items, which take about %d tokens in all; the first item alone exceeds max_tokens %d.",
+			len(items), total, maxTokens)
+	}
+	if len(filterable) > 0 {
+		hint += fmt.Sprintf(" Narrow with filter or search, e.g. on %s;", strings.Join(filterable, ", "))
+	} else {
+		hint += " Narrow with filter or search;"
+	}
+	if len(largest) > 0 {
+		hint += fmt.Sprintf(" select fewer fields, the largest being %s;", strings.Join(largest, ", "))
+	} else {
+		hint += " select fewer fields;"
+	}
+	hint += " or page with limit and offset."
+
+	values := map[string]any{
+		"returned":         n,
+		"omitted":          len(items) - n,
+		"max_tokens":       maxTokens,
+		"estimated_tokens": total,
+		"hint":             hint,
+	}
+	if len(largest) > 0 {
+		values["largest_fields"] = largest
+	}
+	if len(filterable) > 0 {
+		values["filter_fields"] = filterable
+	}
+	keys := []string{"returned", "omitted", "max_tokens", "estimated_tokens", "largest_fields", "filter_fields", "hint"}
+	return payload.Ordered(keys, values)
+}
+
+// fieldStats returns the top-level fields taking the most tokens across
+// items, and those holding a few distinct strings or booleans shared by
+// several items each, most selective first.
+func fieldStats[T any](items []T) (largest, filterable []string) {
+	sizes := make(map[string]int)
+	distinct := make(map[string]map[string]bool)
+	for _, item := range items {
+		obj, ok := any(item).(map[string]any)
+		if !ok {
+			continue
+		}
+		for key, value := range obj {
+			sizes[key] += Estimate(value)
+			switch value.(type) {
+			case string, bool:
+			default:
+				continue
+			}
+			if distinct[key] == nil {
+				distinct[key] = make(map[string]bool)
+			}
+			if len(distinct[key]) <= maxFilterChoices {
+				distinct[key][fmt.Sprint(value)] = true
+			}
+		}
+	}
+
+	largest = slices.SortedFunc(maps.Keys(sizes), func(a, b string) int {
+		return cmp.Or(cmp.Compare(sizes[b], sizes[a]), cmp.Compare(a, b))
+	})
+	largest = largest[:min(maxHintFields, len(largest))]
+
+	for key, values := range distinct {
+		if n := len(values); n >= 2 && n <= maxFilterChoices && 2*n <= len(items) {
+			filterable = append(filterable, key)
+		}
+	}
+	slices.SortFunc(filterable, func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(distinct[a]), len(distinct[b])), cmp.Compare(a, b))
+	})
+	filterable = filterable[:min(maxHintFields, len(filterable))]
+	return largest, filterable
+}
@@ -0,0 +1,216 @@
+package budget
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected int
+	}{
+		{"null", nil, 1},                                   // null
+		{"empty object", map[string]any{}, 1},              // {}
+		{"short string", "ab", 1},                          // "ab"
+		{"string", "abcdefgh", 3},                          // "abcdefgh" = 10 chars
+		{"object", map[string]any{"name": "nas"}, 4},       // {"name":"nas"} = 14 chars
+		{"multi-byte", "ääää", 2},                          // 6 runes
+		{"array", []any{float64(1), float64(22), true}, 3}, // [1,22,true] = 11 chars
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Estimate(tt.value))
+			// Estimates are deterministic, whatever the map iteration order
+			assert.Equal(t, Estimate(tt.value), Estimate(tt.value))
+		})
+	}
+	assert.Equal(t, 0, EstimateText(nil))
+	assert.Equal(t, 3, EstimateText([]byte("123456789")))
+}
+
+func TestFit(t *testing.T) {
+	// 1 for the brackets, then 5 per item
+	size := func(n int) int { return 1 + 5*n }
+	for maxTokens, expected := range map[int]int{0: -1, 5: 0, 6: 1, 10: 1, 11: 2, 16: 3, 100: 3} {
+		assert.Equal(t, expected, Fit(3, maxTokens, size), "max_tokens %d", maxTokens)
+	}
+}
+
+func testDevices() []map[string]any {
+	devices := make([]map[string]any, 10)
+	for i := range devices {
+		devices[i] = map[string]any{
+			"name":       fmt.Sprintf("device-%d", i),
+			"type":       []string{"uap", "usw"}[i%2],
+			"adopted":    true,
+			"port_table": []any{map[string]any{"port_idx": float64(1), "poe_mode": "auto", "speed": float64(1000)}},
+		}
+	}
+	return devices
+}
+
+// wrap returns the budget wrapper around a list handler, inside the
+// annotation envelope and rendering as the registry applies them.
+func wrap(handler server.ToolHandlerFunc, def int) server.ToolHandlerFunc {
+	return payload.Render(annotate.WrapHandler(WrapHandler(handler, def, payload.FormatJSON)))
+}
+
+// devicesSize returns the estimate of the first n test devices rendered in f.
+func devicesSize(t *testing.T, n int, f payload.Format) int {
+	t.Helper()
+	v, err := payload.FromGo(testDevices()[:n])
+	require.NoError(t, err)
+	raw, err := v.Marshal(f)
+	require.NoError(t, err)
+	return EstimateText(raw)
+}
+
+func call(t *testing.T, handler server.ToolHandlerFunc, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	return result
+}
+
+func decode(t *testing.T, result *mcp.CallToolResult) map[string]any {
+	t.Helper()
+	require.False(t, result.IsError, result.Content)
+	var out map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out))
+	return out
+}
+
+func listDevices(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	v, err := payload.FromGo(testDevices())
+	if err != nil {
+		return nil, err
+	}
+	return payload.NewResult(ctx, v), nil
+}
+
+func TestWrapHandler_Truncates(t *testing.T) {
+	maxTokens := devicesSize(t, 3, payload.FormatJSON)
+	total := devicesSize(t, 10, payload.FormatJSON)
+	handler := wrap(listDevices, 0)
+
+	out := decode(t, call(t, handler, map[string]any{"max_tokens": float64(maxTokens)}))
+	assert.Len(t, out[annotate.DataKey], 3)
+	assert.Equal(t, map[string]any{
+		"returned":         float64(3),
+		"omitted":          float64(7),
+		"max_tokens":       float64(maxTokens),
+		"estimated_tokens": float64(total),
+		"largest_fields":   []any{"port_table", "name", "type"},
+		"filter_fields":    []any{"type"},
+		"hint": fmt.Sprintf("Showing 3 of 10 items, which take about %d tokens in all, within max_tokens %d. "+
+			"Narrow with filter or search, e.g. on type; select fewer fields, the largest being port_table, name, type; "+
+			"or page with limit and offset.", total, maxTokens),
+	}, out[KeyTruncated])
+
+	// One item less fits
+	out = decode(t, call(t, handler, map[string]any{"max_tokens": float64(maxTokens - 1)}))
+	assert.Len(t, out[annotate.DataKey], 2)
+}
+
+func TestWrapHandler_MeasuresFormat(t *testing.T) {
+	// The same budget holds more items in more compact formats.
+	maxTokens := devicesSize(t, 3, payload.FormatJSON)
+	require.Less(t, devicesSize(t, 4, payload.FormatJSONCompact), maxTokens)
+	handler := wrap(listDevices, maxTokens)
+
+	out := decode(t, call(t, handler, nil))
+	assert.Len(t, out[annotate.DataKey], 3)
+	out = decode(t, call(t, handler, map[string]any{"format": "json_compact"}))
+	assert.Greater(t, len(out[annotate.DataKey].([]any)), 3)
+
+	// The server's default format applies without the argument.
+	compact := payload.Render(annotate.WrapHandler(WrapHandler(listDevices, maxTokens, payload.FormatJSONCompact)))
+	out = decode(t, call(t, compact, nil))
+	assert.Greater(t, len(out[annotate.DataKey].([]any)), 3)
+}
+
+func TestWrapHandler_FitsOrDisabled(t *testing.T) {
+	// Everything fits
+	result := call(t, wrap(listDevices, 0), map[string]any{"max_tokens": float64(100000)})
+	assert.NotContains(t, result.Content[0].(mcp.TextContent).Text, KeyTruncated)
+
+	// The server default applies without the argument, and max_tokens: 0
+	// lifts it
+	out := decode(t, call(t, wrap(listDevices, 50), nil))
+	assert.Contains(t, out, KeyTruncated)
+	result = call(t, wrap(listDevices, 50), map[string]any{"max_tokens": float64(0)})
+	assert.NotContains(t, result.Content[0].(mcp.TextContent).Text, KeyTruncated)
+
+	// A single item too large for the budget is still returned whole
+	out = decode(t, call(t, wrap(listDevices, 0), map[string]any{"max_tokens": float64(5)}))
+	assert.Len(t, out[annotate.DataKey], 1)
+	truncated := out[KeyTruncated].(map[string]any)
+	assert.Equal(t, float64(1), truncated["returned"])
+	assert.Contains(t, truncated["hint"], "Showing 1 of 10 items")
+	assert.Contains(t, truncated["hint"], "the first item alone exceeds max_tokens 5.")
+}
+
+func TestWrapHandler_CorrectsPage(t *testing.T) {
+	handler := wrap(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		a := annotate.FromContext(ctx)
+		a.Set(generated.KeyTotal, 30)
+		a.Set(generated.KeyReturned, 10)
+		a.Set(generated.KeyNextOffset, 30)
+		return listDevices(ctx, req)
+	}, 0)
+
+	out := decode(t, call(t, handler, map[string]any{"offset": float64(20), "limit": float64(10), "max_tokens": float64(devicesSize(t, 4, payload.FormatJSON))}))
+	assert.Equal(t, float64(30), out[generated.KeyTotal])
+	assert.Equal(t, float64(4), out[generated.KeyReturned])
+	assert.Equal(t, float64(24), out[generated.KeyNextOffset])
+	assert.Len(t, out[annotate.DataKey], 4)
+}
+
+func TestWrapHandler_InvalidMaxTokens(t *testing.T) {
+	for _, arg := range []any{float64(-1), float64(1.5), "100"} {
+		calls := 0
+		handler := wrap(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			calls++
+			return listDevices(ctx, req)
+		}, 0)
+		result := call(t, handler, map[string]any{"max_tokens": arg})
+		assert.True(t, result.IsError)
+		assert.Equal(t, "max_tokens must be a non-negative integer", result.Content[0].(mcp.TextContent).Text)
+		assert.Equal(t, 0, calls)
+	}
+}
+
+func TestWrapHandler_LeavesOtherResultsAlone(t *testing.T) {
+	object := wrap(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return payload.NewResult(ctx, &payload.Value{Data: map[string]any{"name": "large"}}), nil
+	}, 1)
+	assert.Equal(t, "{\n  \"name\": \"large\"\n}", call(t, object, nil).Content[0].(mcp.TextContent).Text)
+
+	text := wrap(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(`[1, 2, 3]`), nil
+	}, 1)
+	assert.Equal(t, `[1, 2, 3]`, call(t, text, nil).Content[0].(mcp.TextContent).Text)
+
+	// Lists of plain values, e.g. from expr, are truncated too
+	names := wrap(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return payload.NewResult(ctx, &payload.Value{Data: []any{"aaaaaa", "bbbbbb", "cccccc"}}), nil
+	}, 7)
+	out := decode(t, call(t, names, nil))
+	assert.Equal(t, []any{"aaaaaa", "bbbbbb"}, out[annotate.DataKey])
+	assert.NotContains(t, out[KeyTruncated], "filter_fields")
+}
@@ -3,17 +3,68 @@ package config
 import (
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var (
 	ErrMissingHost        = errors.New("UNIFI_HOST environment variable is required")
 	ErrMissingCredentials = errors.New("either UNIFI_API_KEY or both UNIFI_USERNAME and UNIFI_PASSWORD must be set")
 	ErrInvalidLogLevel    = errors.New("UNIFI_LOG_LEVEL must be one of: disabled, trace, debug, info, warn, error")
+	ErrInvalidStaleMaxAge = errors.New("UNIFI_STALE_MAX_AGE must be a non-negative duration (e.g. 30m, 24h)")
+	ErrInvalidMaxRetries  = errors.New("UNIFI_MAX_RETRIES must be a non-negative integer")
+	ErrInvalidRateLimit   = errors.New("UNIFI_RATE_LIMIT must be a non-negative number of requests per second")
+	ErrInvalidRateBurst   = errors.New("UNIFI_RATE_BURST must be a positive integer")
+	ErrInvalidConcurrency = errors.New("UNIFI_MAX_CONCURRENCY must be a non-negative integer")
+	ErrInvalidIdempotency = errors.New("UNIFI_IDEMPOTENCY_WINDOW must be a positive duration (e.g. 15m, 1h)")
+	ErrInvalidFormat      = errors.New("UNIFI_OUTPUT_FORMAT must be one of: json, json_compact, yaml, csv, markdown")
+	ErrInvalidMaxTokens   = errors.New("UNIFI_MAX_TOKENS must be a non-negative integer")
+	ErrInvalidStored      = errors.New("UNIFI_STORED_RESULTS must be a non-negative integer")
+	ErrInvalidStoredSize  = errors.New("UNIFI_STORED_RESULTS_MAX_MB must be a positive integer")
+	ErrInvalidHumanize    = errors.New("UNIFI_HUMANIZE must be a boolean (true/false)")
+	ErrInvalidTimeZone    = errors.New("UNIFI_TIMEZONE must be an IANA time zone name (e.g. UTC, Europe/Berlin)")
 )
 
+// DefaultStaleMaxAge is how long last-known-good results may be served while
+// the controller is unreachable.
+const DefaultStaleMaxAge = 24 * time.Hour
+
+// DefaultMaxRetries is how many times transient read failures are retried.
+const DefaultMaxRetries = 2
+
+// Default client-side throttling of controller requests.
+const (
+	DefaultRateLimit      = 10.0
+	DefaultRateBurst      = 10
+	DefaultMaxConcurrency = 4
+)
+
+// DefaultIdempotencyWindow is how long create results are remembered by
+// idempotency key.
+const DefaultIdempotencyWindow = time.Hour
+
+// DefaultMaxTokens is the approximate token budget of list results, measured
+// on the rendered output, below the size at which MCP clients start rejecting
+// tool output.
+const DefaultMaxTokens = 25000
+
+// Default limits of the list results stored per client session.
+const (
+	DefaultStoredResults      = 10
+	DefaultStoredResultsMaxMB = 50
+)
+
+var validFormats = map[string]bool{
+	"json":         true,
+	"json_compact": true,
+	"yaml":         true,
+	"csv":          true,
+	"markdown":     true,
+}
+
 var validLogLevels = map[string]bool{
 	"disabled": true,
 	"trace":    true,
@@ -32,6 +83,26 @@ type Config struct {
 	Site      string // UNIFI_SITE - site name (default: "default")
 	VerifySSL bool   // UNIFI_VERIFY_SSL - verify SSL certs (default: true)
 	LogLevel  string // UNIFI_LOG_LEVEL - go-unifi log level (default: "error")
+
+	StaleMaxAge time.Duration // UNIFI_STALE_MAX_AGE - max age of stale data served when the controller is unreachable (default: 24h, 0 disables)
+	MaxRetries  int           // UNIFI_MAX_RETRIES - retries for transient read failures (default: 2, 0 disables)
+
+	RateLimit      float64 // UNIFI_RATE_LIMIT - controller requests per second (default: 10, 0 disables)
+	RateBurst      int     // UNIFI_RATE_BURST - requests allowed in a burst above the rate limit (default: 10)
+	MaxConcurrency int     // UNIFI_MAX_CONCURRENCY - max controller requests in flight (default: 4, 0 disables)
+
+	IdempotencyWindow time.Duration // UNIFI_IDEMPOTENCY_WINDOW - how long idempotency keys are remembered (default: 1h)
+
+	ResolveRules string // UNIFI_RESOLVE_RULES - path to a YAML file extending the ID resolution rules
+
+	OutputFormat string // UNIFI_OUTPUT_FORMAT - default output format of list and get tools (default: "json")
+	MaxTokens    int    // UNIFI_MAX_TOKENS - approximate token budget of list results (default: 25000, 0 disables)
+
+	StoredResults      int // UNIFI_STORED_RESULTS - list results stored per session for query_result (default: 10, 0 disables)
+	StoredResultsMaxMB int // UNIFI_STORED_RESULTS_MAX_MB - megabytes of stored results per session (default: 50)
+
+	Humanize bool   // UNIFI_HUMANIZE - add readable siblings of timestamps, durations and sizes by default (default: false)
+	TimeZone string // UNIFI_TIMEZONE - time zone of humanized timestamps (default: "UTC")
 }
 
 // Load loads configuration from environment variables.
@@ -43,6 +114,21 @@ func Load() (*Config, error) {
 		Password:  os.Getenv("UNIFI_PASSWORD"),
 		Site:      os.Getenv("UNIFI_SITE"),
 		VerifySSL: true,
+
+		StaleMaxAge: DefaultStaleMaxAge,
+		MaxRetries:  DefaultMaxRetries,
+
+		RateLimit:      DefaultRateLimit,
+		RateBurst:      DefaultRateBurst,
+		MaxConcurrency: DefaultMaxConcurrency,
+
+		IdempotencyWindow: DefaultIdempotencyWindow,
+		MaxTokens:         DefaultMaxTokens,
+
+		StoredResults:      DefaultStoredResults,
+		StoredResultsMaxMB: DefaultStoredResultsMaxMB,
+
+		ResolveRules: os.Getenv("UNIFI_RESOLVE_RULES"),
 	}
 
 	// Parse UNIFI_VERIFY_SSL
@@ -65,6 +151,83 @@ func Load() (*Config, error) {
 		cfg.LogLevel = "error"
 	}
 
+	// Parse UNIFI_STALE_MAX_AGE
+	if v := os.Getenv("UNIFI_STALE_MAX_AGE"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("%w: got %q", ErrInvalidStaleMaxAge, v)
+		}
+		cfg.StaleMaxAge = parsed
+	}
+
+	// Parse UNIFI_MAX_RETRIES
+	if err := parseInt("UNIFI_MAX_RETRIES", 0, &cfg.MaxRetries, ErrInvalidMaxRetries); err != nil {
+		return nil, err
+	}
+
+	// Parse UNIFI_RATE_LIMIT, UNIFI_RATE_BURST and UNIFI_MAX_CONCURRENCY
+	if v := os.Getenv("UNIFI_RATE_LIMIT"); v != "" {
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil || parsed < 0 || math.IsInf(parsed, 0) || math.IsNaN(parsed) {
+			return nil, fmt.Errorf("%w: got %q", ErrInvalidRateLimit, v)
+		}
+		cfg.RateLimit = parsed
+	}
+	if err := parseInt("UNIFI_RATE_BURST", 1, &cfg.RateBurst, ErrInvalidRateBurst); err != nil {
+		return nil, err
+	}
+	if err := parseInt("UNIFI_MAX_CONCURRENCY", 0, &cfg.MaxConcurrency, ErrInvalidConcurrency); err != nil {
+		return nil, err
+	}
+
+	// Parse UNIFI_IDEMPOTENCY_WINDOW
+	if v := os.Getenv("UNIFI_IDEMPOTENCY_WINDOW"); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("%w: got %q", ErrInvalidIdempotency, v)
+		}
+		cfg.IdempotencyWindow = parsed
+	}
+
+	// Parse UNIFI_OUTPUT_FORMAT
+	cfg.OutputFormat = "json"
+	if v := os.Getenv("UNIFI_OUTPUT_FORMAT"); v != "" {
+		v = strings.ToLower(v)
+		if !validFormats[v] {
+			return nil, fmt.Errorf("%w: got %q", ErrInvalidFormat, v)
+		}
+		cfg.OutputFormat = v
+	}
+
+	// Parse UNIFI_MAX_TOKENS
+	if err := parseInt("UNIFI_MAX_TOKENS", 0, &cfg.MaxTokens, ErrInvalidMaxTokens); err != nil {
+		return nil, err
+	}
+
+	// Parse UNIFI_STORED_RESULTS and UNIFI_STORED_RESULTS_MAX_MB
+	if err := parseInt("UNIFI_STORED_RESULTS", 0, &cfg.StoredResults, ErrInvalidStored); err != nil {
+		return nil, err
+	}
+	if err := parseInt("UNIFI_STORED_RESULTS_MAX_MB", 1, &cfg.StoredResultsMaxMB, ErrInvalidStoredSize); err != nil {
+		return nil, err
+	}
+
+	// Parse UNIFI_HUMANIZE and UNIFI_TIMEZONE
+	if v := os.Getenv("UNIFI_HUMANIZE"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: got %q", ErrInvalidHumanize, v)
+		}
+		cfg.Humanize = parsed
+	}
+	cfg.TimeZone = "UTC"
+	if v := os.Getenv("UNIFI_TIMEZONE"); v != "" {
+		if _, err := time.LoadLocation(v); err != nil {
+			return nil, fmt.Errorf("%w: got %q", ErrInvalidTimeZone, v)
+		}
+		cfg.TimeZone = v
+	}
+
 	// Set default site
 	if cfg.Site == "" {
 		cfg.Site = "default"
@@ -90,6 +253,21 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// parseInt reads an integer of at least minimum from the environment variable
+// key into target, leaving target unchanged when the variable is unset.
+func parseInt(key string, minimum int, target *int, errInvalid error) error {
+	v := os.Getenv(key)
+	if v == "" {
+		return nil
+	}
+	parsed, err := strconv.Atoi(v)
+	if err != nil || parsed < minimum {
+		return fmt.Errorf("%w: got %q", errInvalid, v)
+	}
+	*target = parsed
+	return nil
+}
+
 // UseAPIKey returns true if API key auth should be used.
 func (c *Config) UseAPIKey() bool {
 	return c.APIKey != ""
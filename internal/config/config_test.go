@@ -3,6 +3,7 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -143,3 +144,270 @@ func TestLoad_LogLevelInvalid(t *testing.T) {
 	assert.ErrorIs(t, err, ErrInvalidLogLevel)
 	assert.Contains(t, err.Error(), "verbose")
 }
+
+func TestLoad_StaleMaxAgeDefault(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+	t.Setenv("UNIFI_STALE_MAX_AGE", "")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultStaleMaxAge, cfg.StaleMaxAge)
+}
+
+func TestLoad_StaleMaxAgeValid(t *testing.T) {
+	for input, expected := range map[string]time.Duration{"30m": 30 * time.Minute, "0": 0, "2h45m": 165 * time.Minute} {
+		t.Run(input, func(t *testing.T) {
+			t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+			t.Setenv("UNIFI_API_KEY", "test-api-key")
+			t.Setenv("UNIFI_STALE_MAX_AGE", input)
+
+			cfg, err := Load()
+			require.NoError(t, err)
+			assert.Equal(t, expected, cfg.StaleMaxAge)
+		})
+	}
+}
+
+func TestLoad_StaleMaxAgeInvalid(t *testing.T) {
+	for _, input := range []string{"forever", "-5m", "10"} {
+		t.Run(input, func(t *testing.T) {
+			t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+			t.Setenv("UNIFI_API_KEY", "test-api-key")
+			t.Setenv("UNIFI_STALE_MAX_AGE", input)
+
+			_, err := Load()
+			assert.ErrorIs(t, err, ErrInvalidStaleMaxAge)
+			assert.Contains(t, err.Error(), input)
+		})
+	}
+}
+
+func TestLoad_MaxRetriesDefault(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+	t.Setenv("UNIFI_MAX_RETRIES", "")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMaxRetries, cfg.MaxRetries)
+}
+
+func TestLoad_MaxRetriesValid(t *testing.T) {
+	for input, expected := range map[string]int{"0": 0, "5": 5} {
+		t.Run(input, func(t *testing.T) {
+			t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+			t.Setenv("UNIFI_API_KEY", "test-api-key")
+			t.Setenv("UNIFI_MAX_RETRIES", input)
+
+			cfg, err := Load()
+			require.NoError(t, err)
+			assert.Equal(t, expected, cfg.MaxRetries)
+		})
+	}
+}
+
+func TestLoad_MaxRetriesInvalid(t *testing.T) {
+	for _, input := range []string{"many", "-1", "1.5"} {
+		t.Run(input, func(t *testing.T) {
+			t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+			t.Setenv("UNIFI_API_KEY", "test-api-key")
+			t.Setenv("UNIFI_MAX_RETRIES", input)
+
+			_, err := Load()
+			assert.ErrorIs(t, err, ErrInvalidMaxRetries)
+			assert.Contains(t, err.Error(), input)
+		})
+	}
+}
+
+func TestLoad_ThrottleDefaults(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+	t.Setenv("UNIFI_RATE_LIMIT", "")
+	t.Setenv("UNIFI_RATE_BURST", "")
+	t.Setenv("UNIFI_MAX_CONCURRENCY", "")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.InDelta(t, DefaultRateLimit, cfg.RateLimit, 0)
+	assert.Equal(t, DefaultRateBurst, cfg.RateBurst)
+	assert.Equal(t, DefaultMaxConcurrency, cfg.MaxConcurrency)
+}
+
+func TestLoad_ThrottleValid(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+	t.Setenv("UNIFI_RATE_LIMIT", "2.5")
+	t.Setenv("UNIFI_RATE_BURST", "1")
+	t.Setenv("UNIFI_MAX_CONCURRENCY", "0")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.InDelta(t, 2.5, cfg.RateLimit, 0)
+	assert.Equal(t, 1, cfg.RateBurst)
+	assert.Equal(t, 0, cfg.MaxConcurrency)
+}
+
+func TestLoad_ThrottleInvalid(t *testing.T) {
+	tests := []struct {
+		key     string
+		value   string
+		wantErr error
+	}{
+		{"UNIFI_RATE_LIMIT", "fast", ErrInvalidRateLimit},
+		{"UNIFI_RATE_LIMIT", "-1", ErrInvalidRateLimit},
+		{"UNIFI_RATE_LIMIT", "Inf", ErrInvalidRateLimit},
+		{"UNIFI_RATE_BURST", "0", ErrInvalidRateBurst},
+		{"UNIFI_RATE_BURST", "lots", ErrInvalidRateBurst},
+		{"UNIFI_MAX_CONCURRENCY", "-2", ErrInvalidConcurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+			t.Setenv("UNIFI_API_KEY", "test-api-key")
+			t.Setenv(tt.key, tt.value)
+
+			_, err := Load()
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Contains(t, err.Error(), tt.value)
+		})
+	}
+}
+
+func TestLoad_IdempotencyWindow(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+
+	t.Setenv("UNIFI_IDEMPOTENCY_WINDOW", "")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultIdempotencyWindow, cfg.IdempotencyWindow)
+
+	t.Setenv("UNIFI_IDEMPOTENCY_WINDOW", "15m")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, cfg.IdempotencyWindow)
+}
+
+func TestLoad_IdempotencyWindowInvalid(t *testing.T) {
+	for _, input := range []string{"0", "-1h", "soon"} {
+		t.Run(input, func(t *testing.T) {
+			t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+			t.Setenv("UNIFI_API_KEY", "test-api-key")
+			t.Setenv("UNIFI_IDEMPOTENCY_WINDOW", input)
+
+			_, err := Load()
+			assert.ErrorIs(t, err, ErrInvalidIdempotency)
+			assert.Contains(t, err.Error(), input)
+		})
+	}
+}
+
+func TestLoad_ResolveRules(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+	t.Setenv("UNIFI_RESOLVE_RULES", "/etc/go-unifi-mcp/resolve.yaml")
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/go-unifi-mcp/resolve.yaml", cfg.ResolveRules)
+}
+
+func TestLoad_OutputFormat(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+
+	t.Setenv("UNIFI_OUTPUT_FORMAT", "")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "json", cfg.OutputFormat)
+
+	t.Setenv("UNIFI_OUTPUT_FORMAT", "JSON_Compact")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, "json_compact", cfg.OutputFormat)
+
+	t.Setenv("UNIFI_OUTPUT_FORMAT", "xml")
+	_, err = Load()
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+	assert.Contains(t, err.Error(), "xml")
+}
+
+func TestLoad_MaxTokens(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+
+	t.Setenv("UNIFI_MAX_TOKENS", "")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMaxTokens, cfg.MaxTokens)
+
+	t.Setenv("UNIFI_MAX_TOKENS", "0")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, 0, cfg.MaxTokens)
+
+	for _, input := range []string{"-1", "many"} {
+		t.Setenv("UNIFI_MAX_TOKENS", input)
+		_, err = Load()
+		assert.ErrorIs(t, err, ErrInvalidMaxTokens)
+	}
+}
+
+func TestLoad_StoredResults(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+
+	t.Setenv("UNIFI_STORED_RESULTS", "")
+	t.Setenv("UNIFI_STORED_RESULTS_MAX_MB", "")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultStoredResults, cfg.StoredResults)
+	assert.Equal(t, DefaultStoredResultsMaxMB, cfg.StoredResultsMaxMB)
+
+	t.Setenv("UNIFI_STORED_RESULTS", "0")
+	t.Setenv("UNIFI_STORED_RESULTS_MAX_MB", "5")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, 0, cfg.StoredResults)
+	assert.Equal(t, 5, cfg.StoredResultsMaxMB)
+
+	t.Setenv("UNIFI_STORED_RESULTS", "-1")
+	_, err = Load()
+	assert.ErrorIs(t, err, ErrInvalidStored)
+
+	t.Setenv("UNIFI_STORED_RESULTS", "10")
+	t.Setenv("UNIFI_STORED_RESULTS_MAX_MB", "0")
+	_, err = Load()
+	assert.ErrorIs(t, err, ErrInvalidStoredSize)
+}
+
+func TestLoad_Humanize(t *testing.T) {
+	t.Setenv("UNIFI_HOST", "https://192.168.1.1")
+	t.Setenv("UNIFI_API_KEY", "test-api-key")
+
+	t.Setenv("UNIFI_HUMANIZE", "")
+	t.Setenv("UNIFI_TIMEZONE", "")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.False(t, cfg.Humanize)
+	assert.Equal(t, "UTC", cfg.TimeZone)
+
+	t.Setenv("UNIFI_HUMANIZE", "true")
+	t.Setenv("UNIFI_TIMEZONE", "Europe/Berlin")
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.True(t, cfg.Humanize)
+	assert.Equal(t, "Europe/Berlin", cfg.TimeZone)
+
+	t.Setenv("UNIFI_HUMANIZE", "sometimes")
+	_, err = Load()
+	assert.ErrorIs(t, err, ErrInvalidHumanize)
+
+	t.Setenv("UNIFI_HUMANIZE", "false")
+	t.Setenv("UNIFI_TIMEZONE", "Mars/Olympus_Mons")
+	_, err = Load()
+	assert.ErrorIs(t, err, ErrInvalidTimeZone)
+}
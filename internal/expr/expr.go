@@ -0,0 +1,186 @@
+// Package expr evaluates jq expressions on tool results, so that callers can
+// reshape list and get output beyond what query filtering and projection
+// offer, e.g. `[.[] | select(.purpose == "corporate") | {name, vlan}]`.
+//
+// Expressions run with gojq, without access to the environment, files or
+// modules. Each evaluation is bounded by a timeout and by limits on the
+// number and size of the values it produces, so a pathological expression
+// fails the call rather than the server.
+package expr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/itchyny/gojq"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Limits on expression evaluation.
+const (
+	MaxLength      = 4096            // bytes of expression source
+	Timeout        = 2 * time.Second // evaluation time
+	MaxOutputs     = 10000           // values produced
+	MaxOutputBytes = 8 << 20         // JSON size of the result
+)
+
+// Expr is a compiled expression.
+type Expr struct {
+	code *gojq.Code
+}
+
+// Compile parses and compiles a jq expression.
+func Compile(src string) (*Expr, error) {
+	if len(src) > MaxLength {
+		return nil, fmt.Errorf("invalid expr: longer than %d bytes", MaxLength)
+	}
+	query, err := gojq.Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expr: %w", err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expr: %w", err)
+	}
+	return &Expr{code: code}, nil
+}
+
+// Eval evaluates the expression on data. An expression producing a single
+// value returns it; one producing several returns them as an array, and one
+// producing none returns nil.
+func (e *Expr) Eval(ctx context.Context, data any) (any, error) {
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	var outputs []any
+	iter := e.code.RunWithContext(ctx, normalize(data))
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, fmt.Errorf("expr timed out after %s", Timeout)
+			}
+			var halt *gojq.HaltError
+			if errors.As(err, &halt) && halt.Value() == nil {
+				break
+			}
+			return nil, fmt.Errorf("expr error: %w", err)
+		}
+		if len(outputs) == MaxOutputs {
+			return nil, fmt.Errorf("expr produced more than %d values", MaxOutputs)
+		}
+		outputs = append(outputs, v)
+	}
+
+	var result any
+	switch len(outputs) {
+	case 0:
+	case 1:
+		result = outputs[0]
+	default:
+		result = outputs
+	}
+	if raw, err := json.Marshal(result); err != nil {
+		return nil, fmt.Errorf("expr error: %w", err)
+	} else if len(raw) > MaxOutputBytes {
+		return nil, fmt.Errorf("expr result is larger than %d bytes", MaxOutputBytes)
+	}
+	return result, nil
+}
+
+// normalize copies data into the types gojq accepts: map[string]any, []any
+// and scalars. Post-processing adds values such as []string of resolved
+// names, and lists of objects are held as []map[string]any.
+func normalize(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, x := range v {
+			out[k] = normalize(x)
+		}
+		return out
+	case []map[string]any:
+		out := make([]any, len(v))
+		for i, x := range v {
+			out[i] = normalize(x)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, x := range v {
+			out[i] = normalize(x)
+		}
+		return out
+	case []string:
+		out := make([]any, len(v))
+		for i, x := range v {
+			out[i] = x
+		}
+		return out
+	case *payload.Value:
+		if v == nil {
+			return nil
+		}
+		return normalize(v.Data)
+	case nil, bool, string, float64, int:
+		return v
+	}
+	// Other types go through JSON
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// WrapHandler decorates a list or get tool handler to evaluate the "expr"
+// argument on its result. The expression is compiled before the handler is
+// called, so an invalid expression fails without calling the controller.
+func WrapHandler(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		src, _ := req.GetArguments()["expr"].(string)
+		if src == "" {
+			return handler(ctx, req)
+		}
+		e, err := Compile(src)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		result, err := handler(ctx, req)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		v, ok := payload.FromResult(result)
+		if !ok {
+			if len(result.Content) == 0 {
+				return result, nil
+			}
+			text, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				return result, nil
+			}
+			if v, err = payload.FromJSON([]byte(text.Text)); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("expr: result is not JSON: %v", err)), nil
+			}
+		}
+
+		out, err := e.Eval(ctx, v.Data)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return payload.NewResult(ctx, v.WithData(out)), nil
+	}
+}
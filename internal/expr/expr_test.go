@@ -0,0 +1,176 @@
+package expr
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNetworks(t *testing.T) *payload.Value {
+	t.Helper()
+	v, err := payload.FromJSON([]byte(`[
+		{"_id": "n1", "name": "LAN", "purpose": "corporate", "vlan": 1},
+		{"_id": "n2", "name": "Guest", "purpose": "guest", "vlan": 20},
+		{"_id": "n3", "name": "IoT", "purpose": "corporate", "vlan": 30}
+	]`))
+	require.NoError(t, err)
+	return v
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		expected any
+	}{
+		{
+			name: "reshape",
+			expr: `[.[] | select(.purpose == "corporate") | {name, vlan}]`,
+			expected: []any{
+				map[string]any{"name": "LAN", "vlan": float64(1)},
+				map[string]any{"name": "IoT", "vlan": float64(30)},
+			},
+		},
+		{name: "single value", expr: `length`, expected: 3},
+		{name: "several values", expr: `.[].name`, expected: []any{"LAN", "Guest", "IoT"}},
+		{name: "no values", expr: `.[] | select(.vlan > 100)`, expected: nil},
+		{name: "halt", expr: `.[0].name, halt, .[1].name`, expected: "LAN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Compile(tt.expr)
+			require.NoError(t, err)
+			out, err := e.Eval(context.Background(), testNetworks(t).Data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+}
+
+func TestEval_NormalizesPostProcessedValues(t *testing.T) {
+	data := []map[string]any{{"group_ids": []any{"g1"}, "group_names": []string{"Admins"}}}
+	e, err := Compile(`.[0].group_names[0]`)
+	require.NoError(t, err)
+	out, err := e.Eval(context.Background(), data)
+	require.NoError(t, err)
+	assert.Equal(t, "Admins", out)
+}
+
+func TestEval_DoesNotModifyInput(t *testing.T) {
+	v := testNetworks(t)
+	e, err := Compile(`.[0].name = "changed" | .[0]`)
+	require.NoError(t, err)
+	_, err = e.Eval(context.Background(), v.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "LAN", v.Data.([]map[string]any)[0]["name"])
+}
+
+func TestCompile_Errors(t *testing.T) {
+	_, err := Compile(`.[] | select(`)
+	assert.ErrorContains(t, err, "invalid expr:")
+
+	_, err = Compile(`nosuchfunction(1)`)
+	assert.ErrorContains(t, err, "invalid expr: function not defined: nosuchfunction/1")
+
+	_, err = Compile(strings.Repeat(".", MaxLength+1))
+	assert.ErrorContains(t, err, "longer than 4096 bytes")
+
+	// The environment is not exposed
+	e, err := Compile(`$ENV | length`)
+	require.NoError(t, err)
+	out, err := e.Eval(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, out)
+}
+
+func TestEval_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{"runtime error", `.[0].name + 1`, "expr error:"},
+		{"error function", `error("boom")`, "expr error: error: boom"},
+		{"timeout", `def f: f; f`, "expr timed out after 2s"},
+		{"too many values", `range(20000)`, "expr produced more than 10000 values"},
+		{"too large", `[range(10000) | "` + strings.Repeat("x", 1000) + `"]`, "expr result is larger than"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Compile(tt.expr)
+			require.NoError(t, err)
+			_, err = e.Eval(context.Background(), testNetworks(t).Data)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWrapHandler(t *testing.T) {
+	var calls int
+	handler := WrapHandler(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		return payload.NewResult(ctx, testNetworks(t)), nil
+	})
+	call := func(ctx context.Context, args map[string]any) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(ctx, req)
+		require.NoError(t, err)
+		return result
+	}
+
+	// Text results are parsed and rendered in their original key order
+	result := call(context.Background(), map[string]any{"expr": `[.[] | {vlan, name}]`})
+	require.False(t, result.IsError)
+	assert.Equal(t, `[
+  {
+    "name": "LAN",
+    "vlan": 1
+  },
+  {
+    "name": "Guest",
+    "vlan": 20
+  },
+  {
+    "name": "IoT",
+    "vlan": 30
+  }
+]`, result.Content[0].(mcp.TextContent).Text)
+
+	result = call(payload.WithStructured(context.Background()), map[string]any{"expr": `.[1].name`})
+	v, ok := payload.FromResult(result)
+	require.True(t, ok)
+	assert.Equal(t, "Guest", v.Data)
+
+	// Without expr the result is passed through
+	result = call(context.Background(), map[string]any{})
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"purpose": "guest"`)
+
+	// Invalid expressions fail before the handler is called
+	calls = 0
+	result = call(context.Background(), map[string]any{"expr": `.[`})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "invalid expr")
+	assert.Equal(t, 0, calls)
+
+	result = call(context.Background(), map[string]any{"expr": `.[0] | keys | .[0] + 1`})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "expr error")
+}
+
+func TestWrapHandler_PassesErrorsThrough(t *testing.T) {
+	handler := WrapHandler(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("list error"), nil
+	})
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"expr": `.`}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, "list error", result.Content[0].(mcp.TextContent).Text)
+}
@@ -0,0 +1,35 @@
+package humanize
+
+// stateCodes names the numeric codes of fields by resource. Codes missing
+// from a table get no sibling.
+var stateCodes = map[string]map[string]map[int]string{
+	"Device": {
+		"state": {
+			0:  "disconnected",
+			1:  "connected",
+			2:  "pending adoption",
+			4:  "upgrading",
+			5:  "provisioning",
+			6:  "heartbeat missed",
+			7:  "adopting",
+			9:  "adoption failed",
+			10: "managed by other",
+			11: "isolated",
+		},
+	},
+}
+
+// addCodes adds a <key>_human sibling naming each known code in m.
+func addCodes(m map[string]any, codes map[string]map[int]string) {
+	for key, names := range codes {
+		n, ok := number(m[key])
+		if !ok || n != float64(int(n)) {
+			continue
+		}
+		name, ok := names[int(n)]
+		sibling := key + SuffixHuman
+		if _, exists := m[sibling]; ok && !exists {
+			m[sibling] = name
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Package humanize adds readable siblings to raw values in tool results,
+// which models otherwise misread: RFC 3339 times next to epoch timestamps,
+// durations next to uptimes, sizes next to byte counters and names next to
+// numeric state codes. The raw values are kept, so that filters and
+// follow-up calls keep working on them.
+//
+// Values are recognized by their key, at any depth:
+//
+//	last_seen, *_seen, *_time, *_timestamp  epoch seconds or milliseconds  <key>_at
+//	uptime, *_uptime, duration, *_duration  seconds                        <key>_human
+//	bytes, *_bytes                          bytes                          <key>_human
+//	bytes-r, *_bytes-r                      bytes per second               <key>_human
+//
+// and state codes by resource and key, on the top-level objects of a result.
+// A sibling that would replace an existing key is not added.
+package humanize
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ArgHumanize is the tool argument turning humanizing on or off.
+const ArgHumanize = "humanize"
+
+// Suffixes of the added keys.
+const (
+	SuffixTime  = "_at"
+	SuffixHuman = "_human"
+)
+
+// Humanizer adds readable siblings to tool results. A nil *Humanizer renders
+// times in UTC and humanizes only calls that ask for it.
+type Humanizer struct {
+	loc       *time.Location
+	byDefault bool
+}
+
+// New creates a Humanizer rendering times in loc, which humanizes calls
+// without a "humanize" argument when byDefault is set.
+func New(loc *time.Location, byDefault bool) *Humanizer {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &Humanizer{loc: loc, byDefault: byDefault}
+}
+
+// WrapHandler decorates a tool handler of resource to humanize its
+// structured result when the "humanize" argument, or the default, asks for
+// it.
+func (h *Humanizer) WrapHandler(handler server.ToolHandlerFunc, resource string) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		enabled := h != nil && h.byDefault
+		if arg, ok := req.GetArguments()[ArgHumanize]; ok {
+			b, ok := arg.(bool)
+			if !ok {
+				return mcp.NewToolResultError(ArgHumanize + " must be a boolean"), nil
+			}
+			enabled = b
+		}
+
+		result, err := handler(ctx, req)
+		if err != nil || !enabled {
+			return result, err
+		}
+		if v, ok := payload.FromResult(result); ok {
+			h.Value(resource, v.Data)
+		}
+		return result, nil
+	}
+}
+
+// Value humanizes data, the decoded result of a tool of resource, in place.
+func (h *Humanizer) Value(resource string, data any) {
+	loc := time.UTC
+	if h != nil {
+		loc = h.loc
+	}
+	codes := stateCodes[resource]
+	switch v := data.(type) {
+	case map[string]any:
+		addCodes(v, codes)
+	case []map[string]any:
+		for _, item := range v {
+			addCodes(item, codes)
+		}
+	case []any:
+		for _, elem := range v {
+			if item, ok := elem.(map[string]any); ok {
+				addCodes(item, codes)
+			}
+		}
+	}
+	humanizeValue(data, loc)
+}
+
+func humanizeValue(data any, loc *time.Location) {
+	switch v := data.(type) {
+	case map[string]any:
+		humanizeMap(v, loc)
+	case []map[string]any:
+		for _, item := range v {
+			humanizeMap(item, loc)
+		}
+	case []any:
+		for _, elem := range v {
+			humanizeValue(elem, loc)
+		}
+	}
+}
+
+// humanizeMap adds the siblings of the values of m and recurses into nested
+// objects and arrays.
+func humanizeMap(m map[string]any, loc *time.Location) {
+	// Collect first: adding keys while ranging over m could visit them.
+	added := make(map[string]any)
+	for key, value := range m {
+		humanizeValue(value, loc)
+		n, ok := number(value)
+		if !ok {
+			continue
+		}
+		var sibling string
+		var text string
+		switch {
+		case isTimestampKey(key):
+			sibling, text = key+SuffixTime, formatTimestamp(n, loc)
+		case isDurationKey(key):
+			sibling, text = key+SuffixHuman, formatDuration(n)
+		case isByteRateKey(key):
+			sibling, text = key+SuffixHuman, formatBytes(n)+"/s"
+		case isBytesKey(key):
+			sibling, text = key+SuffixHuman, formatBytes(n)
+		}
+		if text == "" {
+			continue
+		}
+		if _, exists := m[sibling]; !exists {
+			added[sibling] = text
+		}
+	}
+	for key, value := range added {
+		m[key] = value
+	}
+}
+
+// number returns a non-negative numeric value.
+func number(v any) (float64, bool) {
+	var n float64
+	switch v := v.(type) {
+	case float64:
+		n = v
+	case int:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	default:
+		return 0, false
+	}
+	if n < 0 || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, false
+	}
+	return n, true
+}
+
+func isTimestampKey(key string) bool {
+	return key == "last_seen" || key == "time" || key == "timestamp" ||
+		hasAnySuffix(key, "_seen", "_time", "_timestamp")
+}
+
+func isDurationKey(key string) bool {
+	return key == "uptime" || key == "duration" || hasAnySuffix(key, "_uptime", "_duration")
+}
+
+func isBytesKey(key string) bool {
+	return key == "bytes" || strings.HasSuffix(key, "_bytes")
+}
+
+func isByteRateKey(key string) bool {
+	return key == "bytes-r" || strings.HasSuffix(key, "_bytes-r")
+}
+
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Epoch timestamps between 2001 and 2286, in seconds or milliseconds. Other
+// values of timestamp keys, such as zero for never, get no sibling.
+const (
+	minEpochSeconds = 1e9
+	maxEpochSeconds = 1e10
+)
+
+// formatTimestamp renders epoch seconds or milliseconds as RFC 3339 in loc,
+// or "" if n is not a plausible timestamp.
+func formatTimestamp(n float64, loc *time.Location) string {
+	var t time.Time
+	switch {
+	case n >= minEpochSeconds && n < maxEpochSeconds:
+		t = time.Unix(int64(n), 0)
+	case n >= minEpochSeconds*1000 && n < maxEpochSeconds*1000:
+		t = time.UnixMilli(int64(n))
+	default:
+		return ""
+	}
+	return t.In(loc).Format(time.RFC3339)
+}
+
+// formatDuration renders seconds in their two largest units, e.g. "3d 4h",
+// "4h 12m" or "45s". A zero second unit is left out: "3d", not "3d 0h".
+func formatDuration(n float64) string {
+	rest := int64(n)
+	if rest == 0 {
+		return "0s"
+	}
+	var parts []string
+	for _, u := range durationUnits {
+		count := rest / u.seconds
+		rest %= u.seconds
+		switch {
+		case len(parts) == 0 && count == 0:
+			continue
+		case count > 0:
+			parts = append(parts, fmt.Sprintf("%d%s", count, u.name))
+		}
+		if len(parts) == 2 || len(parts) == 1 && count == 0 {
+			break
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+var durationUnits = []struct {
+	name    string
+	seconds int64
+}{{"d", 86400}, {"h", 3600}, {"m", 60}, {"s", 1}}
+
+// formatBytes renders a byte count in binary units, e.g. "512 B" or
+// "1.5 GiB".
+func formatBytes(n float64) string {
+	if n < 1024 {
+		return fmt.Sprintf("%d B", int64(n))
+	}
+	units := []string{"KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	i := -1
+	for n >= 1024 && i < len(units)-1 {
+		n /= 1024
+		i++
+	}
+	return fmt.Sprintf("%.1f %s", n, units[i])
+}
@@ -0,0 +1,172 @@
+package humanize
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatTimestamp(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	assert.Equal(t, "2024-01-15T10:30:00Z", formatTimestamp(1705314600, time.UTC))
+	assert.Equal(t, "2024-01-15T10:30:00Z", formatTimestamp(1705314600000, time.UTC))
+	assert.Equal(t, "2024-01-15T11:30:00+01:00", formatTimestamp(1705314600, berlin))
+
+	// Zero for never, counters and durations are no timestamps
+	for _, n := range []float64{0, 3600, 1e10, 1e13} {
+		assert.Empty(t, formatTimestamp(n, time.UTC), n)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[float64]string{
+		0:       "0s",
+		45:      "45s",
+		60:      "1m",
+		754:     "12m 34s",
+		15120:   "4h 12m",
+		273600:  "3d 4h",
+		273900:  "3d 4h",
+		259500:  "3d",
+		86400.7: "1d",
+	}
+	for seconds, expected := range tests {
+		assert.Equal(t, expected, formatDuration(seconds), seconds)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := map[float64]string{
+		0:                "0 B",
+		512:              "512 B",
+		1024:             "1.0 KiB",
+		1536:             "1.5 KiB",
+		5 << 20:          "5.0 MiB",
+		1.5 * (1 << 30):  "1.5 GiB",
+		3 * (1 << 40):    "3.0 TiB",
+		2048 * (1 << 60): "2048.0 EiB",
+	}
+	for n, expected := range tests {
+		assert.Equal(t, expected, formatBytes(n), n)
+	}
+}
+
+func TestValue(t *testing.T) {
+	data := []map[string]any{{
+		"name":      "ap",
+		"state":     float64(1),
+		"last_seen": float64(1705314600),
+		"uptime":    float64(273600),
+		"rx_bytes":  float64(1.5 * (1 << 30)),
+		"bytes-r":   float64(2048),
+		"port_table": []any{
+			map[string]any{"port_idx": float64(1), "tx_bytes": float64(512), "state": float64(1)},
+		},
+		"adopted":    true,
+		"cfgversion": "1705314600",
+	}}
+	New(nil, false).Value("Device", data)
+
+	assert.Equal(t, map[string]any{
+		"name":           "ap",
+		"state":          float64(1),
+		"state_human":    "connected",
+		"last_seen":      float64(1705314600),
+		"last_seen_at":   "2024-01-15T10:30:00Z",
+		"uptime":         float64(273600),
+		"uptime_human":   "3d 4h",
+		"rx_bytes":       float64(1.5 * (1 << 30)),
+		"rx_bytes_human": "1.5 GiB",
+		"bytes-r":        float64(2048),
+		"bytes-r_human":  "2.0 KiB/s",
+		"port_table": []any{
+			// State codes are named on top-level objects only
+			map[string]any{"port_idx": float64(1), "tx_bytes": float64(512), "tx_bytes_human": "512 B", "state": float64(1)},
+		},
+		"adopted":    true,
+		"cfgversion": "1705314600",
+	}, data[0])
+
+	// Existing keys are kept, and state codes belong to their resource
+	user := map[string]any{"last_seen": float64(1705314600), "last_seen_at": "yesterday", "state": float64(1)}
+	New(nil, false).Value("User", user)
+	assert.Equal(t, map[string]any{"last_seen": float64(1705314600), "last_seen_at": "yesterday", "state": float64(1)}, user)
+
+	// Unknown codes get no name
+	device := map[string]any{"state": float64(3)}
+	New(nil, false).Value("Device", device)
+	assert.NotContains(t, device, "state_human")
+}
+
+func call(t *testing.T, handler server.ToolHandlerFunc, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	return result
+}
+
+func getClient(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	v, err := payload.FromJSON([]byte(`{"name":"nas","last_seen":1705314600,"uptime":60}`))
+	if err != nil {
+		return nil, err
+	}
+	return payload.NewResult(ctx, v), nil
+}
+
+func TestWrapHandler(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		humanizer *Humanizer
+		args      map[string]any
+		expected  string
+	}{
+		{"off by default", nil, nil, `{"name":"nas","last_seen":1705314600,"uptime":60}`},
+		{"on request", nil, map[string]any{"humanize": true},
+			`{"name":"nas","last_seen":1705314600,"last_seen_at":"2024-01-15T10:30:00Z","uptime":60,"uptime_human":"1m"}`},
+		{"server default", New(tokyo, true), nil,
+			`{"name":"nas","last_seen":1705314600,"last_seen_at":"2024-01-15T19:30:00+09:00","uptime":60,"uptime_human":"1m"}`},
+		{"turned off", New(tokyo, true), map[string]any{"humanize": false}, `{"name":"nas","last_seen":1705314600,"uptime":60}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := payload.Render(tt.humanizer.WrapHandler(getClient, "User"))
+			result := call(t, handler, tt.args)
+			require.False(t, result.IsError)
+			assert.JSONEq(t, tt.expected, result.Content[0].(mcp.TextContent).Text)
+		})
+	}
+}
+
+func TestWrapHandler_InvalidArgument(t *testing.T) {
+	calls := 0
+	handler := New(nil, false).WrapHandler(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		return getClient(ctx, req)
+	}, "User")
+
+	result := call(t, handler, map[string]any{"humanize": "yes"})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "humanize must be a boolean", result.Content[0].(mcp.TextContent).Text)
+	assert.Equal(t, 0, calls)
+}
+
+func TestWrapHandler_LeavesTextAlone(t *testing.T) {
+	handler := New(nil, true).WrapHandler(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(`{"uptime": 60}`), nil
+	}, "User")
+	assert.Equal(t, `{"uptime": 60}`, call(t, handler, nil).Content[0].(mcp.TextContent).Text)
+}
@@ -0,0 +1,149 @@
+// Package idempotency lets create tools be retried safely. A create call that
+// carries an idempotency_key is remembered for a configurable window; repeating
+// the call with the same key and arguments returns the original result instead
+// of creating a duplicate object.
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ArgKey is the tool argument carrying the idempotency key.
+const ArgKey = "idempotency_key"
+
+// KeyReplayed is the annotation set when a result is replayed from the store.
+const KeyReplayed = "idempotent_replay"
+
+// entry tracks one key. result is nil while the first call is in flight;
+// done is closed once it finishes.
+type entry struct {
+	fingerprint string
+	result      *mcp.CallToolResult
+	at          time.Time
+	done        chan struct{}
+}
+
+// Store remembers the results of create calls by idempotency key.
+// It is safe for concurrent use.
+type Store struct {
+	mu      sync.Mutex
+	window  time.Duration
+	entries map[string]*entry
+	now     func() time.Time
+}
+
+// NewStore creates a Store that remembers results for window.
+func NewStore(window time.Duration) *Store {
+	return &Store{
+		window:  window,
+		entries: make(map[string]*entry),
+		now:     time.Now,
+	}
+}
+
+// WrapHandler decorates a create tool handler with idempotency key handling.
+// Calls without a key pass through unchanged, as do all calls when s is nil.
+func (s *Store) WrapHandler(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	if s == nil {
+		return handler
+	}
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := req.GetArguments()
+		key, _ := args[ArgKey].(string)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		site, _ := args["site"].(string)
+		if site == "" {
+			site = "default"
+		}
+		storeKey := req.Params.Name + "\x00" + site + "\x00" + key
+		fingerprint, err := fingerprintArgs(args)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to process %s: %v", ArgKey, err)), nil
+		}
+
+		for {
+			s.mu.Lock()
+			s.evictExpired()
+			e, ok := s.entries[storeKey]
+			if !ok {
+				e = &entry{fingerprint: fingerprint, done: make(chan struct{})}
+				s.entries[storeKey] = e
+				s.mu.Unlock()
+				return s.run(ctx, req, handler, storeKey, e)
+			}
+			s.mu.Unlock()
+
+			if e.fingerprint != fingerprint {
+				return mcp.NewToolResultError(fmt.Sprintf("%s %q was already used with different arguments", ArgKey, key)), nil
+			}
+
+			// Wait for a concurrent call with the same key, then look again:
+			// it either left a result to replay or failed and released the key.
+			select {
+			case <-e.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			s.mu.Lock()
+			result := e.result
+			s.mu.Unlock()
+			if result != nil {
+				annotate.FromContext(ctx).Set(KeyReplayed, true)
+				return result, nil
+			}
+		}
+	}
+}
+
+// run executes the first call for a key and records its result. Failed calls
+// release the key so that they can be retried.
+func (s *Store) run(ctx context.Context, req mcp.CallToolRequest, handler server.ToolHandlerFunc, storeKey string, e *entry) (*mcp.CallToolResult, error) {
+	result, err := handler(ctx, req)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer close(e.done)
+	if err != nil || result == nil || result.IsError {
+		delete(s.entries, storeKey)
+		return result, err
+	}
+	e.result = result
+	e.at = s.now()
+	return result, nil
+}
+
+// evictExpired drops completed entries older than the window. Callers must
+// hold s.mu.
+func (s *Store) evictExpired() {
+	now := s.now()
+	for key, e := range s.entries {
+		if e.result != nil && now.Sub(e.at) > s.window {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// fingerprintArgs returns a canonical encoding of the call arguments, excluding
+// the key itself. encoding/json sorts map keys, so equal arguments always
+// produce the same fingerprint.
+func fingerprintArgs(args map[string]any) (string, error) {
+	rest := maps.Clone(args)
+	delete(rest, ArgKey)
+	data, err := json.Marshal(rest)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
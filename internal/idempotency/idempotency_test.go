@@ -0,0 +1,228 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingHandler creates a new object on every call.
+func countingHandler(calls *atomic.Int32) server.ToolHandlerFunc {
+	return func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		n := calls.Add(1)
+		return mcp.NewToolResultText(fmt.Sprintf(`{"_id":"pf%d"}`, n)), nil
+	}
+}
+
+func createRequest(args map[string]any) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "create_port_forward"
+	req.Params.Arguments = args
+	return req
+}
+
+func resultText(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+	require.NotNil(t, result)
+	require.NotEmpty(t, result.Content)
+	return result.Content[0].(mcp.TextContent).Text
+}
+
+func TestWrapHandler_ReplaysResult(t *testing.T) {
+	var calls atomic.Int32
+	handler := NewStore(time.Hour).WrapHandler(countingHandler(&calls))
+	args := map[string]any{"name": "ssh", "idempotency_key": "k1"}
+
+	first, err := handler(context.Background(), createRequest(args))
+	require.NoError(t, err)
+	assert.Equal(t, `{"_id":"pf1"}`, resultText(t, first))
+
+	ctx, annotations := annotate.NewContext(context.Background())
+	second, err := handler(ctx, createRequest(args))
+	require.NoError(t, err)
+	assert.Equal(t, `{"_id":"pf1"}`, resultText(t, second))
+	assert.Equal(t, int32(1), calls.Load())
+
+	replayed, _ := annotations.Get(KeyReplayed)
+	assert.Equal(t, true, replayed)
+}
+
+func TestWrapHandler_WithoutKey(t *testing.T) {
+	var calls atomic.Int32
+	handler := NewStore(time.Hour).WrapHandler(countingHandler(&calls))
+
+	for range 2 {
+		_, err := handler(context.Background(), createRequest(map[string]any{"name": "ssh"}))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestWrapHandler_NilStore(t *testing.T) {
+	var calls atomic.Int32
+	var store *Store
+	handler := store.WrapHandler(countingHandler(&calls))
+
+	for range 2 {
+		_, err := handler(context.Background(), createRequest(map[string]any{"name": "ssh", "idempotency_key": "k1"}))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestWrapHandler_KeysAreScoped(t *testing.T) {
+	var calls atomic.Int32
+	handler := NewStore(time.Hour).WrapHandler(countingHandler(&calls))
+
+	_, err := handler(context.Background(), createRequest(map[string]any{"name": "ssh", "idempotency_key": "k1"}))
+	require.NoError(t, err)
+
+	// Same key on another site.
+	_, err = handler(context.Background(), createRequest(map[string]any{"name": "ssh", "site": "branch", "idempotency_key": "k1"}))
+	require.NoError(t, err)
+
+	// Same key on another tool.
+	req := createRequest(map[string]any{"name": "ssh", "idempotency_key": "k1"})
+	req.Params.Name = "create_network"
+	_, err = handler(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestWrapHandler_DifferentArguments(t *testing.T) {
+	var calls atomic.Int32
+	handler := NewStore(time.Hour).WrapHandler(countingHandler(&calls))
+
+	_, err := handler(context.Background(), createRequest(map[string]any{"name": "ssh", "idempotency_key": "k1"}))
+	require.NoError(t, err)
+
+	result, err := handler(context.Background(), createRequest(map[string]any{"name": "http", "idempotency_key": "k1"}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), `idempotency_key "k1" was already used with different arguments`)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestWrapHandler_FailureReleasesKey(t *testing.T) {
+	var calls atomic.Int32
+	handler := NewStore(time.Hour).WrapHandler(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if calls.Add(1) == 1 {
+			return mcp.NewToolResultError("controller timeout"), nil
+		}
+		return mcp.NewToolResultText(`{"_id":"pf1"}`), nil
+	})
+	args := map[string]any{"name": "ssh", "idempotency_key": "k1"}
+
+	first, err := handler(context.Background(), createRequest(args))
+	require.NoError(t, err)
+	assert.True(t, first.IsError)
+
+	second, err := handler(context.Background(), createRequest(args))
+	require.NoError(t, err)
+	assert.False(t, second.IsError)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestWrapHandler_Expiry(t *testing.T) {
+	var calls atomic.Int32
+	store := NewStore(time.Minute)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	handler := store.WrapHandler(countingHandler(&calls))
+	args := map[string]any{"name": "ssh", "idempotency_key": "k1"}
+
+	_, err := handler(context.Background(), createRequest(args))
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+	result, err := handler(context.Background(), createRequest(args))
+	require.NoError(t, err)
+	assert.Equal(t, `{"_id":"pf2"}`, resultText(t, result))
+	assert.Len(t, store.entries, 1)
+}
+
+func TestWrapHandler_ConcurrentDuplicatesWait(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	started := make(chan struct{})
+	handler := NewStore(time.Hour).WrapHandler(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls.Add(1)
+		close(started)
+		<-release
+		return mcp.NewToolResultText(`{"_id":"pf1"}`), nil
+	})
+	args := map[string]any{"name": "ssh", "idempotency_key": "k1"}
+
+	var wg sync.WaitGroup
+	results := make([]string, 2)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		result, err := handler(context.Background(), createRequest(args))
+		assert.NoError(t, err)
+		results[0] = resultText(t, result)
+	}()
+	<-started
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		result, err := handler(context.Background(), createRequest(args))
+		assert.NoError(t, err)
+		results[1] = resultText(t, result)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, results[0], results[1])
+}
+
+func TestWrapHandler_WaitCanceled(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	handler := NewStore(time.Hour).WrapHandler(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		close(started)
+		<-release
+		return mcp.NewToolResultText(`{"_id":"pf1"}`), nil
+	})
+	args := map[string]any{"name": "ssh", "idempotency_key": "k1"}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = handler(context.Background(), createRequest(args))
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := handler(ctx, createRequest(args))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	<-done
+}
+
+func TestFingerprintArgs(t *testing.T) {
+	a, err := fingerprintArgs(map[string]any{"name": "ssh", "port": 22, "idempotency_key": "k1"})
+	require.NoError(t, err)
+	b, err := fingerprintArgs(map[string]any{"port": 22, "name": "ssh", "idempotency_key": "k2"})
+	require.NoError(t, err)
+	assert.Equal(t, a, b)
+
+	c, err := fingerprintArgs(map[string]any{"name": "ssh", "port": 2222})
+	require.NoError(t, err)
+	assert.NotEqual(t, a, c)
+}
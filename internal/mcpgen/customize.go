@@ -0,0 +1,43 @@
+package mcpgen
+
+import (
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed customizations.yml
+var customizationsYml []byte
+
+// Customizations adjusts the generated tools of resources beyond what their
+// field definitions describe.
+type Customizations struct {
+	Resources map[string]ResourceCustomization `yaml:"resources"`
+}
+
+// ResourceCustomization adjusts the tools of one resource.
+type ResourceCustomization struct {
+	// SummaryFields are the fields of the "summary" view of list and get
+	// tools, after _id.
+	SummaryFields []string `yaml:"summaryFields"`
+}
+
+// loadCustomizations parses the embedded customizations.yml.
+func loadCustomizations() (*Customizations, error) {
+	var c Customizations
+	if err := yaml.Unmarshal(customizationsYml, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse customizations.yml: %w", err)
+	}
+	return &c, nil
+}
+
+// summaryFields returns the fields of the "summary" view of a resource,
+// starting with _id, or nil if it has none.
+func (c *Customizations) summaryFields(resource string) []string {
+	fields := c.Resources[resource].SummaryFields
+	if len(fields) == 0 {
+		return nil
+	}
+	return append([]string{"_id"}, fields...)
+}
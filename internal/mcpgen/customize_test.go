@@ -0,0 +1,27 @@
+package mcpgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCustomizations(t *testing.T) {
+	c, err := loadCustomizations()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"_id", "name", "purpose", "vlan", "ip_subnet", "enabled"}, c.summaryFields("Network"))
+	assert.Equal(t, "_id", c.summaryFields("Device")[0])
+	assert.Nil(t, c.summaryFields("NoSuchResource"))
+
+	// Field names are listed once each
+	for resource, rc := range c.Resources {
+		seen := make(map[string]bool)
+		for _, field := range rc.SummaryFields {
+			assert.False(t, seen[field], "%s lists %s twice", resource, field)
+			assert.NotEqual(t, "_id", field, "%s lists _id, which every summary starts with", resource)
+			seen[field] = true
+		}
+	}
+}
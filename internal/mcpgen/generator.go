@@ -8,11 +8,13 @@ import (
 	"go/format"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"text/template"
 
 	"github.com/claytono/go-unifi-mcp/internal/gounifi"
+	"github.com/claytono/go-unifi-mcp/internal/resolve/refs"
 	"github.com/iancoleman/strcase"
 )
 
@@ -30,6 +32,8 @@ type FieldSchema struct {
 	IsArray     bool     // Whether this is an array type
 	ItemType    string   // Type of array items if IsArray
 	Required    bool     // Whether field is required (non-omitempty)
+	Reference   string   // Resource referenced by an _id/_ids field, if any
+	NameInput   string   // Argument taking the referenced name(s) in place of the ID(s)
 }
 
 // ToolInfo contains metadata about a tool to be generated.
@@ -40,6 +44,20 @@ type ToolInfo struct {
 	IsSetting  bool
 	IsV2       bool
 	Fields     []FieldSchema // Field schemas for create/update operations
+
+	SummaryFields []string // Fields of the "summary" view, if any
+}
+
+// ReferenceFields returns the fields that accept the referenced object's name
+// in place of its ID.
+func (t ToolInfo) ReferenceFields() []FieldSchema {
+	var fields []FieldSchema
+	for _, f := range t.Fields {
+		if f.NameInput != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
 }
 
 // GeneratorConfig holds configuration for the generator.
@@ -56,6 +74,10 @@ func Generate(cfg GeneratorConfig) error {
 	if err != nil {
 		return fmt.Errorf("failed to create customizer: %w", err)
 	}
+	customizations, err := loadCustomizations()
+	if err != nil {
+		return err
+	}
 
 	// Find the versioned fields directory (e.g., .tmp/fields/v9.0.114)
 	fieldsDir, err := findFieldsDir(cfg.FieldsDir)
@@ -93,6 +115,8 @@ func Generate(cfg GeneratorConfig) error {
 			IsV2:       r.IsV2(),
 			Operations: InferOperations(r),
 			Fields:     extractFieldSchemas(r),
+
+			SummaryFields: customizations.summaryFields(r.StructName),
 		}
 		tools = append(tools, tool)
 	}
@@ -101,6 +125,7 @@ func Generate(cfg GeneratorConfig) error {
 	sort.Slice(tools, func(i, j int) bool {
 		return tools[i].Name < tools[j].Name
 	})
+	markReferences(tools)
 
 	// Ensure output directory exists
 	if err := os.MkdirAll(cfg.OutDir, 0755); err != nil {
@@ -120,6 +145,10 @@ func Generate(cfg GeneratorConfig) error {
 		return fmt.Errorf("failed to render types template: %w", err)
 	}
 
+	if err := renderTemplate("templates/client.go.tmpl", filepath.Join(cfg.OutDir, "client.gen.go"), tools); err != nil {
+		return fmt.Errorf("failed to render client template: %w", err)
+	}
+
 	return nil
 }
 
@@ -158,6 +187,7 @@ func renderTemplate(templatePath, outputPath string, data interface{}) error {
 		},
 		"fieldProperty":  fieldPropertyFunc,
 		"enumFilterHint": enumFilterHintFunc,
+		"join":           strings.Join,
 	}
 
 	tmpl, err := template.New(filepath.Base(templatePath)).Funcs(funcMap).Parse(string(content))
@@ -219,6 +249,40 @@ func extractFieldSchemas(r *gounifi.Resource) []FieldSchema {
 	return schemas
 }
 
+// markReferences records the resource referenced by each ID field, following
+// the resolver's built-in rules, along with the _name/_names argument that
+// create, update and upsert tools accept in its place. Only resources with a
+// list tool can be resolved.
+func markReferences(tools []ToolInfo) {
+	resources := make(map[string]string)
+	for _, tool := range tools {
+		if slices.Contains(tool.Operations, "List") {
+			resources[strings.ToLower(tool.Name)] = tool.Name
+		}
+	}
+
+	tables := refs.Defaults(resources)
+	for _, tool := range tools {
+		names := make(map[string]bool, len(tool.Fields))
+		for _, f := range tool.Fields {
+			names[f.Name] = true
+		}
+		for i, f := range tool.Fields {
+			m := tables.Match(f.Name)
+			if m.Rule != refs.RuleOverride && m.Rule != refs.RuleConvention || m.NameField == "" || names[m.NameField] {
+				continue
+			}
+			isID := f.Type == "string" && !m.List
+			isIDs := f.Type == "array" && f.ItemType == "string" && m.List
+			if !isID && !isIDs {
+				continue
+			}
+			tool.Fields[i].Reference = m.Resource
+			tool.Fields[i].NameInput = m.NameField
+		}
+	}
+}
+
 // convertFieldToSchema converts a gounifi FieldInfo to a FieldSchema.
 func convertFieldToSchema(f *gounifi.FieldInfo) FieldSchema {
 	schema := FieldSchema{
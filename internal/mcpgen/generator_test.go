@@ -204,7 +204,8 @@ func TestGenerate_WithMockFields(t *testing.T) {
 	mockFieldJSON := `{
 		"name": ".{1,256}",
 		"enabled": "true|false",
-		"vlan_id": "^[0-9]{1,4}$"
+		"vlan_id": "^[0-9]{1,4}$",
+		"networkconf_id": ""
 	}`
 	require.NoError(t, os.WriteFile(
 		filepath.Join(fieldsDir, "Network.json"),
@@ -235,17 +236,46 @@ func TestGenerate_WithMockFields(t *testing.T) {
 	_, err = os.Stat(filepath.Join(outDir, "types.gen.go"))
 	assert.NoError(t, err, "types.gen.go should exist")
 
+	// Verify the intercepted client wraps the mock resource's methods
+	clientContent, err := os.ReadFile(filepath.Join(outDir, "client.gen.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(clientContent), "func (c *InterceptedClient) ListNetwork(ctx context.Context, site string) ([]unifi.Network, error)")
+	assert.Contains(t, string(clientContent), `Category: "delete"`)
+
 	// Verify generated code compiles by checking it has expected content
 	handlersContent, err := os.ReadFile(filepath.Join(outDir, "handlers.gen.go"))
 	require.NoError(t, err)
 	assert.Contains(t, string(handlersContent), "package generated")
 	assert.Contains(t, string(handlersContent), "Network") // Our mock resource
+	assert.Contains(t, string(handlersContent), `return TypedUpsert(client, unifi.Client.ListNetwork, unifi.Client.GetNetwork, unifi.Client.CreateNetwork, unifi.Client.UpdateNetwork)`)
+	assert.Contains(t, string(handlersContent), `return TypedDelete(client, unifi.Client.DeleteNetwork)`)
+	assert.NotContains(t, string(handlersContent), "GenericList")
 
 	// Verify metadata.gen.go has expected content
 	metadataContent, err := os.ReadFile(filepath.Join(outDir, "metadata.gen.go"))
 	require.NoError(t, err)
 	assert.Contains(t, string(metadataContent), "Network")
 	assert.Contains(t, string(metadataContent), "AllToolMetadata")
+	assert.Contains(t, string(metadataContent), `Name:        "upsert_network"`)
+	assert.Contains(t, string(metadataContent), `"match_on": map[string]any{`)
+	assert.Contains(t, string(metadataContent), `"idempotency_key": map[string]any{`)
+	assert.Contains(t, string(metadataContent), `"sort": map[string]any{`)
+	assert.Contains(t, string(metadataContent), `"expr": map[string]any{`)
+	assert.Contains(t, string(metadataContent), `"format": map[string]any{`)
+	assert.Contains(t, string(metadataContent), `"max_tokens": map[string]any{`)
+	assert.Contains(t, string(metadataContent), `"store_result": map[string]any{`)
+	assert.Contains(t, string(metadataContent), `"humanize": map[string]any{`)
+	assert.Contains(t, string(metadataContent), `SummaryFields: []string{"_id", "name", "purpose", "vlan", "ip_subnet", "enabled"},`,
+		"customized resources should embed their summary fields")
+	assert.Contains(t, string(metadataContent), `summary for the key fields of each Network (_id, name, purpose, vlan, ip_subnet, enabled)`)
+	assert.Contains(t, string(metadataContent), `an unknown top-level field is an error listing the valid ones`,
+		"get tools should accept fields")
+	assert.Contains(t, string(metadataContent), `{\"$or\": [filter, ...]}`,
+		"filter description should document boolean groups")
+	assert.Contains(t, string(metadataContent), `"description": "Select the Network by name instead of id",`)
+	assert.Contains(t, string(metadataContent), `"description": "Name of the Network for networkconf_id, in place of its ID",`,
+		"write tools should accept names for ID reference fields")
+	assert.Contains(t, string(metadataContent), `"networkconf_name": "networkconf_id",`)
 
 	// Verify list tool descriptions include enum filter hints where applicable
 	// V2 resources (DNSRecord, FirewallZonePolicy) have enum fields that should appear as hints
@@ -1015,6 +1045,29 @@ func TestRenderTemplate_FormatError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestMarkReferences(t *testing.T) {
+	tools := []ToolInfo{
+		{Name: "Network", Operations: []string{"List", "Create"}},
+		{Name: "UserGroup", Operations: []string{"Create"}},
+		{Name: "User", Operations: []string{"List", "Create"}, Fields: []FieldSchema{
+			{Name: "network_id", Type: "string"},
+			{Name: "excluded_networkconf_ids", Type: "array", ItemType: "string"},
+			{Name: "usergroup_id", Type: "string"},
+			{Name: "site_id", Type: "string"},
+			{Name: "vlan_id", Type: "integer"},
+			{Name: "map_id", Type: "string"},
+			{Name: "map_name", Type: "string"},
+		}},
+	}
+	markReferences(tools)
+
+	refs := tools[2].ReferenceFields()
+	require.Len(t, refs, 2)
+	assert.Equal(t, FieldSchema{Name: "network_id", Type: "string", Reference: "Network", NameInput: "network_name"}, refs[0])
+	assert.Equal(t, "Network", refs[1].Reference)
+	assert.Equal(t, "excluded_networkconf_names", refs[1].NameInput)
+}
+
 func TestEnumFilterHintFunc(t *testing.T) {
 	tests := []struct {
 		name   string
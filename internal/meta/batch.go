@@ -3,18 +3,19 @@ package meta
 import (
 	"context"
 	"encoding/json"
-	"strings"
 	"sync"
 
-	"github.com/claytono/go-unifi-mcp/internal/resolve"
+	"github.com/claytono/go-unifi-mcp/internal/expr"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
 	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	toolregistry "github.com/claytono/go-unifi-mcp/internal/tools/registry"
 	"github.com/filipowm/go-unifi/unifi"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
 // BatchHandler returns a handler that executes multiple tools in parallel.
-func BatchHandler(client unifi.Client, registry map[string]generated.HandlerFunc, resolver *resolve.Resolver) server.ToolHandlerFunc {
+func BatchHandler(client unifi.Client, registry map[string]generated.HandlerFunc, mw *toolregistry.Middleware) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := req.GetArguments()
 		calls, ok := args["calls"].([]any)
@@ -68,16 +69,29 @@ func BatchHandler(client unifi.Client, registry map[string]generated.HandlerFunc
 					return
 				}
 
+				// An entry-level expr reshapes the entry's result, whatever
+				// the tool
+				var entryExpr *expr.Expr
+				if src, _ := callMap["expr"].(string); src != "" {
+					var err error
+					if entryExpr, err = expr.Compile(src); err != nil {
+						result["error"] = err.Error()
+						mu.Lock()
+						results[idx] = result
+						mu.Unlock()
+						return
+					}
+				}
+
 				// Build inner request
 				innerReq := mcp.CallToolRequest{}
 				innerReq.Params.Name = toolName
 				innerReq.Params.Arguments = toolArgs
 
-				handler := handlerFactory(client)
-				if !strings.HasPrefix(toolName, "delete_") {
-					handler = resolve.WrapHandler(handler, resolver)
-				}
-				toolResult, err := handler(ctx, innerReq)
+				// Structured results are embedded as-is and rendered once
+				// with the whole batch.
+				handler := mw.WrapStructured(handlerFactory(client), toolName)
+				toolResult, err := handler(payload.WithStructured(ctx), innerReq)
 				if err != nil {
 					result["error"] = err.Error()
 					mu.Lock()
@@ -87,7 +101,10 @@ func BatchHandler(client unifi.Client, registry map[string]generated.HandlerFunc
 				}
 
 				// Extract the result content
-				if toolResult != nil && len(toolResult.Content) > 0 {
+				if v, ok := payload.FromResult(toolResult); ok {
+					result["result"] = v
+					result["isError"] = false
+				} else if toolResult != nil && len(toolResult.Content) > 0 {
 					if textContent, ok := toolResult.Content[0].(mcp.TextContent); ok {
 						// Try to parse as JSON for cleaner output
 						var parsed any
@@ -99,6 +116,7 @@ func BatchHandler(client unifi.Client, registry map[string]generated.HandlerFunc
 					}
 					result["isError"] = toolResult.IsError
 				}
+				evalEntryExpr(ctx, entryExpr, result)
 
 				mu.Lock()
 				results[idx] = result
@@ -108,10 +126,34 @@ func BatchHandler(client unifi.Client, registry map[string]generated.HandlerFunc
 
 		wg.Wait()
 
-		data, err := json.MarshalIndent(results, "", "  ")
+		data, err := (&payload.Value{Data: results}).MarshalIndent()
 		if err != nil {
 			return mcp.NewToolResultError("failed to marshal results: " + err.Error()), nil
 		}
 		return mcp.NewToolResultText(string(data)), nil
 	}
 }
+
+// evalEntryExpr evaluates an entry-level expr on the result of a successful
+// call, replacing the result with the expression's output or with an error.
+func evalEntryExpr(ctx context.Context, e *expr.Expr, result map[string]any) {
+	if e == nil || result["isError"] != false {
+		return
+	}
+	var out any
+	var err error
+	if v, ok := result["result"].(*payload.Value); ok {
+		if out, err = e.Eval(ctx, v.Data); err == nil {
+			out = v.WithData(out)
+		}
+	} else {
+		out, err = e.Eval(ctx, result["result"])
+	}
+	if err != nil {
+		delete(result, "result")
+		delete(result, "isError")
+		result["error"] = err.Error()
+		return
+	}
+	result["result"] = out
+}
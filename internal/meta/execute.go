@@ -2,17 +2,16 @@ package meta
 
 import (
 	"context"
-	"strings"
 
-	"github.com/claytono/go-unifi-mcp/internal/resolve"
 	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	toolregistry "github.com/claytono/go-unifi-mcp/internal/tools/registry"
 	"github.com/filipowm/go-unifi/unifi"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
 // ExecuteHandler returns a handler that dispatches to any tool by name.
-func ExecuteHandler(client unifi.Client, registry map[string]generated.HandlerFunc, resolver *resolve.Resolver) server.ToolHandlerFunc {
+func ExecuteHandler(client unifi.Client, registry map[string]generated.HandlerFunc, mw *toolregistry.Middleware) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		args := req.GetArguments()
 		toolName, ok := args["tool"].(string)
@@ -35,10 +34,7 @@ func ExecuteHandler(client unifi.Client, registry map[string]generated.HandlerFu
 		innerReq.Params.Name = toolName
 		innerReq.Params.Arguments = toolArgs
 
-		handler := handlerFactory(client)
-		if !strings.HasPrefix(toolName, "delete_") {
-			handler = resolve.WrapHandler(handler, resolver)
-		}
+		handler := mw.Wrap(handlerFactory(client), toolName)
 		return handler(ctx, innerReq)
 	}
 }
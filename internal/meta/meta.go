@@ -1,24 +1,24 @@
 // Package meta provides meta-tools for lazy mode operation.
-// In lazy mode, only 3 meta-tools are registered instead of 242 direct tools,
+// In lazy mode, only 3 meta-tools are registered instead of 274 direct tools,
 // reducing context size from ~5000 tokens to ~200 tokens.
 package meta
 
 import (
-	"github.com/claytono/go-unifi-mcp/internal/resolve"
 	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	toolregistry "github.com/claytono/go-unifi-mcp/internal/tools/registry"
 	"github.com/filipowm/go-unifi/unifi"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
 // RegisterMetaTools registers the 3 meta-tools for lazy mode operation.
-func RegisterMetaTools(s *server.MCPServer, client unifi.Client, resolver *resolve.Resolver) {
+func RegisterMetaTools(s *server.MCPServer, client unifi.Client, mw *toolregistry.Middleware) {
 	registry := generated.GetHandlerRegistry()
 
 	// tool_index - Returns filtered tool catalog
 	s.AddTool(mcp.NewTool("tool_index",
 		mcp.WithDescription("Returns the catalog of all available UniFi tools. Use this to discover tools before calling execute."),
-		mcp.WithString("category", mcp.Description("Filter by operation type: list, get, create, update, delete")),
+		mcp.WithString("category", mcp.Description("Filter by operation type: list, get, create, update, upsert, delete")),
 		mcp.WithString("resource", mcp.Description("Filter by resource name (case-insensitive partial match)")),
 	), ToolIndexHandler())
 
@@ -27,11 +27,11 @@ func RegisterMetaTools(s *server.MCPServer, client unifi.Client, resolver *resol
 		mcp.WithDescription("Executes any UniFi tool by name. Use tool_index first to discover available tools."),
 		mcp.WithString("tool", mcp.Required(), mcp.Description("Name of the tool to execute (e.g., 'list_network')")),
 		mcp.WithObject("arguments", mcp.Description("Arguments to pass to the tool")),
-	), ExecuteHandler(client, registry, resolver))
+	), ExecuteHandler(client, registry, mw))
 
 	// batch - Executes multiple tools in parallel
 	s.AddTool(mcp.NewTool("batch",
 		mcp.WithDescription("Executes multiple UniFi tools in parallel. Each call specifies a tool name and its arguments."),
-		mcp.WithArray("calls", mcp.Required(), mcp.Description("Array of tool calls, each with 'tool' (string) and 'arguments' (object)")),
-	), BatchHandler(client, registry, resolver))
+		mcp.WithArray("calls", mcp.Required(), mcp.Description("Array of tool calls, each with 'tool' (string), 'arguments' (object) and optionally 'expr' (string), a jq expression applied to that call's result")),
+	), BatchHandler(client, registry, mw))
 }
@@ -642,3 +642,49 @@ func TestBatch_NonJSONResult(t *testing.T) {
 	// Result should be stored as plain text string
 	assert.Equal(t, "plain text, not JSON", results[0]["result"])
 }
+
+func TestBatch_EntryExpr(t *testing.T) {
+	mockHandler := func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(`{"result": "ok", "count": 2}`), nil
+	}
+
+	registry := map[string]generated.HandlerFunc{
+		"test_tool": func(_ unifi.Client) server.ToolHandlerFunc {
+			return mockHandler
+		},
+	}
+
+	handler := BatchHandler(nil, registry, nil)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"calls": []any{
+			map[string]any{"tool": "test_tool", "arguments": map[string]any{}, "expr": ".result"},
+			map[string]any{"tool": "test_tool", "arguments": map[string]any{}, "expr": ".["},
+			map[string]any{"tool": "test_tool", "arguments": map[string]any{}, "expr": ".count + .result"},
+			map[string]any{"tool": "test_tool", "arguments": map[string]any{}},
+		},
+	}
+
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.False(t, result.IsError)
+
+	var results []map[string]any
+	content := result.Content[0].(mcp.TextContent)
+	err = json.Unmarshal([]byte(content.Text), &results)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	assert.Equal(t, "ok", results[0]["result"])
+	assert.NotContains(t, results[0], "error")
+
+	assert.Contains(t, results[1]["error"], "invalid expr")
+	assert.NotContains(t, results[1], "result")
+
+	assert.Contains(t, results[2]["error"], "expr error")
+	assert.NotContains(t, results[2], "result")
+
+	assert.Equal(t, map[string]any{"result": "ok", "count": float64(2)}, results[3]["result"])
+}
@@ -0,0 +1,171 @@
+package payload
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+)
+
+// appendValue appends the compact JSON encoding of v to b, rendering objects
+// in the key order o. Values of types not produced by encoding/json decoding
+// are marshalled with encoding/json.
+func appendValue(b []byte, v any, o *keyOrder) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(b, "null"...), nil
+	case string:
+		return appendString(b, v), nil
+	case bool:
+		return strconv.AppendBool(b, v), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, &json.UnsupportedValueError{Str: strconv.FormatFloat(v, 'g', -1, 64)}
+		}
+		return appendFloat(b, v), nil
+	case map[string]any:
+		return appendObject(b, v, o)
+	case []map[string]any:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		b = append(b, '[')
+		for i, item := range v {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			var err error
+			if b, err = appendObject(b, item, o); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, ']'), nil
+	case []any:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		b = append(b, '[')
+		for i, elem := range v {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			var err error
+			if b, err = appendValue(b, elem, o); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, ']'), nil
+	case *Value:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		return appendValue(b, v.Data, v.order)
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return append(b, raw...), nil
+	}
+}
+
+// appendObject renders m with the keys known to o first, in their recorded
+// order, followed by the remaining keys sorted. Derived keys without a
+// position of their own follow the key they were derived from.
+func appendObject(b []byte, m map[string]any, o *keyOrder) ([]byte, error) {
+	if m == nil {
+		return append(b, "null"...), nil
+	}
+
+	// Only keys unknown to o need placing, typically none or a few added
+	// by post-processing.
+	var rest []string
+	var attached map[string][]string
+	for key := range m {
+		if !o.has(key) {
+			rest = append(rest, key)
+		}
+	}
+	if len(rest) > 0 {
+		slices.Sort(rest)
+		unattached := rest[:0]
+		for _, key := range rest {
+			if source := sourceKey(m, key); source != "" {
+				if attached == nil {
+					attached = make(map[string][]string)
+				}
+				attached[source] = append(attached[source], key)
+				continue
+			}
+			unattached = append(unattached, key)
+		}
+		rest = unattached
+	}
+
+	keys := make([]string, 0, len(m))
+	var appendKey func(string)
+	appendKey = func(key string) {
+		keys = append(keys, key)
+		for _, derived := range attached[key] {
+			appendKey(derived)
+		}
+	}
+	if o != nil {
+		for _, key := range o.keys {
+			if _, ok := m[key]; ok {
+				appendKey(key)
+			}
+		}
+	}
+	for _, key := range rest {
+		appendKey(key)
+	}
+
+	b = append(b, '{')
+	for i, key := range keys {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendString(b, key)
+		b = append(b, ':')
+		var err error
+		if b, err = appendValue(b, m[key], o.child(key)); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, '}'), nil
+}
+
+// appendString appends s as a JSON string, escaped the way encoding/json
+// escapes it.
+func appendString(b []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' || c >= utf8.RuneSelf {
+			raw, _ := json.Marshal(s)
+			return append(b, raw...)
+		}
+	}
+	b = append(b, '"')
+	b = append(b, s...)
+	return append(b, '"')
+}
+
+// appendFloat formats f like encoding/json does.
+func appendFloat(b []byte, f float64) []byte {
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	b = strconv.AppendFloat(b, f, format, -1, 64)
+	if format == 'e' {
+		// Clean up e-09 to e-9.
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b
+}
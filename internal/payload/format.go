@@ -0,0 +1,381 @@
+package payload
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is a text rendering of tool output.
+type Format string
+
+// Supported formats. Tabular formats flatten nested objects into dotted
+// columns such as "uplink.speed", join arrays of scalars with ", " and
+// render other arrays as compact JSON. Lists become one row per item and
+// objects one row per field.
+const (
+	FormatJSON        Format = "json"         // indented JSON
+	FormatJSONCompact Format = "json_compact" // JSON without whitespace
+	FormatYAML        Format = "yaml"
+	FormatCSV         Format = "csv"      // annotations as leading "# key: value" lines
+	FormatMarkdown    Format = "markdown" // tables, annotations as a leading list
+)
+
+// Formats lists the supported formats, in the order they are documented.
+var Formats = []Format{FormatJSON, FormatJSONCompact, FormatYAML, FormatCSV, FormatMarkdown}
+
+// ParseFormat validates a format name. An empty name is FormatJSON.
+func ParseFormat(name string) (Format, error) {
+	if name == "" {
+		return FormatJSON, nil
+	}
+	if f := Format(name); slices.Contains(Formats, f) {
+		return f, nil
+	}
+	names := make([]string, len(Formats))
+	for i, f := range Formats {
+		names[i] = string(f)
+	}
+	return "", fmt.Errorf("invalid format %q (supported formats: %s)", name, strings.Join(names, ", "))
+}
+
+// Marshal renders the value in format f.
+func (v *Value) Marshal(f Format) ([]byte, error) {
+	switch f {
+	case FormatJSON, "":
+		return v.MarshalIndent()
+	case FormatJSONCompact:
+		return v.MarshalJSON()
+	}
+
+	// The other formats work on the value decoded with its keys in render
+	// order.
+	raw, err := v.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	doc, err := decodeOrdered(raw)
+	if err != nil {
+		return nil, err
+	}
+	switch f {
+	case FormatYAML:
+		return marshalYAML(doc)
+	case FormatCSV:
+		return marshalCSV(doc, v.envelope)
+	case FormatMarkdown:
+		return marshalMarkdown(doc, v.envelope), nil
+	}
+	return nil, fmt.Errorf("unsupported format %q", f)
+}
+
+// object is a decoded JSON object that keeps its key order.
+type object []field
+
+type field struct {
+	key   string
+	value any
+}
+
+// MarshalJSON renders the object compactly, in order.
+func (o object) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for i, f := range o {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendString(b, f.key)
+		b = append(b, ':')
+		raw, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, raw...)
+	}
+	return append(b, '}'), nil
+}
+
+// decodeOrdered decodes JSON into objects, []any, json.Number, string, bool
+// and nil.
+func decodeOrdered(raw []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	return decodeNext(dec)
+}
+
+func decodeNext(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		obj := object{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeNext(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, field{key: key.(string), value: value})
+		}
+		_, err = dec.Token()
+		return obj, err
+	case json.Delim('['):
+		arr := []any{}
+		for dec.More() {
+			elem, err := decodeNext(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, elem)
+		}
+		_, err = dec.Token()
+		return arr, err
+	}
+	return tok, nil
+}
+
+func marshalYAML(doc any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(yamlNode(doc)); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// yamlNode converts a decoded value to a YAML node, so that mappings keep
+// their key order and strings holding numbers stay strings.
+func yamlNode(v any) *yaml.Node {
+	switch v := v.(type) {
+	case object:
+		n := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for _, f := range v {
+			n.Content = append(n.Content, yamlNode(f.key), yamlNode(f.value))
+		}
+		return n
+	case []any:
+		n := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, elem := range v {
+			n.Content = append(n.Content, yamlNode(elem))
+		}
+		return n
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(v.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v)}
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+}
+
+// table is the tabular form of a value.
+type table struct {
+	annotations object   // envelope keys other than the data
+	list        bool     // rows are list items rather than object fields
+	columns     []string // for lists
+	rows        []object // flattened items, or one field/value row per field
+	scalar      *string  // a value that is neither a list nor an object
+}
+
+// tabulate builds the table of a decoded value. The annotations of an
+// envelope, see Envelope, are set apart from its data.
+func tabulate(doc any, envelope bool) table {
+	var t table
+	if obj, ok := doc.(object); ok && envelope && len(obj) > 0 && obj[len(obj)-1].key == EnvelopeKey {
+		t.annotations = obj[:len(obj)-1]
+		doc = obj[len(obj)-1].value
+	}
+	switch v := doc.(type) {
+	case []any:
+		t.list = true
+		seen := make(map[string]bool)
+		for _, item := range v {
+			row := flatten("", item, nil)
+			prev := -1
+			for _, f := range row {
+				if !seen[f.key] {
+					seen[f.key] = true
+					t.columns = slices.Insert(t.columns, prev+1, f.key)
+				}
+				prev = slices.Index(t.columns, f.key)
+			}
+			t.rows = append(t.rows, row)
+		}
+	case object:
+		for _, f := range flatten("", v, nil) {
+			t.rows = append(t.rows, object{{key: "field", value: f.key}, {key: "value", value: f.value}})
+		}
+	default:
+		c := cell(v)
+		t.scalar = &c
+	}
+	return t
+}
+
+// flatten appends the cells of v to out: nested objects become dotted
+// columns and anything else a single cell. A value that is not an object
+// has the column "value".
+func flatten(prefix string, v any, out object) object {
+	obj, ok := v.(object)
+	if !ok || (len(obj) == 0 && prefix != "") {
+		if prefix == "" {
+			prefix = "value"
+		}
+		return append(out, field{key: prefix, value: cell(v)})
+	}
+	for _, f := range obj {
+		key := f.key
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		out = flatten(key, f.value, out)
+	}
+	return out
+}
+
+// cell renders a value as the text of a table cell.
+func cell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case object:
+		if len(v) == 0 {
+			return ""
+		}
+	case []any:
+		if !slices.ContainsFunc(v, isComposite) {
+			cells := make([]string, len(v))
+			for i, elem := range v {
+				cells[i] = cell(elem)
+			}
+			return strings.Join(cells, ", ")
+		}
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
+
+func isComposite(v any) bool {
+	switch v.(type) {
+	case object, []any:
+		return true
+	}
+	return false
+}
+
+// values returns the cells of row in the order of columns.
+func (t table) values(row object) []string {
+	cells := make([]string, len(t.columns))
+	for _, f := range row {
+		cells[slices.Index(t.columns, f.key)] = f.value.(string)
+	}
+	return cells
+}
+
+func marshalCSV(doc any, envelope bool) ([]byte, error) {
+	t := tabulate(doc, envelope)
+	var buf bytes.Buffer
+	for _, a := range t.annotations {
+		fmt.Fprintf(&buf, "# %s: %s\n", a.key, cell(a.value))
+	}
+	w := csv.NewWriter(&buf)
+	switch {
+	case t.scalar != nil:
+		_ = w.Write([]string{"value"})
+		_ = w.Write([]string{*t.scalar})
+	case t.list:
+		if len(t.columns) > 0 {
+			_ = w.Write(t.columns)
+		}
+		for _, row := range t.rows {
+			_ = w.Write(t.values(row))
+		}
+	default:
+		_ = w.Write([]string{"field", "value"})
+		for _, row := range t.rows {
+			_ = w.Write([]string{row[0].value.(string), row[1].value.(string)})
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func marshalMarkdown(doc any, envelope bool) []byte {
+	t := tabulate(doc, envelope)
+	var buf bytes.Buffer
+	for _, a := range t.annotations {
+		fmt.Fprintf(&buf, "- **%s**: %s\n", a.key, markdownCell(cell(a.value)))
+	}
+	if len(t.annotations) > 0 {
+		buf.WriteByte('\n')
+	}
+	switch {
+	case t.scalar != nil:
+		buf.WriteString(*t.scalar)
+		buf.WriteByte('\n')
+	case t.list:
+		if len(t.rows) == 0 {
+			buf.WriteString("_No items_\n")
+			break
+		}
+		writeMarkdownRow(&buf, t.columns)
+		writeMarkdownRule(&buf, len(t.columns))
+		for _, row := range t.rows {
+			writeMarkdownRow(&buf, t.values(row))
+		}
+	default:
+		writeMarkdownRow(&buf, []string{"Field", "Value"})
+		writeMarkdownRule(&buf, 2)
+		for _, row := range t.rows {
+			writeMarkdownRow(&buf, []string{row[0].value.(string), row[1].value.(string)})
+		}
+	}
+	return buf.Bytes()
+}
+
+func writeMarkdownRow(buf *bytes.Buffer, cells []string) {
+	buf.WriteByte('|')
+	for _, c := range cells {
+		buf.WriteByte(' ')
+		buf.WriteString(markdownCell(c))
+		buf.WriteString(" |")
+	}
+	buf.WriteByte('\n')
+}
+
+func writeMarkdownRule(buf *bytes.Buffer, n int) {
+	buf.WriteString("|" + strings.Repeat(" --- |", n) + "\n")
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+// markdownCell escapes the characters that would break a table row.
+func markdownCell(s string) string {
+	return markdownEscaper.Replace(s)
+}
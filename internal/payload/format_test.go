@@ -0,0 +1,161 @@
+package payload
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClients = `[
+	{"name": "nas", "ip": "10.0.0.5", "network_id": "n1", "uptime": 3600, "tags": ["a", "b"],
+	 "uplink": {"mac": "aa:bb", "speed": 1000}, "version": "6.10"},
+	{"name": "tv | lounge", "network_id": "n2", "blocked": true, "uplink": {}, "note": "two\nlines"}
+]`
+
+func resolvedClients(t *testing.T) *Value {
+	t.Helper()
+	v, err := FromJSON([]byte(testClients))
+	require.NoError(t, err)
+	// network_name is added by ID resolution and renders after network_id
+	for i, name := range []string{"LAN", "IoT"} {
+		v.Data.([]map[string]any)[i]["network_name"] = name
+	}
+	return v
+}
+
+func marshalString(t *testing.T, v *Value, f Format) string {
+	t.Helper()
+	out, err := v.Marshal(f)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, name := range []string{"json", "json_compact", "yaml", "csv", "markdown"} {
+		f, err := ParseFormat(name)
+		require.NoError(t, err)
+		assert.Equal(t, Format(name), f)
+	}
+	f, err := ParseFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, FormatJSON, f)
+
+	_, err = ParseFormat("xml")
+	assert.EqualError(t, err, `invalid format "xml" (supported formats: json, json_compact, yaml, csv, markdown)`)
+}
+
+func TestMarshal_JSONCompact(t *testing.T) {
+	v, err := FromJSON([]byte(`{"b": [1, 2], "a": {"y": null, "x": "s"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, `{"b":[1,2],"a":{"y":null,"x":"s"}}`, marshalString(t, v, FormatJSONCompact))
+}
+
+func TestMarshal_YAML(t *testing.T) {
+	assert.Equal(t, `- name: nas
+  ip: 10.0.0.5
+  network_id: n1
+  network_name: LAN
+  uptime: 3600
+  tags:
+    - a
+    - b
+  uplink:
+    mac: aa:bb
+    speed: 1000
+  version: "6.10"
+- name: tv | lounge
+  network_id: n2
+  network_name: IoT
+  blocked: true
+  uplink: {}
+  note: |-
+    two
+    lines
+`, marshalString(t, resolvedClients(t), FormatYAML))
+}
+
+func TestMarshal_CSV(t *testing.T) {
+	// Columns follow the merged key order of the items, as in JSON output
+	assert.Equal(t, `name,ip,network_id,network_name,blocked,uplink,note,uptime,tags,uplink.mac,uplink.speed,version
+nas,10.0.0.5,n1,LAN,,,,3600,"a, b",aa:bb,1000,6.10
+tv | lounge,,n2,IoT,true,,"two
+lines",,,,,
+`, marshalString(t, resolvedClients(t), FormatCSV))
+}
+
+func TestMarshal_Markdown(t *testing.T) {
+	v, err := FromJSON([]byte(`[{"name": "nas", "ports": [{"idx": 1}], "up": true}, {"name": "a|b", "note": "x\ny"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, `| name | note | ports | up |
+| --- | --- | --- | --- |
+| nas |  | [{"idx":1}] | true |
+| a\|b | x<br>y |  |  |
+`, marshalString(t, v, FormatMarkdown))
+
+	empty, err := FromJSON([]byte(`[]`))
+	require.NoError(t, err)
+	assert.Equal(t, "_No items_\n", marshalString(t, empty, FormatMarkdown))
+}
+
+func TestMarshal_Objects(t *testing.T) {
+	v, err := FromJSON([]byte(`{"name": "mgmt", "ssh": {"enabled": true, "keys": []}, "vlan": null}`))
+	require.NoError(t, err)
+	assert.Equal(t, "| Field | Value |\n| --- | --- |\n| name | mgmt |\n| ssh.enabled | true |\n| ssh.keys |  |\n| vlan |  |\n",
+		marshalString(t, v, FormatMarkdown))
+	assert.Equal(t, "field,value\nname,mgmt\nssh.enabled,true\nssh.keys,\nvlan,\n", marshalString(t, v, FormatCSV))
+}
+
+func TestMarshal_Scalars(t *testing.T) {
+	v := &Value{Data: float64(3)}
+	assert.Equal(t, "value\n3\n", marshalString(t, v, FormatCSV))
+	assert.Equal(t, "3\n", marshalString(t, v, FormatMarkdown))
+	assert.Equal(t, "3\n", marshalString(t, v, FormatYAML))
+
+	names := &Value{Data: []any{"a", "b"}}
+	assert.Equal(t, "value\na\nb\n", marshalString(t, names, FormatCSV))
+}
+
+func TestMarshal_AnnotatedEnvelope(t *testing.T) {
+	items, err := FromJSON([]byte(`[{"name": "a"}, {"name": "b"}]`))
+	require.NoError(t, err)
+	v := Envelope([]string{"total", "next_offset"}, map[string]any{
+		"total": float64(10), "next_offset": float64(2),
+	}, items)
+	assert.Equal(t, "# total: 10\n# next_offset: 2\nname\na\nb\n", marshalString(t, v, FormatCSV))
+	assert.Equal(t, "- **total**: 10\n- **next_offset**: 2\n\n| name |\n| --- |\n| a |\n| b |\n",
+		marshalString(t, v, FormatMarkdown))
+
+	// An object whose last field merely is named data is not an envelope
+	obj := Ordered([]string{"name", "data"}, map[string]any{"name": "a", "data": "x"})
+	assert.Equal(t, "field,value\nname,a\ndata,x\n", marshalString(t, obj, FormatCSV))
+	assert.Equal(t, "| Field | Value |\n| --- | --- |\n| name | a |\n| data | x |\n", marshalString(t, obj, FormatMarkdown))
+}
+
+func TestRenderFormat(t *testing.T) {
+	calls := 0
+	handler := RenderFormat(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		v, err := FromJSON([]byte(`[{"b":1,"a":2}]`))
+		require.NoError(t, err)
+		return NewResult(ctx, v), nil
+	}, FormatJSONCompact)
+	call := func(args map[string]any) *mcp.CallToolResult {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = args
+		result, err := handler(context.Background(), req)
+		require.NoError(t, err)
+		return result
+	}
+
+	assert.Equal(t, `[{"b":1,"a":2}]`, call(nil).Content[0].(mcp.TextContent).Text)
+	assert.Equal(t, "b,a\n1,2\n", call(map[string]any{"format": "csv"}).Content[0].(mcp.TextContent).Text)
+
+	calls = 0
+	result := call(map[string]any{"format": "xml"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `invalid format "xml"`)
+	assert.Equal(t, 0, calls)
+}
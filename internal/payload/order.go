@@ -0,0 +1,174 @@
+package payload
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// keyOrder is the key order for the objects found at one path of a document.
+// Elements of an array share the order of the key holding the array, so a
+// list of objects of the same type has a single keyOrder.
+type keyOrder struct {
+	keys     []string
+	names    map[string]string // raw (escaped) key -> decoded key
+	children map[string]*keyOrder
+}
+
+func newKeyOrder() *keyOrder {
+	return &keyOrder{names: make(map[string]string)}
+}
+
+// has reports whether key has a position in the order.
+func (o *keyOrder) has(key string) bool {
+	if o == nil {
+		return false
+	}
+	_, ok := o.names[key]
+	return ok
+}
+
+// child returns the order of the objects held under key.
+func (o *keyOrder) child(key string) *keyOrder {
+	if o == nil {
+		return nil
+	}
+	return o.children[key]
+}
+
+// add places key right after prev, or first when prev is empty. Keys that
+// already have a position keep it.
+func (o *keyOrder) add(key, prev string) {
+	if _, ok := o.names[key]; ok {
+		return
+	}
+	o.names[key] = key
+	pos := 0
+	if prev != "" {
+		pos = slices.Index(o.keys, prev) + 1
+	}
+	o.keys = slices.Insert(o.keys, pos, key)
+}
+
+func (o *keyOrder) childFor(key string) *keyOrder {
+	if o.children == nil {
+		o.children = make(map[string]*keyOrder)
+	}
+	c, ok := o.children[key]
+	if !ok {
+		c = newKeyOrder()
+		o.children[key] = c
+	}
+	return c
+}
+
+// scanOrder records the order keys first appear in for every object path of
+// a valid JSON document. Objects at the same path with different key sets are
+// merged: a key not seen before is placed after the key preceding it.
+func scanOrder(data []byte) *keyOrder {
+	type frame struct {
+		node    *keyOrder
+		object  bool
+		wantKey bool
+		key     string // current (last seen) key of an object
+	}
+	root := newKeyOrder()
+	var stack []frame
+	nodeFor := func() *keyOrder {
+		if len(stack) == 0 {
+			return root
+		}
+		top := &stack[len(stack)-1]
+		if top.object {
+			return top.node.childFor(top.key)
+		}
+		return top.node
+	}
+
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '{':
+			stack = append(stack, frame{node: nodeFor(), object: true, wantKey: true})
+		case '[':
+			stack = append(stack, frame{node: nodeFor()})
+		case '}', ']':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case ',':
+			if len(stack) > 0 && stack[len(stack)-1].object {
+				stack[len(stack)-1].wantKey = true
+			}
+		case '"':
+			end := stringEnd(data, i)
+			if len(stack) > 0 && stack[len(stack)-1].object && stack[len(stack)-1].wantKey {
+				top := &stack[len(stack)-1]
+				raw := data[i+1 : end]
+				key, ok := top.node.names[string(raw)]
+				if !ok {
+					key = decodeKey(raw)
+					top.node.add(key, top.key)
+					top.node.names[string(raw)] = key
+				}
+				top.key = key
+				top.wantKey = false
+			}
+			i = end
+		}
+	}
+	return root
+}
+
+// stringEnd returns the index of the quote closing the string starting at
+// data[start].
+func stringEnd(data []byte, start int) int {
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return len(data) - 1
+}
+
+func decodeKey(raw []byte) string {
+	if !slices.Contains(raw, '\\') {
+		return string(raw)
+	}
+	var key string
+	if err := json.Unmarshal([]byte(`"`+string(raw)+`"`), &key); err != nil {
+		return string(raw)
+	}
+	return key
+}
+
+// derivedSuffixes are the suffixes of keys added by post-processing, such as
+// ID resolution or humanizing, next to the key they were derived from.
+var derivedSuffixes = []struct{ source, derived string }{
+	{"_ids", "_names"},
+	{"_id", "_name"},
+	{"", "_names"},
+	{"", "_name"},
+	{"", "_at"},
+	{"", "_human"},
+}
+
+// sourceKey returns the key of m that derived was derived from, or "".
+// foo_id wins over foo for foo_name.
+func sourceKey(m map[string]any, derived string) string {
+	for _, s := range derivedSuffixes {
+		if !strings.HasSuffix(derived, s.derived) {
+			continue
+		}
+		source := strings.TrimSuffix(derived, s.derived) + s.source
+		if source == derived || source == "" {
+			continue
+		}
+		if _, ok := m[source]; ok {
+			return source
+		}
+	}
+	return ""
+}
@@ -0,0 +1,196 @@
+// Package payload carries tool output as a structured value through the
+// handler middleware chain. Handlers build a Value once from the client's
+// result; query processing, ID resolution and annotation then work on it in
+// place, and Render marshals it to text once, at the outermost layer.
+//
+// A Value remembers the key order of the JSON it was built from, so the
+// rendered output keeps the field order of the go-unifi types rather than
+// sorting keys alphabetically. Keys added later by post-processing, such as
+// network_name next to network_id, are placed right after their source key.
+package payload
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Value is structured tool output: JSON-compatible data (maps, slices and
+// scalars as produced by encoding/json) plus the key order to render it in.
+// Lists of objects are held as []map[string]any. Data may contain nested
+// *Value nodes, which are rendered with their own key order.
+type Value struct {
+	Data     any
+	order    *keyOrder
+	envelope bool // annotations around the data, see Envelope
+}
+
+// FromGo converts v to a Value by marshalling it to JSON once and decoding
+// the result, recording the key order of the marshalled output.
+func FromGo(v any) (*Value, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return FromJSON(raw)
+}
+
+// FromJSON decodes raw JSON into a Value, recording the order keys first
+// appear in for each object path.
+func FromJSON(raw []byte) (*Value, error) {
+	var data any
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var items []map[string]any
+		if err := json.Unmarshal(raw, &items); err == nil {
+			data = items
+		} else if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, err
+		}
+	} else if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	return &Value{Data: data, order: scanOrder(raw)}, nil
+}
+
+// Ordered returns a Value holding values as an object whose keys are
+// rendered in the given order. Keys missing from keys are rendered last,
+// sorted.
+func Ordered(keys []string, values map[string]any) *Value {
+	order := newKeyOrder()
+	prev := ""
+	for _, key := range keys {
+		order.add(key, prev)
+		prev = key
+	}
+	return &Value{Data: values, order: order}
+}
+
+// EnvelopeKey is the key an envelope holds the tool output under, last.
+const EnvelopeKey = "data"
+
+// Envelope returns a Value holding annotations, rendered in the order of
+// keys, followed by data under EnvelopeKey. Tabular formats render the
+// annotations apart from the data.
+func Envelope(keys []string, annotations map[string]any, data any) *Value {
+	values := maps.Clone(annotations)
+	if values == nil {
+		values = make(map[string]any, 1)
+	}
+	values[EnvelopeKey] = data
+	v := Ordered(append(slices.Clone(keys), EnvelopeKey), values)
+	v.envelope = true
+	return v
+}
+
+// WithData returns a Value holding data, rendered with v's key order where
+// its objects sit at the same paths as v's, e.g. after reshaping a list of
+// objects into a list of some of their fields.
+func (v *Value) WithData(data any) *Value {
+	return &Value{Data: data, order: v.order}
+}
+
+// MarshalJSON renders the value as compact JSON.
+func (v *Value) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return appendValue(nil, v.Data, v.order)
+}
+
+// MarshalIndent renders the value as JSON indented with two spaces, matching
+// json.MarshalIndent(x, "", "  ").
+func (v *Value) MarshalIndent() ([]byte, error) {
+	raw, err := v.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.Grow(len(raw) + len(raw)/2)
+	if err := json.Indent(&buf, raw, "", "  "); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type structuredKey struct{}
+
+// WithStructured returns a context asking handlers to return their output as
+// a structured Value instead of text. The caller must render the result, see
+// Render.
+func WithStructured(ctx context.Context) context.Context {
+	return context.WithValue(ctx, structuredKey{}, true)
+}
+
+// Structured reports whether ctx asks for structured results.
+func Structured(ctx context.Context) bool {
+	structured, _ := ctx.Value(structuredKey{}).(bool)
+	return structured
+}
+
+// NewResult returns v as a tool result: carried as structured content when
+// ctx asks for it, otherwise rendered as indented JSON text.
+func NewResult(ctx context.Context, v *Value) *mcp.CallToolResult {
+	if Structured(ctx) {
+		return &mcp.CallToolResult{StructuredContent: v}
+	}
+	return render(v)
+}
+
+// FromResult returns the structured value carried by a successful result.
+func FromResult(result *mcp.CallToolResult) (*Value, bool) {
+	if result == nil || result.IsError {
+		return nil, false
+	}
+	v, ok := result.StructuredContent.(*Value)
+	return v, ok
+}
+
+// Render decorates a handler chain built from structured-aware layers: it
+// asks the chain for a structured result and marshals it to indented JSON
+// text once. Text results are returned unchanged.
+func Render(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return RenderFormat(handler, FormatJSON)
+}
+
+// RenderFormat is Render, marshalling to the format named by the "format"
+// argument, or to def when there is none. An invalid format is reported
+// without calling the handler.
+func RenderFormat(handler server.ToolHandlerFunc, def Format) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		format := def
+		if name, ok := req.GetArguments()["format"].(string); ok {
+			var err error
+			if format, err = ParseFormat(name); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+		result, err := handler(WithStructured(ctx), req)
+		if err != nil {
+			return result, err
+		}
+		v, ok := FromResult(result)
+		if !ok {
+			return result, nil
+		}
+		return renderFormat(v, format), nil
+	}
+}
+
+func render(v *Value) *mcp.CallToolResult {
+	return renderFormat(v, FormatJSON)
+}
+
+func renderFormat(v *Value, format Format) *mcp.CallToolResult {
+	data, err := v.Marshal(format)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err))
+	}
+	return mcp.NewToolResultText(string(data))
+}
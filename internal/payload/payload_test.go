@@ -0,0 +1,150 @@
+package payload
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPort struct {
+	Idx     int    `json:"port_idx"`
+	PoeMode string `json:"poe_mode,omitempty"`
+}
+
+type testDevice struct {
+	ID        string     `json:"_id"`
+	Name      string     `json:"name"`
+	NetworkID string     `json:"network_id,omitempty"`
+	Ports     []testPort `json:"port_table,omitempty"`
+	Uptime    float64    `json:"uptime"`
+}
+
+func TestFromGo_KeepsTypeOrder(t *testing.T) {
+	devices := []testDevice{{
+		ID:        "d1",
+		Name:      "switch",
+		NetworkID: "net1",
+		Ports:     []testPort{{Idx: 1, PoeMode: "auto"}},
+		Uptime:    42,
+	}}
+	v, err := FromGo(devices)
+	require.NoError(t, err)
+
+	items, ok := v.Data.([]map[string]any)
+	require.True(t, ok)
+	require.Len(t, items, 1)
+
+	out, err := v.MarshalJSON()
+	require.NoError(t, err)
+	expected, err := json.Marshal(devices)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(out))
+}
+
+func TestMarshalIndent_MatchesEncodingJSON(t *testing.T) {
+	device := testDevice{ID: "d1", Name: "a <b> & \"c\" é", Uptime: 1e-7}
+	v, err := FromGo(device)
+	require.NoError(t, err)
+
+	out, err := v.MarshalIndent()
+	require.NoError(t, err)
+	expected, err := json.MarshalIndent(device, "", "  ")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(out))
+}
+
+func TestMarshalJSON_DerivedKeysFollowSource(t *testing.T) {
+	v, err := FromJSON([]byte(`[{"name":"r1","network_id":"n1","group_ids":["g1"],"ap_mac":"aa","last_seen":1,"enabled":true}]`))
+	require.NoError(t, err)
+
+	item := v.Data.([]map[string]any)[0]
+	item["network_name"] = "LAN"
+	item["group_names"] = []string{"G"}
+	item["ap_mac_name"] = "AP"
+	item["last_seen_at"] = "T"
+	item["zzz"] = 1
+
+	out, err := v.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t,
+		`[{"name":"r1","network_id":"n1","network_name":"LAN","group_ids":["g1"],"group_names":["G"],"ap_mac":"aa","ap_mac_name":"AP","last_seen":1,"last_seen_at":"T","enabled":true,"zzz":1}]`,
+		string(out))
+}
+
+func TestMarshalJSON_MergesHeterogeneousObjects(t *testing.T) {
+	v, err := FromJSON([]byte(`[{"b":1,"a":2},{"b":1,"c":3,"a":2}]`))
+	require.NoError(t, err)
+
+	out, err := v.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `[{"b":1,"a":2},{"b":1,"c":3,"a":2}]`, string(out))
+}
+
+func TestMarshalJSON_EscapedKeys(t *testing.T) {
+	v, err := FromJSON([]byte(`{"zA":1,"a\"b":{"y":1,"x":2}}`))
+	require.NoError(t, err)
+
+	out, err := v.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"zA":1,"a\"b":{"y":1,"x":2}}`, string(out))
+}
+
+func TestMarshalJSON_UnsupportedFloat(t *testing.T) {
+	_, err := (&Value{Data: map[string]any{"x": math.NaN()}}).MarshalJSON()
+	assert.Error(t, err)
+}
+
+func TestOrdered_NestedValue(t *testing.T) {
+	inner, err := FromJSON([]byte(`{"b":1,"a":2}`))
+	require.NoError(t, err)
+
+	v := Ordered([]string{"stale", "data"}, map[string]any{"data": inner, "stale": true})
+	out, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.Equal(t, `{"stale":true,"data":{"b":1,"a":2}}`, string(out))
+}
+
+func TestNewResult(t *testing.T) {
+	v, err := FromJSON([]byte(`{"b":1,"a":2}`))
+	require.NoError(t, err)
+
+	text := NewResult(context.Background(), v)
+	assert.Equal(t, "{\n  \"b\": 1,\n  \"a\": 2\n}", text.Content[0].(mcp.TextContent).Text)
+	_, ok := FromResult(text)
+	assert.False(t, ok)
+
+	structured := NewResult(WithStructured(context.Background()), v)
+	got, ok := FromResult(structured)
+	require.True(t, ok)
+	assert.Same(t, v, got)
+}
+
+func TestRender(t *testing.T) {
+	handler := Render(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		assert.True(t, Structured(ctx))
+		v, err := FromJSON([]byte(`[{"b":1,"a":2}]`))
+		require.NoError(t, err)
+		return NewResult(ctx, v), nil
+	})
+
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.Nil(t, result.StructuredContent)
+	assert.Equal(t, "[\n  {\n    \"b\": 1,\n    \"a\": 2\n  }\n]", result.Content[0].(mcp.TextContent).Text)
+}
+
+func TestRender_PassesTextThrough(t *testing.T) {
+	handler := Render(func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("boom"), nil
+	})
+
+	result, err := handler(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, "boom", result.Content[0].(mcp.TextContent).Text)
+}
@@ -0,0 +1,256 @@
+package query
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Aggregation replaces the matching items by summary rows.
+//
+// With group_by, each row holds the values of the group_by fields, the
+// number of items in the group as "count" and one "<op>_<field>" value per
+// aggregate, e.g. {"network_id": "...", "count": 12, "sum_rx_bytes": ...}.
+// Without group_by, aggregates summarize all matching items in one row.
+// An item whose group_by path addresses several values, such as
+// "port_table[*].poe_mode", counts in the group of each distinct value.
+//
+// Facets count the distinct values of each facet field separately and
+// return {"<field>": [{"<field>": value, "count": n}, ...], ...}.
+
+// KeyCount is the key of the number of items in a group or facet row.
+const KeyCount = "count"
+
+// aggregateOps lists the supported aggregate operations.
+var aggregateOps = []string{"sum", "min", "max", "avg"}
+
+// Grouped returns true if the items are replaced by group rows.
+func (o Options) Grouped() bool {
+	return len(o.GroupBy) > 0 || len(o.Aggregate) > 0
+}
+
+// aggregate is one compiled "<op>_<field>" aggregate.
+type aggregate struct {
+	op   string
+	key  string
+	path path
+}
+
+func compileAggregates(spec map[string][]string) ([]aggregate, error) {
+	var aggs []aggregate
+	for _, op := range slices.Sorted(maps.Keys(spec)) {
+		if !slices.Contains(aggregateOps, op) {
+			return nil, fmt.Errorf("invalid aggregate: unknown operation %q (supported operations: %s)",
+				op, strings.Join(aggregateOps, ", "))
+		}
+		for _, field := range spec[op] {
+			p, err := parsePath(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid aggregate: %w", err)
+			}
+			aggs = append(aggs, aggregate{op: op, key: op + "_" + field, path: p})
+		}
+	}
+	return aggs, nil
+}
+
+// group accumulates the items of one group.
+type group struct {
+	values []any // group_by values
+	count  int
+	stats  []stats // one per aggregate
+}
+
+// stats accumulates the numeric values of one aggregate.
+type stats struct {
+	n             int
+	sum, min, max float64
+}
+
+func (s *stats) add(v float64) {
+	if s.n == 0 || v < s.min {
+		s.min = v
+	}
+	if s.n == 0 || v > s.max {
+		s.max = v
+	}
+	s.n++
+	s.sum += v
+}
+
+func (s *stats) result(op string) any {
+	if op == "sum" {
+		return s.sum
+	}
+	if s.n == 0 {
+		return nil
+	}
+	switch op {
+	case "min":
+		return s.min
+	case "max":
+		return s.max
+	}
+	return s.sum / float64(s.n)
+}
+
+// applyGroupBy groups items by the values of the groupBy paths and returns
+// one row per group, largest groups first.
+func applyGroupBy(items []map[string]any, fields []string, paths []path, aggs []aggregate) []map[string]any {
+	groups := make(map[string]*group)
+	var order []*group
+	for _, item := range items {
+		for _, values := range groupValues(item, paths) {
+			id := groupID(values)
+			g, ok := groups[id]
+			if !ok {
+				g = &group{values: values, stats: make([]stats, len(aggs))}
+				groups[id] = g
+				order = append(order, g)
+			}
+			g.count++
+			for i, agg := range aggs {
+				for _, v := range expandArrays(agg.path.values(item, nil)) {
+					if n, ok := numberValue(v); ok {
+						g.stats[i].add(n)
+					}
+				}
+			}
+		}
+	}
+	if len(paths) == 0 && len(order) == 0 {
+		// Aggregates over no items still report a row
+		order = append(order, &group{stats: make([]stats, len(aggs))})
+	}
+
+	slices.SortStableFunc(order, func(a, b *group) int {
+		if c := cmp.Compare(b.count, a.count); c != 0 {
+			return c
+		}
+		for i := range a.values {
+			if c := compareNullable(a.values[i], b.values[i]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+
+	rows := make([]map[string]any, len(order))
+	for i, g := range order {
+		row := make(map[string]any, len(fields)+1+len(aggs))
+		for j, field := range fields {
+			row[field] = g.values[j]
+		}
+		// Counts are float64 like decoded JSON numbers, so rows sort and
+		// filter like items
+		row[KeyCount] = float64(g.count)
+		for j, agg := range aggs {
+			row[agg.key] = g.stats[j].result(agg.op)
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// groupValues returns the combinations of group_by values of an item. A
+// missing value groups as null.
+func groupValues(item map[string]any, paths []path) [][]any {
+	combos := [][]any{{}}
+	for _, p := range paths {
+		values := distinct(expandArrays(p.values(item, nil)))
+		if len(values) == 0 {
+			values = []any{nil}
+		}
+		next := make([][]any, 0, len(combos)*len(values))
+		for _, combo := range combos {
+			for _, v := range values {
+				next = append(next, append(slices.Clip(combo), v))
+			}
+		}
+		combos = next
+	}
+	return combos
+}
+
+// distinct removes values with the same identity as an earlier value.
+func distinct(values []any) []any {
+	if len(values) < 2 {
+		return values
+	}
+	seen := make(map[string]bool, len(values))
+	out := values[:0:0]
+	for _, v := range values {
+		id := valueID(v)
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// valueID identifies a value for grouping; unlike valueString it keeps null
+// apart from the string "<nil>" and 1 apart from "1".
+func valueID(v any) string {
+	return typeName(v) + ":" + valueString(v)
+}
+
+func groupID(values []any) string {
+	var b strings.Builder
+	for _, v := range values {
+		b.WriteString(valueID(v))
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+// compareNullable orders values as sorting does, with nulls last.
+func compareNullable(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return compareValues(a, b)
+}
+
+// applyFacets returns, per facet field, the distinct values of the field
+// and the number of items holding each, most frequent first. Items without
+// the field, or holding null, are not counted.
+func applyFacets(items []map[string]any, fields []string, paths []path) map[string]any {
+	facets := make(map[string]any, len(fields))
+	for i, field := range fields {
+		rows := []any{}
+		for _, row := range applyGroupBy(items, fields[i:i+1], paths[i:i+1], nil) {
+			if row[field] != nil {
+				rows = append(rows, row)
+			}
+		}
+		facets[field] = rows
+	}
+	return facets
+}
+
+// expandArrays replaces arrays in values by their elements, so that an item
+// tagged ["a", "b"] counts towards both groups.
+func expandArrays(values []any) []any {
+	for i, v := range values {
+		if _, ok := v.([]any); ok {
+			out := slices.Clone(values[:i])
+			for _, v := range values[i:] {
+				if arr, ok := v.([]any); ok {
+					out = append(out, arr...)
+				} else {
+					out = append(out, v)
+				}
+			}
+			return out
+		}
+	}
+	return values
+}
@@ -0,0 +1,113 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testClients = []map[string]any{
+	{"name": "laptop", "network_id": "lan", "rx_bytes": float64(100), "is_wired": false, "satisfaction": float64(90)},
+	{"name": "phone", "network_id": "lan", "rx_bytes": float64(300), "is_wired": false},
+	{"name": "tv", "network_id": "iot", "rx_bytes": "50", "is_wired": true, "satisfaction": float64(70)},
+	{"name": "nas", "network_id": "lan", "rx_bytes": float64(200), "is_wired": true, "satisfaction": float64(100)},
+	{"name": "guest"},
+}
+
+func TestApply_GroupBy(t *testing.T) {
+	result, err := Apply(testClients, Options{
+		GroupBy:   []string{"network_id"},
+		Aggregate: map[string][]string{"sum": {"rx_bytes"}, "avg": {"satisfaction"}, "max": {"rx_bytes"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{"network_id": "lan", "count": float64(3), "sum_rx_bytes": float64(600), "max_rx_bytes": float64(300), "avg_satisfaction": float64(95)},
+		{"network_id": "iot", "count": float64(1), "sum_rx_bytes": float64(50), "max_rx_bytes": float64(50), "avg_satisfaction": float64(70)},
+		{"network_id": nil, "count": float64(1), "sum_rx_bytes": float64(0), "max_rx_bytes": nil, "avg_satisfaction": nil},
+	}, result)
+}
+
+func TestApply_GroupByMultipleFields(t *testing.T) {
+	result, err := Apply(testClients, Options{
+		Filter:  map[string]any{"network_id": map[string]any{"exists": true}},
+		GroupBy: []string{"network_id", "is_wired"},
+		Sort:    []SortKey{{Field: "network_id"}, {Field: "is_wired"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{"network_id": "iot", "is_wired": true, "count": float64(1)},
+		{"network_id": "lan", "is_wired": false, "count": float64(2)},
+		{"network_id": "lan", "is_wired": true, "count": float64(1)},
+	}, result)
+}
+
+func TestApply_AggregateWithoutGroupBy(t *testing.T) {
+	result, err := Apply(testClients, Options{Aggregate: map[string][]string{"min": {"rx_bytes"}, "sum": {"rx_bytes"}}})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{"count": float64(5), "min_rx_bytes": float64(50), "sum_rx_bytes": float64(650)},
+	}, result)
+
+	result, err = Apply(nil, Options{Aggregate: map[string][]string{"sum": {"rx_bytes"}}})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"count": float64(0), "sum_rx_bytes": float64(0)}}, result)
+}
+
+func TestApplyPage_GroupsArePaginated(t *testing.T) {
+	result, page, err := ApplyPage(testClients, Options{
+		GroupBy: []string{"network_id"},
+		Limit:   1,
+		Fields:  []string{"network_id"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"network_id": "lan"}}, result)
+	assert.Equal(t, Page{Total: 3, Returned: 1, NextOffset: ptr(1)}, page)
+}
+
+func TestApply_GroupByNestedPath(t *testing.T) {
+	// A switch counts once in each PoE mode its ports use
+	result, err := Apply(testDevices, Options{GroupBy: []string{"port_table[*].poe_mode"}})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{"port_table[*].poe_mode": "off", "count": float64(2)},
+		{"port_table[*].poe_mode": "auto", "count": float64(1)},
+		{"port_table[*].poe_mode": nil, "count": float64(1)},
+	}, result)
+}
+
+func TestApplyFacets(t *testing.T) {
+	facets, err := ApplyFacets(testClients, Options{
+		Filter: map[string]any{"name": map[string]any{"ne": "phone"}},
+		Facets: []string{"network_id", "is_wired", "nope"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"network_id": []any{
+			map[string]any{"network_id": "lan", "count": float64(2)},
+			map[string]any{"network_id": "iot", "count": float64(1)},
+		},
+		"is_wired": []any{
+			map[string]any{"is_wired": true, "count": float64(2)},
+			map[string]any{"is_wired": false, "count": float64(1)},
+		},
+		"nope": []any{},
+	}, facets)
+}
+
+func TestAggregationErrors(t *testing.T) {
+	_, err := Apply(testClients, Options{Aggregate: map[string][]string{"median": {"rx_bytes"}}})
+	assert.ErrorContains(t, err, `invalid aggregate: unknown operation "median" (supported operations: sum, min, max, avg)`)
+
+	_, err = Apply(testClients, Options{GroupBy: []string{"a["}})
+	assert.ErrorContains(t, err, `invalid group_by: invalid path "a["`)
+
+	_, err = ApplyFacets(testClients, Options{Facets: []string{"a"}, GroupBy: []string{"b"}})
+	assert.ErrorContains(t, err, "facets cannot be combined with group_by")
+
+	_, err = ApplyFacets(testClients, Options{Facets: []string{"a"}, Limit: 1})
+	assert.ErrorContains(t, err, "facets cannot be combined")
+
+	_, err = ApplyFacets(testClients, Options{Facets: []string{"a]"}})
+	assert.ErrorContains(t, err, "invalid facets")
+}
@@ -0,0 +1,136 @@
+package query
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// The IP operators compare addresses as ranges, so that a field may hold a
+// single address ("10.20.1.5"), a CIDR ("10.20.0.0/16", or "10.20.0.1/24"
+// as in a network's ip_subnet) or an address range ("10.0.0.10-10.0.0.20"
+// as in firewall group members):
+//
+//	in_cidr        the value lies within one of the operand CIDRs
+//	contains_ip    the value contains the operand address
+//	overlaps_cidr  the value shares an address with one of the operand CIDRs
+//
+// IPv4 and IPv6 ranges never match each other; IPv4-mapped IPv6 addresses
+// are read as IPv4. Values that are not addresses never match.
+
+// ipRange is an inclusive range of addresses of one family.
+type ipRange struct {
+	lo, hi netip.Addr
+}
+
+// parseIPRange reads an address, a CIDR or a "lo-hi" range.
+func parseIPRange(s string) (ipRange, bool) {
+	s = strings.TrimSpace(s)
+	if lo, hi, ok := strings.Cut(s, "-"); ok {
+		a, errA := netip.ParseAddr(strings.TrimSpace(lo))
+		b, errB := netip.ParseAddr(strings.TrimSpace(hi))
+		if errA != nil || errB != nil {
+			return ipRange{}, false
+		}
+		a, b = a.Unmap(), b.Unmap()
+		if a.Is4() != b.Is4() || a.Compare(b) > 0 {
+			return ipRange{}, false
+		}
+		return ipRange{lo: a, hi: b}, true
+	}
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return ipRange{}, false
+		}
+		p = p.Masked()
+		return ipRange{lo: p.Addr(), hi: lastAddr(p)}, true
+	}
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return ipRange{}, false
+	}
+	a = a.WithZone("").Unmap()
+	return ipRange{lo: a, hi: a}, true
+}
+
+// lastAddr returns the last address of a masked prefix.
+func lastAddr(p netip.Prefix) netip.Addr {
+	b := p.Addr().AsSlice()
+	for i := p.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - i%8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+func (r ipRange) sameFamily(o ipRange) bool {
+	return r.lo.Is4() == o.lo.Is4()
+}
+
+// within reports whether r lies within o.
+func (r ipRange) within(o ipRange) bool {
+	return r.sameFamily(o) && o.lo.Compare(r.lo) <= 0 && r.hi.Compare(o.hi) <= 0
+}
+
+// overlaps reports whether r and o share an address.
+func (r ipRange) overlaps(o ipRange) bool {
+	return r.sameFamily(o) && r.lo.Compare(o.hi) <= 0 && o.lo.Compare(r.hi) <= 0
+}
+
+// compileIPOperator compiles in_cidr, contains_ip and overlaps_cidr.
+func compileIPOperator(op string, arg any) (condition, error) {
+	var operands []ipRange
+	if op == "contains_ip" {
+		s, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("contains_ip requires an IP address string, got %s", typeName(arg))
+		}
+		r, ok := parseIPRange(s)
+		if !ok || r.lo != r.hi {
+			return nil, fmt.Errorf("contains_ip: invalid IP address %q", s)
+		}
+		operands = append(operands, r)
+	} else {
+		list, ok := arg.([]any)
+		if !ok {
+			list = []any{arg}
+		}
+		for _, v := range list {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s requires a CIDR string or an array of them, got %s", op, typeName(v))
+			}
+			r, ok := parseIPRange(s)
+			if !ok {
+				return nil, fmt.Errorf("%s: invalid CIDR %q", op, s)
+			}
+			operands = append(operands, r)
+		}
+		if len(operands) == 0 {
+			return nil, fmt.Errorf("%s requires at least one CIDR", op)
+		}
+	}
+
+	match := map[string]func(value, operand ipRange) bool{
+		"in_cidr":       ipRange.within,
+		"contains_ip":   func(value, operand ipRange) bool { return operand.within(value) },
+		"overlaps_cidr": ipRange.overlaps,
+	}[op]
+	return anyValue(func(value any) bool {
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		r, ok := parseIPRange(s)
+		if !ok {
+			return false
+		}
+		for _, operand := range operands {
+			if match(r, operand) {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
@@ -0,0 +1,102 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIPRange(t *testing.T) {
+	tests := []struct {
+		input  string
+		lo, hi string
+	}{
+		{"10.20.1.5", "10.20.1.5", "10.20.1.5"},
+		{"10.20.0.0/16", "10.20.0.0", "10.20.255.255"},
+		{"192.168.1.1/24", "192.168.1.0", "192.168.1.255"},
+		{"10.0.0.10-10.0.0.20", "10.0.0.10", "10.0.0.20"},
+		{"10.0.0.10 - 10.0.0.20", "10.0.0.10", "10.0.0.20"},
+		{"::ffff:10.1.2.3", "10.1.2.3", "10.1.2.3"},
+		{"fd00:1::/64", "fd00:1::", "fd00:1::ffff:ffff:ffff:ffff"},
+		{"fe80::1%eth0", "fe80::1", "fe80::1"},
+		{"0.0.0.0/0", "0.0.0.0", "255.255.255.255"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, ok := parseIPRange(tt.input)
+			require.True(t, ok)
+			assert.Equal(t, tt.lo, r.lo.String())
+			assert.Equal(t, tt.hi, r.hi.String())
+		})
+	}
+
+	for _, input := range []string{"", "host.example.com", "10.0.0.256", "10.0.0.0/33", "10.0.0.20-10.0.0.10", "10.0.0.1-::1", "aa:bb:cc:dd:ee:ff"} {
+		_, ok := parseIPRange(input)
+		assert.False(t, ok, input)
+	}
+}
+
+func TestApply_FilterIPOperators(t *testing.T) {
+	items := []map[string]any{
+		{"name": "nas", "fixed_ip": "10.20.1.5"},
+		{"name": "printer", "fixed_ip": "10.30.0.9"},
+		{"name": "lan", "ip_subnet": "10.20.0.1/16"},
+		{"name": "v6", "ip_subnet": "fd00:20::1/64", "fixed_ip": "fd00:20::5"},
+		{"name": "servers", "group_members": []any{"192.168.1.0/28", "192.168.1.100"}},
+		{"name": "dhcp", "group_members": []any{"192.168.1.5-192.168.1.9"}},
+		{"name": "mac", "fixed_ip": "aa:bb:cc:dd:ee:ff"},
+		{"name": "none"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   map[string]any
+		expected []any
+	}{
+		{"in_cidr", map[string]any{"fixed_ip": map[string]any{"in_cidr": "10.20.0.0/16"}}, []any{"nas"}},
+		{"in_cidr any of", map[string]any{"fixed_ip": map[string]any{"in_cidr": []any{"10.20.0.0/16", "10.30.0.0/24"}}}, []any{"nas", "printer"}},
+		{"in_cidr ipv6", map[string]any{"fixed_ip": map[string]any{"in_cidr": "fd00::/16"}}, []any{"v6"}},
+		{"in_cidr subnet", map[string]any{"ip_subnet": map[string]any{"in_cidr": "10.0.0.0/8"}}, []any{"lan"}},
+		{"in_cidr larger subnet", map[string]any{"ip_subnet": map[string]any{"in_cidr": "10.20.1.0/24"}}, nil},
+		{"contains_ip subnet", map[string]any{"ip_subnet": map[string]any{"contains_ip": "10.20.200.3"}}, []any{"lan"}},
+		{"contains_ip array", map[string]any{"group_members": map[string]any{"contains_ip": "192.168.1.5"}}, []any{"servers", "dhcp"}},
+		{"contains_ip exact", map[string]any{"group_members": map[string]any{"contains_ip": "192.168.1.100"}}, []any{"servers"}},
+		{"contains_ip ipv6", map[string]any{"ip_subnet": map[string]any{"contains_ip": "fd00:20::abcd"}}, []any{"v6"}},
+		{"overlaps_cidr", map[string]any{"group_members": map[string]any{"overlaps_cidr": "192.168.1.8/29"}}, []any{"servers", "dhcp"}},
+		{"overlaps_cidr families", map[string]any{"ip_subnet": map[string]any{"overlaps_cidr": []any{"10.20.5.0/24", "fd00:20::/48"}}}, []any{"lan", "v6"}},
+		{"not in_cidr", map[string]any{"fixed_ip": map[string]any{"not": map[string]any{"in_cidr": "10.0.0.0/8"}}}, []any{"lan", "v6", "servers", "dhcp", "mac", "none"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Apply(items, Options{Filter: tt.filter})
+			require.NoError(t, err)
+			var names []any
+			for _, item := range result {
+				names = append(names, item["name"])
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestApply_FilterIPOperatorErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  map[string]any
+		wantErr string
+	}{
+		{"in_cidr number", map[string]any{"ip": map[string]any{"in_cidr": float64(10)}}, "in_cidr requires a CIDR string or an array of them, got number"},
+		{"in_cidr invalid", map[string]any{"ip": map[string]any{"in_cidr": "10.0.0.0/40"}}, `in_cidr: invalid CIDR "10.0.0.0/40"`},
+		{"overlaps_cidr empty", map[string]any{"ip": map[string]any{"overlaps_cidr": []any{}}}, "overlaps_cidr requires at least one CIDR"},
+		{"overlaps_cidr element", map[string]any{"ip": map[string]any{"overlaps_cidr": []any{"10.0.0.0/8", true}}}, "got boolean"},
+		{"contains_ip array", map[string]any{"ip": map[string]any{"contains_ip": []any{"10.0.0.1"}}}, "contains_ip requires an IP address string, got array"},
+		{"contains_ip cidr", map[string]any{"ip": map[string]any{"contains_ip": "10.0.0.0/8"}}, `contains_ip: invalid IP address "10.0.0.0/8"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Apply(testItems, Options{Filter: tt.filter})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
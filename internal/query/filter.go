@@ -0,0 +1,382 @@
+package query
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A filter maps fields to conditions, all of which must hold, and may group
+// nested filters with {"$or": [...]} and {"$and": [...]}. Fields are paths
+// (see path.go); a condition on a path that addresses several values, such
+// as "port_table[*].poe_mode", or on an array, holds if any value matches.
+// A condition is either a plain value, matched exactly, or an operator
+// object such as {"gte": 10, "lt": 20}, whose operators must all hold:
+//
+//	eq, ne              equal / not equal (ne also matches a missing field)
+//	contains            case-insensitive substring
+//	regex               RE2 regular expression
+//	gt, gte, lt, lte    numeric, timestamp or natural string comparison
+//	in, not_in          value is / is not one of an array of values
+//	exists              field is present and not null (true) or not (false)
+//	not                 negation of a nested condition
+//	in_cidr, contains_ip, overlaps_cidr
+//	                    IPv4/IPv6 address and CIDR matching (see cidr.go)
+//
+// The negations ne, not_in, exists: false and not hold when the positive
+// condition does not, i.e. when no value matches it.
+//
+// Filters are compiled once per query so that invalid operators and
+// regular expressions are reported to the caller rather than silently
+// matching nothing.
+
+// matcher reports whether an item matches a compiled filter.
+type matcher func(item map[string]any) bool
+
+// condition reports whether the values a field path addresses match. values
+// is empty when the item has no such field.
+type condition func(values []any) bool
+
+// operators lists the supported operators, in the order they are documented.
+var operators = []string{"eq", "ne", "contains", "regex", "gt", "gte", "lt", "lte", "in", "not_in", "exists", "not", "in_cidr", "contains_ip", "overlaps_cidr"}
+
+// compileFilter compiles a filter argument into a matcher. The keys "$or"
+// and "$and" hold arrays of nested filters, of which any or all must match.
+func compileFilter(filter map[string]any) (matcher, error) {
+	keys := slices.Sorted(maps.Keys(filter))
+	matchers := make([]matcher, len(keys))
+	for i, key := range keys {
+		var err error
+		if strings.HasPrefix(key, "$") {
+			matchers[i], err = compileGroup(key, filter[key])
+		} else {
+			matchers[i], err = compileField(key, filter[key])
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return allOf(matchers), nil
+}
+
+// compileGroup compiles a "$or" or "$and" group.
+func compileGroup(key string, arg any) (matcher, error) {
+	if key != "$or" && key != "$and" {
+		return nil, fmt.Errorf("invalid filter: unknown group %q (supported groups: $or, $and)", key)
+	}
+	arr, ok := arg.([]any)
+	if !ok || len(arr) == 0 {
+		return nil, fmt.Errorf("invalid filter: %s requires a non-empty array of filters, got %s", key, typeName(arg))
+	}
+	matchers := make([]matcher, len(arr))
+	for i, elem := range arr {
+		filter, ok := elem.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid filter: %s[%d] must be a filter object, got %s", key, i, typeName(elem))
+		}
+		m, err := compileFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		matchers[i] = m
+	}
+	if key == "$and" {
+		return allOf(matchers), nil
+	}
+	return func(item map[string]any) bool {
+		for _, m := range matchers {
+			if m(item) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// compileField compiles the condition on one field path.
+func compileField(field string, arg any) (matcher, error) {
+	p, err := parsePath(field)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	cond, err := compileCondition(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter for field %q: %w", field, err)
+	}
+	// The matcher is used by one goroutine at a time, so it reuses one
+	// buffer for the values of every item.
+	var buf []any
+	return func(item map[string]any) bool {
+		buf = buf[:0]
+		if p.isKey() {
+			if v, ok := item[field]; ok {
+				buf = append(buf, v)
+			}
+		} else {
+			buf = p.values(item, buf)
+		}
+		return cond(buf)
+	}, nil
+}
+
+// anyValue returns a condition that holds if match holds for any value. An
+// array value matches as a whole or by any element, so {"tags": ["a", "b"]}
+// matches ["a", "b"] and {"group_ids": "g1"} matches ["g1", "g2"].
+func anyValue(match func(value any) bool) condition {
+	return func(values []any) bool {
+		return slices.ContainsFunc(values, func(value any) bool {
+			if match(value) {
+				return true
+			}
+			arr, ok := value.([]any)
+			return ok && slices.ContainsFunc(arr, match)
+		})
+	}
+}
+
+// negate returns a condition that holds if cond does not.
+func negate(cond condition) condition {
+	return func(values []any) bool { return !cond(values) }
+}
+
+func allOf(matchers []matcher) matcher {
+	if len(matchers) == 1 {
+		return matchers[0]
+	}
+	return func(item map[string]any) bool {
+		for _, m := range matchers {
+			if !m(item) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// compileCondition compiles a plain value or an operator object.
+func compileCondition(arg any) (condition, error) {
+	ops, ok := arg.(map[string]any)
+	if !ok {
+		return equals(arg), nil
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("empty operator object (supported operators: %s)", strings.Join(operators, ", "))
+	}
+	names := slices.Sorted(maps.Keys(ops))
+	conds := make([]condition, len(names))
+	for i, op := range names {
+		cond, err := compileOperator(op, ops[op])
+		if err != nil {
+			return nil, err
+		}
+		conds[i] = cond
+	}
+	if len(conds) == 1 {
+		return conds[0], nil
+	}
+	return func(values []any) bool {
+		for _, cond := range conds {
+			if !cond(values) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func compileOperator(op string, arg any) (condition, error) {
+	switch op {
+	case "eq":
+		return equals(arg), nil
+	case "ne":
+		return negate(equals(arg)), nil
+	case "contains":
+		s, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("contains requires a string, got %s", typeName(arg))
+		}
+		s = strings.ToLower(s)
+		return anyValue(func(value any) bool {
+			return strings.Contains(strings.ToLower(valueString(value)), s)
+		}), nil
+	case "regex":
+		s, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("regex requires a string, got %s", typeName(arg))
+		}
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+		return anyValue(func(value any) bool {
+			return re.MatchString(valueString(value))
+		}), nil
+	case "gt":
+		return compileComparison(op, arg, func(c int) bool { return c > 0 })
+	case "gte":
+		return compileComparison(op, arg, func(c int) bool { return c >= 0 })
+	case "lt":
+		return compileComparison(op, arg, func(c int) bool { return c < 0 })
+	case "lte":
+		return compileComparison(op, arg, func(c int) bool { return c <= 0 })
+	case "in", "not_in":
+		arr, ok := arg.([]any)
+		if !ok {
+			return nil, fmt.Errorf("%s requires an array, got %s", op, typeName(arg))
+		}
+		set := make(map[string]struct{}, len(arr))
+		for _, v := range arr {
+			set[valueString(v)] = struct{}{}
+		}
+		in := anyValue(func(value any) bool {
+			_, found := set[valueString(value)]
+			return found
+		})
+		if op == "not_in" {
+			return negate(in), nil
+		}
+		return in, nil
+	case "exists":
+		want, ok := arg.(bool)
+		if !ok {
+			return nil, fmt.Errorf("exists requires true or false, got %s", typeName(arg))
+		}
+		exists := anyValue(func(value any) bool { return value != nil })
+		if !want {
+			return negate(exists), nil
+		}
+		return exists, nil
+	case "not":
+		cond, err := compileCondition(arg)
+		if err != nil {
+			return nil, fmt.Errorf("not: %w", err)
+		}
+		return negate(cond), nil
+	case "in_cidr", "contains_ip", "overlaps_cidr":
+		return compileIPOperator(op, arg)
+	}
+	return nil, fmt.Errorf("unknown operator %q (supported operators: %s)", op, strings.Join(operators, ", "))
+}
+
+// equals matches values whose string forms are equal, so 24 matches "24".
+func equals(want any) condition {
+	s := valueString(want)
+	return anyValue(func(value any) bool {
+		return valueString(value) == s
+	})
+}
+
+func valueString(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// compileComparison compiles an ordering operator. A numeric operand
+// compares numerically, as does a string holding a number against numeric
+// values; a timestamp operand (RFC 3339 or a date) compares against
+// timestamps, with numeric field values read as Unix seconds or
+// milliseconds; any other string operand compares with the natural string
+// order used for sorting. Values that cannot be compared with the operand
+// never match.
+func compileComparison(op string, arg any, ok func(int) bool) (condition, error) {
+	switch operand := arg.(type) {
+	case float64:
+		return anyValue(func(value any) bool {
+			n, isNum := numberValue(value)
+			return isNum && ok(cmp.Compare(n, operand))
+		}), nil
+	case string:
+		if n, err := strconv.ParseFloat(operand, 64); err == nil {
+			// "6.9" compares numerically with numbers, naturally with
+			// versions such as "6.10.1"
+			return anyValue(func(value any) bool {
+				if v, isNum := numberValue(value); isNum {
+					return ok(cmp.Compare(v, n))
+				}
+				s, isString := value.(string)
+				return isString && ok(naturalCompare(s, operand))
+			}), nil
+		}
+		if t, isTime := parseTime(operand); isTime {
+			return anyValue(func(value any) bool {
+				vt, isTime := timeValue(value)
+				return isTime && ok(vt.Compare(t))
+			}), nil
+		}
+		return anyValue(func(value any) bool {
+			s, isString := value.(string)
+			return isString && ok(naturalCompare(s, operand))
+		}), nil
+	}
+	return nil, fmt.Errorf("%s requires a number, timestamp or string, got %s", op, typeName(arg))
+}
+
+// numberValue reads a number, or a string holding one, as UniFi stores some
+// numeric fields as strings.
+func numberValue(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// timeLayouts are the timestamp formats accepted in filters. Layouts
+// without a zone are read as UTC.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", time.DateOnly}
+
+func parseTime(s string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// msThreshold separates Unix timestamps in seconds from ones in
+// milliseconds: 1e11 seconds is in the year 5138, 1e11 milliseconds in 1973.
+const msThreshold = 1e11
+
+// timeValue reads a timestamp string or a Unix timestamp in seconds or
+// milliseconds.
+func timeValue(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case float64:
+		if v >= msThreshold {
+			return time.UnixMilli(int64(v)), true
+		}
+		return time.Unix(int64(v), 0), true
+	case string:
+		return parseTime(v)
+	}
+	return time.Time{}, false
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,226 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A path addresses values nested in an item, e.g. "uplink.speed",
+// "port_table[*].poe_mode" or "radio_table[0].channel". A key step applied
+// to an array applies to each of its elements, so "port_table.poe_mode" is
+// the same as "port_table[*].poe_mode". A plain key is a path of one step.
+type path []step
+
+// step is one key or array index of a path.
+type step struct {
+	key     string
+	index   int // for an index step; -1 for [*]
+	isIndex bool
+}
+
+// parsePath parses a dot/bracket path.
+func parsePath(s string) (path, error) {
+	if !strings.ContainsAny(s, ".[]") {
+		return path{{key: s}}, nil
+	}
+	var p path
+	rest := s
+	for {
+		end := strings.IndexAny(rest, ".[")
+		if end < 0 {
+			end = len(rest)
+		}
+		key := rest[:end]
+		if key == "" || strings.Contains(key, "]") {
+			return nil, fmt.Errorf("invalid path %q: expected a field name", s)
+		}
+		p = append(p, step{key: key})
+		rest = rest[end:]
+
+		for strings.HasPrefix(rest, "[") {
+			closing := strings.IndexByte(rest, ']')
+			if closing < 0 {
+				return nil, fmt.Errorf("invalid path %q: missing ]", s)
+			}
+			idx := rest[1:closing]
+			switch n, err := strconv.Atoi(idx); {
+			case idx == "*":
+				p = append(p, step{index: -1, isIndex: true})
+			case err == nil && n >= 0:
+				p = append(p, step{index: n, isIndex: true})
+			default:
+				return nil, fmt.Errorf("invalid path %q: index must be * or a non-negative integer, got %q", s, idx)
+			}
+			rest = rest[closing+1:]
+		}
+
+		if rest == "" {
+			return p, nil
+		}
+		if rest[0] != '.' {
+			return nil, fmt.Errorf("invalid path %q: expected . or [ after ]", s)
+		}
+		rest = rest[1:]
+	}
+}
+
+// compilePaths parses the paths of a parameter.
+func compilePaths(param string, fields []string) ([]path, error) {
+	paths := make([]path, len(fields))
+	for i, field := range fields {
+		p, err := parsePath(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", param, err)
+		}
+		paths[i] = p
+	}
+	return paths, nil
+}
+
+// isKey reports whether the path is a single top-level key.
+func (p path) isKey() bool {
+	return len(p) == 1 && !p[0].isIndex
+}
+
+// values appends the values p addresses in v to out. Missing keys and
+// out-of-range indexes address nothing.
+func (p path) values(v any, out []any) []any {
+	if len(p) == 0 {
+		return append(out, v)
+	}
+	s := p[0]
+	switch v := v.(type) {
+	case map[string]any:
+		if x, ok := v[s.key]; ok && !s.isIndex {
+			return p[1:].values(x, out)
+		}
+	case []any:
+		switch {
+		case !s.isIndex:
+			for _, elem := range v {
+				out = p.values(elem, out)
+			}
+		case s.index < 0:
+			for _, elem := range v {
+				out = p[1:].values(elem, out)
+			}
+		case s.index < len(v):
+			out = p[1:].values(v[s.index], out)
+		}
+	}
+	return out
+}
+
+// first returns the first value p addresses in item, or nil.
+func (p path) first(item map[string]any) any {
+	if p.isKey() {
+		return item[p[0].key]
+	}
+	if values := p.values(item, nil); len(values) > 0 {
+		return values[0]
+	}
+	return nil
+}
+
+// projection is a tree of the paths kept by a fields projection.
+type projection struct {
+	whole   bool // keep the value as is
+	keys    map[string]*projection
+	all     *projection         // [*]
+	indexes map[int]*projection // [n]
+}
+
+// newProjection builds the projection keeping the given paths.
+func newProjection(paths []path) *projection {
+	root := &projection{}
+	for _, p := range paths {
+		node := root
+		for _, s := range p {
+			node = node.child(s)
+		}
+		node.whole = true
+	}
+	return root
+}
+
+func (n *projection) child(s step) *projection {
+	switch {
+	case !s.isIndex:
+		if n.keys == nil {
+			n.keys = make(map[string]*projection)
+		}
+		if n.keys[s.key] == nil {
+			n.keys[s.key] = &projection{}
+		}
+		return n.keys[s.key]
+	case s.index < 0:
+		if n.all == nil {
+			n.all = &projection{}
+		}
+		return n.all
+	default:
+		if n.indexes == nil {
+			n.indexes = make(map[int]*projection)
+		}
+		if n.indexes[s.index] == nil {
+			n.indexes[s.index] = &projection{}
+		}
+		return n.indexes[s.index]
+	}
+}
+
+// object projects an object, keeping the keys that are present.
+func (n *projection) object(m map[string]any) map[string]any {
+	out := make(map[string]any, len(n.keys))
+	for key, child := range n.keys {
+		if v, ok := m[key]; ok {
+			if projected, ok := child.apply(v); ok {
+				out[key] = projected
+			}
+		}
+	}
+	return out
+}
+
+// apply projects v, reporting false if nothing of v is kept. Nested objects
+// and array elements without any of the projected keys are left out.
+func (n *projection) apply(v any) (any, bool) {
+	if n.whole {
+		return v, true
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		if len(n.keys) == 0 {
+			return nil, false
+		}
+		out := n.object(v)
+		return out, len(out) > 0
+	case []any:
+		if n.all == nil && len(n.indexes) == 0 {
+			// A key step on an array applies to each element
+			out := make([]any, 0, len(v))
+			for _, elem := range v {
+				if projected, ok := n.apply(elem); ok {
+					out = append(out, projected)
+				}
+			}
+			return out, true
+		}
+		out := make([]any, 0, len(v))
+		for i, elem := range v {
+			child := n.all
+			if c, ok := n.indexes[i]; ok {
+				child = c
+			}
+			if child == nil {
+				continue
+			}
+			if projected, ok := child.apply(elem); ok {
+				out = append(out, projected)
+			}
+		}
+		return out, true
+	}
+	return nil, false
+}
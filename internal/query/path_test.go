@@ -0,0 +1,177 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDevices = []map[string]any{
+	{
+		"name":   "switch-1",
+		"uplink": map[string]any{"speed": float64(1000), "type": "wire"},
+		"port_table": []any{
+			map[string]any{"port_idx": float64(1), "poe_mode": "auto", "poe_power": "4.5", "name": "Port 1"},
+			map[string]any{"port_idx": float64(2), "poe_mode": "off", "name": "Port 2"},
+		},
+		"group_ids": []any{"g1", "g2"},
+	},
+	{
+		"name":   "switch-2",
+		"uplink": map[string]any{"speed": float64(100), "type": "wire"},
+		"port_table": []any{
+			map[string]any{"port_idx": float64(1), "poe_mode": "off", "name": "Uplink"},
+		},
+	},
+	{
+		"name":        "ap-1",
+		"radio_table": []any{map[string]any{"radio": "ng", "channel": float64(6)}, map[string]any{"radio": "na", "channel": float64(36)}},
+	},
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected path
+	}{
+		{"name", path{{key: "name"}}},
+		{"uplink.speed", path{{key: "uplink"}, {key: "speed"}}},
+		{"port_table[*].poe_mode", path{{key: "port_table"}, {index: -1, isIndex: true}, {key: "poe_mode"}}},
+		{"radio_table[1].channel", path{{key: "radio_table"}, {index: 1, isIndex: true}, {key: "channel"}}},
+		{"a[0][*]", path{{key: "a"}, {index: 0, isIndex: true}, {index: -1, isIndex: true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			p, err := parsePath(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, p)
+		})
+	}
+}
+
+func TestParsePath_Errors(t *testing.T) {
+	tests := []struct {
+		in      string
+		wantErr string
+	}{
+		{"a..b", "expected a field name"},
+		{".a", "expected a field name"},
+		{"a.", "expected a field name"},
+		{"a[", "missing ]"},
+		{"a[x]", `index must be * or a non-negative integer, got "x"`},
+		{"a[-1]", "index must be * or a non-negative integer"},
+		{"a[0]b", "expected . or [ after ]"},
+		{"[0]", "expected a field name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := parsePath(tt.in)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func deviceNames(items []map[string]any) []any {
+	var names []any
+	for _, item := range items {
+		names = append(names, item["name"])
+	}
+	return names
+}
+
+func TestApply_FilterNestedPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   map[string]any
+		expected []any
+	}{
+		{"object path", map[string]any{"uplink.speed": map[string]any{"gte": float64(1000)}}, []any{"switch-1"}},
+		{"any element", map[string]any{"port_table[*].poe_mode": "off"}, []any{"switch-1", "switch-2"}},
+		{"implicit wildcard", map[string]any{"port_table.poe_mode": "auto"}, []any{"switch-1"}},
+		{"numeric string element", map[string]any{"port_table[*].poe_power": map[string]any{"gt": float64(1)}}, []any{"switch-1"}},
+		{"index", map[string]any{"radio_table[1].channel": float64(36)}, []any{"ap-1"}},
+		{"index out of range", map[string]any{"radio_table[5].channel": map[string]any{"exists": true}}, nil},
+		{"no element matches", map[string]any{"port_table[*].poe_mode": map[string]any{"ne": "off"}}, []any{"ap-1"}},
+		{"array field", map[string]any{"group_ids": "g2"}, []any{"switch-1"}},
+		{"whole array field", map[string]any{"group_ids": []any{"g1", "g2"}}, []any{"switch-1"}},
+		{"ne whole array field", map[string]any{"group_ids": map[string]any{"ne": []any{"g1", "g2"}}}, []any{"switch-2", "ap-1"}},
+		{"not_in array field", map[string]any{"group_ids": map[string]any{"not_in": []any{"g1"}}}, []any{"switch-2", "ap-1"}},
+		{"missing path", map[string]any{"port_table[*].poe_mode": map[string]any{"exists": false}}, []any{"ap-1"}},
+		{"in group", map[string]any{"$or": []any{
+			map[string]any{"radio_table[*].radio": "na"},
+			map[string]any{"uplink.speed": float64(100)},
+		}}, []any{"switch-2", "ap-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Apply(testDevices, Options{Filter: tt.filter})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, deviceNames(result))
+		})
+	}
+
+	_, err := Apply(testDevices, Options{Filter: map[string]any{"port_table[x]": "a"}})
+	assert.ErrorContains(t, err, `invalid filter: invalid path "port_table[x]"`)
+}
+
+func TestApply_SearchNested(t *testing.T) {
+	result, err := Apply(testDevices, Options{Search: "uplink"})
+	require.NoError(t, err)
+	assert.Equal(t, []any{"switch-2"}, deviceNames(result))
+}
+
+func TestApply_SortNested(t *testing.T) {
+	result, err := Apply(testDevices, Options{Sort: []SortKey{{Field: "uplink.speed"}}})
+	require.NoError(t, err)
+	assert.Equal(t, []any{"switch-2", "switch-1", "ap-1"}, deviceNames(result))
+
+	_, err = Apply(testDevices, Options{Sort: []SortKey{{Field: "uplink..speed"}}})
+	assert.ErrorContains(t, err, "invalid sort: invalid path")
+}
+
+func TestApply_FieldsNested(t *testing.T) {
+	result, err := Apply(testDevices[:2], Options{Fields: []string{
+		"name", "uplink.speed", "port_table[*].poe_mode", "port_table[*].port_idx",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{
+			"name":   "switch-1",
+			"uplink": map[string]any{"speed": float64(1000)},
+			"port_table": []any{
+				map[string]any{"port_idx": float64(1), "poe_mode": "auto"},
+				map[string]any{"port_idx": float64(2), "poe_mode": "off"},
+			},
+		},
+		{
+			"name":   "switch-2",
+			"uplink": map[string]any{"speed": float64(100)},
+			"port_table": []any{
+				map[string]any{"port_idx": float64(1), "poe_mode": "off"},
+			},
+		},
+	}, result)
+}
+
+func TestApply_FieldsNestedIndexAndMissing(t *testing.T) {
+	result, err := Apply(testDevices, Options{Fields: []string{"name", "radio_table[1]", "uplink.nope", "port_table.name"}})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{
+			"name":       "switch-1",
+			"port_table": []any{map[string]any{"name": "Port 1"}, map[string]any{"name": "Port 2"}},
+		},
+		{
+			"name":       "switch-2",
+			"port_table": []any{map[string]any{"name": "Uplink"}},
+		},
+		{
+			"name":        "ap-1",
+			"radio_table": []any{map[string]any{"radio": "na", "channel": float64(36)}},
+		},
+	}, result)
+
+	_, err = Apply(testDevices, Options{Fields: []string{"port_table["}})
+	assert.ErrorContains(t, err, `invalid fields: invalid path "port_table["`)
+}
@@ -1,27 +1,49 @@
 // Package query provides generic post-processing for list operation results.
-// It supports field filtering, text search, and field projection.
+// It supports field filtering, text search, aggregation, sorting, pagination
+// and field projection.
 package query
 
 import (
 	"fmt"
-	"regexp"
+	"slices"
 	"strings"
 )
 
 // Options holds query parameters extracted from MCP request arguments.
 type Options struct {
-	Filter map[string]any // field -> value | {"contains":"..."} | {"regex":"..."}
-	Search string         // case-insensitive text search across top-level string values
+	Filter map[string]any // field -> value | {operator: operand, ...}, see filter.go
+	Search string         // case-insensitive text search across string values
+	Sort   []SortKey      // sort order, most significant first
+	Limit  int            // maximum number of items returned (0 = no limit)
+	Offset int            // number of matching items skipped
 	Fields []string       // field projection (nil = all fields)
+
+	GroupBy   []string            // fields to group by, see aggregate.go
+	Aggregate map[string][]string // aggregate operation -> fields
+	Facets    []string            // fields to count distinct values of
+}
+
+// SortKey is one field of a sort order.
+type SortKey struct {
+	Field string
+	Desc  bool
 }
 
 // HasQuery returns true if any query parameters are set.
 func (o Options) HasQuery() bool {
-	return len(o.Filter) > 0 || o.Search != "" || len(o.Fields) > 0
+	return len(o.Filter) > 0 || o.Search != "" || len(o.Sort) > 0 || o.Paginated() || len(o.Fields) > 0 ||
+		o.Grouped() || len(o.Facets) > 0
+}
+
+// Paginated returns true if a limit or offset is set. Paginated responses
+// report a Page alongside the items.
+func (o Options) Paginated() bool {
+	return o.Limit > 0 || o.Offset > 0
 }
 
-// ParseOptions extracts query options from MCP request arguments.
-func ParseOptions(args map[string]any) Options {
+// ParseOptions extracts query options from MCP request arguments. It returns
+// an error if limit or offset is not a non-negative integer.
+func ParseOptions(args map[string]any) (Options, error) {
 	var opts Options
 	if f, ok := args["filter"].(map[string]any); ok {
 		opts.Filter = f
@@ -29,6 +51,14 @@ func ParseOptions(args map[string]any) Options {
 	if s, ok := args["search"].(string); ok {
 		opts.Search = s
 	}
+	opts.Sort = parseSort(args["sort"])
+	var err error
+	if opts.Limit, err = nonNegativeInt("limit", args); err != nil {
+		return Options{}, err
+	}
+	if opts.Offset, err = nonNegativeInt("offset", args); err != nil {
+		return Options{}, err
+	}
 	if arr, ok := args["fields"].([]any); ok {
 		for _, v := range arr {
 			if s, ok := v.(string); ok {
@@ -36,74 +66,199 @@ func ParseOptions(args map[string]any) Options {
 			}
 		}
 	}
-	return opts
+	opts.GroupBy = stringList(args["group_by"])
+	if agg, ok := args["aggregate"].(map[string]any); ok {
+		for op, fields := range agg {
+			if list := stringList(fields); len(list) > 0 {
+				if opts.Aggregate == nil {
+					opts.Aggregate = make(map[string][]string)
+				}
+				opts.Aggregate[op] = list
+			}
+		}
+	}
+	opts.Facets = stringList(args["facets"])
+	return opts, nil
 }
 
-// Apply applies filter, search, and fields operations to a slice of items.
-// Order: filter → search → fields (projection last so filter/search see all fields).
-func Apply(items []map[string]any, opts Options) []map[string]any {
-	if len(items) == 0 {
-		return items
+// stringList reads a string or an array of strings, skipping other
+// elements.
+func stringList(arg any) []string {
+	switch v := arg.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var list []string
+		for _, elem := range v {
+			if s, ok := elem.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
 	}
+	return nil
+}
 
-	result := items
+// parseSort reads a sort argument: a field name or an array of them, each
+// optionally prefixed with "-" for descending order.
+func parseSort(arg any) []SortKey {
+	var keys []SortKey
+	for _, name := range stringList(arg) {
+		key := SortKey{Field: strings.TrimSpace(name)}
+		if rest, ok := strings.CutPrefix(key.Field, "-"); ok {
+			key = SortKey{Field: rest, Desc: true}
+		}
+		if key.Field != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
 
-	if len(opts.Filter) > 0 {
-		result = applyFilter(result, opts.Filter)
+// nonNegativeInt reads an optional JSON number argument as an int.
+func nonNegativeInt(key string, args map[string]any) (int, error) {
+	arg, ok := args[key]
+	if !ok || arg == nil {
+		return 0, nil
 	}
-	if opts.Search != "" {
-		result = applySearch(result, opts.Search)
+	f, ok := arg.(float64)
+	if !ok {
+		return 0, fmt.Errorf("invalid %s: must be a non-negative integer, got %s", key, typeName(arg))
 	}
-	if len(opts.Fields) > 0 {
-		result = applyFields(result, opts.Fields)
+	if f < 0 || f != float64(int(f)) {
+		return 0, fmt.Errorf("invalid %s: must be a non-negative integer, got %v", key, f)
 	}
+	return int(f), nil
+}
 
-	return result
+// Page describes the part of the matching items a paginated query returned.
+type Page struct {
+	Total      int  // items matching filter and search, or groups
+	Returned   int  // items returned
+	NextOffset *int // offset of the next page, nil on the last page
 }
 
-func applyFilter(items []map[string]any, filter map[string]any) []map[string]any {
-	result := make([]map[string]any, 0)
-	for _, item := range items {
-		if matchesFilter(item, filter) {
-			result = append(result, item)
-		}
+// Validate returns the error ApplyPage, or ApplyFacets with facets, would
+// return for opts, so that an invalid query is rejected before the items are
+// fetched.
+func (o Options) Validate() error {
+	if len(o.Facets) > 0 {
+		_, err := ApplyFacets(nil, o)
+		return err
 	}
-	return result
+	_, _, err := ApplyPage(nil, o)
+	return err
 }
 
-func matchesFilter(item map[string]any, filter map[string]any) bool {
-	for field, filterValue := range filter {
-		fieldValue, exists := item[field]
-		if !exists {
-			return false
+// Apply applies filter, search, sort, pagination and fields operations to a
+// slice of items. It returns an error if the filter is invalid.
+func Apply(items []map[string]any, opts Options) ([]map[string]any, error) {
+	result, _, err := ApplyPage(items, opts)
+	return result, err
+}
+
+// ApplyPage is Apply, also reporting which page of the matching items was
+// returned.
+// Order: filter → search → group_by/aggregate → sort → offset/limit → fields
+// (projection last so the other steps see all fields). With group_by or
+// aggregate, the steps after grouping apply to the group rows.
+func ApplyPage(items []map[string]any, opts Options) ([]map[string]any, Page, error) {
+	if len(opts.Facets) > 0 {
+		return nil, Page{}, fmt.Errorf("query: facets are applied by ApplyFacets")
+	}
+	match, err := compileMatch(opts)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	groupBy, err := compilePaths("group_by", opts.GroupBy)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	aggs, err := compileAggregates(opts.Aggregate)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	sortKeys, err := compileSort(opts.Sort)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	var proj *projection
+	if len(opts.Fields) > 0 {
+		if proj, err = compileFields(opts.Fields); err != nil {
+			return nil, Page{}, err
 		}
-		if !matchesFieldFilter(fieldValue, filterValue) {
-			return false
+	}
+
+	result := applyMatch(items, match, opts.Search)
+	if opts.Grouped() {
+		result = applyGroupBy(result, opts.GroupBy, groupBy, aggs)
+	}
+	if len(result) > 1 && len(sortKeys) > 0 {
+		result = applySort(result, sortKeys)
+	}
+
+	page := Page{Total: len(result)}
+	if opts.Paginated() {
+		result = result[min(opts.Offset, len(result)):]
+		if opts.Limit > 0 && len(result) > opts.Limit {
+			result = result[:opts.Limit]
+			next := opts.Offset + opts.Limit
+			page.NextOffset = &next
 		}
 	}
-	return true
+	page.Returned = len(result)
+
+	if len(result) > 0 && proj != nil {
+		result = applyFields(result, proj)
+	}
+
+	return result, page, nil
 }
 
-func matchesFieldFilter(fieldValue any, filterValue any) bool {
-	// Check if filterValue is an operator object like {"contains": "..."} or {"regex": "..."}
-	if opMap, ok := filterValue.(map[string]any); ok {
-		if containsVal, ok := opMap["contains"].(string); ok {
-			fieldStr := fmt.Sprintf("%v", fieldValue)
-			return strings.Contains(strings.ToLower(fieldStr), strings.ToLower(containsVal))
-		}
-		if regexVal, ok := opMap["regex"].(string); ok {
-			fieldStr := fmt.Sprintf("%v", fieldValue)
-			matched, err := regexp.MatchString(regexVal, fieldStr)
-			if err != nil {
-				return false // invalid regex → no match
-			}
-			return matched
-		}
-		return false // unknown operator
+// ApplyFacets applies filter and search, then counts the distinct values of
+// each of the facets fields, see aggregate.go.
+func ApplyFacets(items []map[string]any, opts Options) (map[string]any, error) {
+	if opts.Grouped() || len(opts.Sort) > 0 || opts.Paginated() || len(opts.Fields) > 0 {
+		return nil, fmt.Errorf("facets cannot be combined with group_by, aggregate, sort, limit, offset or fields")
+	}
+	match, err := compileMatch(opts)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := compilePaths("facets", opts.Facets)
+	if err != nil {
+		return nil, err
+	}
+	return applyFacets(applyMatch(items, match, opts.Search), opts.Facets, paths), nil
+}
+
+// compileMatch compiles the filter, or returns nil if there is none.
+func compileMatch(opts Options) (matcher, error) {
+	if len(opts.Filter) == 0 {
+		return nil, nil
 	}
+	return compileFilter(opts.Filter)
+}
 
-	// Exact match: compare string representations
-	return fmt.Sprintf("%v", fieldValue) == fmt.Sprintf("%v", filterValue)
+// applyMatch applies the compiled filter and search to items.
+func applyMatch(items []map[string]any, match matcher, search string) []map[string]any {
+	if len(items) > 0 && match != nil {
+		items = applyFilter(items, match)
+	}
+	if len(items) > 0 && search != "" {
+		items = applySearch(items, search)
+	}
+	return items
+}
+
+func applyFilter(items []map[string]any, match matcher) []map[string]any {
+	result := make([]map[string]any, 0)
+	for _, item := range items {
+		if match(item) {
+			result = append(result, item)
+		}
+	}
+	return result
 }
 
 func applySearch(items []map[string]any, search string) []map[string]any {
@@ -117,11 +272,21 @@ func applySearch(items []map[string]any, search string) []map[string]any {
 	return result
 }
 
-func matchesSearch(item map[string]any, searchLower string) bool {
-	for _, value := range item {
-		// Only search string-typed values
-		if s, ok := value.(string); ok {
-			if strings.Contains(strings.ToLower(s), searchLower) {
+// matchesSearch reports whether any string value in v, at any depth,
+// contains searchLower.
+func matchesSearch(v any, searchLower string) bool {
+	switch v := v.(type) {
+	case string:
+		return strings.Contains(strings.ToLower(v), searchLower)
+	case map[string]any:
+		for _, value := range v {
+			if matchesSearch(value, searchLower) {
+				return true
+			}
+		}
+	case []any:
+		for _, value := range v {
+			if matchesSearch(value, searchLower) {
 				return true
 			}
 		}
@@ -129,16 +294,60 @@ func matchesSearch(item map[string]any, searchLower string) bool {
 	return false
 }
 
-func applyFields(items []map[string]any, fields []string) []map[string]any {
+// compileFields parses fields paths into a projection.
+func compileFields(fields []string) (*projection, error) {
+	paths, err := compilePaths("fields", fields)
+	if err != nil {
+		return nil, err
+	}
+	return newProjection(paths), nil
+}
+
+// applyFields projects items to the fields paths. Nested paths keep the
+// structure leading to them, e.g. "port_table[*].poe_mode" keeps
+// {"port_table": [{"poe_mode": ...}, ...]}.
+func applyFields(items []map[string]any, proj *projection) []map[string]any {
 	result := make([]map[string]any, len(items))
 	for i, item := range items {
-		projected := make(map[string]any, len(fields))
-		for _, field := range fields {
-			if val, ok := item[field]; ok {
-				projected[field] = val
-			}
-		}
-		result[i] = projected
+		result[i] = proj.object(item)
 	}
 	return result
 }
+
+// ProjectItem projects a single object, such as the result of a get tool,
+// to the fields paths. valid lists the top-level fields the object can hold;
+// a path starting with any other field is an error naming the valid ones,
+// so that a misspelt field is not mistaken for a missing value.
+func ProjectItem(item map[string]any, fields []string, valid []string) (map[string]any, error) {
+	paths, err := itemPaths(fields, valid)
+	if err != nil {
+		return nil, err
+	}
+	return newProjection(paths).object(item), nil
+}
+
+// ValidateItemFields returns the error ProjectItem would return for fields,
+// so that they are rejected before the object is fetched. valid is nil when
+// the fields of the object are not known in advance; then only the paths are
+// checked.
+func ValidateItemFields(fields []string, valid []string) error {
+	_, err := itemPaths(fields, valid)
+	return err
+}
+
+// itemPaths parses fields paths, checking that each starts with one of the
+// valid fields unless valid is nil.
+func itemPaths(fields []string, valid []string) ([]path, error) {
+	paths, err := compilePaths("fields", fields)
+	if err != nil || valid == nil {
+		return paths, err
+	}
+	for i, p := range paths {
+		if !slices.Contains(valid, p[0].key) {
+			valid = slices.Sorted(slices.Values(valid))
+			return nil, fmt.Errorf("invalid fields: unknown field %q (valid fields: %s)",
+				fields[i], strings.Join(valid, ", "))
+		}
+	}
+	return paths, nil
+}
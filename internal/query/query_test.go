@@ -1,9 +1,11 @@
 package query
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseOptions(t *testing.T) {
@@ -80,6 +82,48 @@ func TestParseOptions(t *testing.T) {
 				Fields: []string{"name", "ip"},
 			},
 		},
+		{
+			name: "sort as string",
+			args: map[string]any{
+				"sort": "-rx_bytes",
+			},
+			expected: Options{
+				Sort: []SortKey{{Field: "rx_bytes", Desc: true}},
+			},
+		},
+		{
+			name: "sort as array",
+			args: map[string]any{
+				"sort": []any{"type", 1, "-name", ""},
+			},
+			expected: Options{
+				Sort: []SortKey{{Field: "type"}, {Field: "name", Desc: true}},
+			},
+		},
+		{
+			name: "limit and offset",
+			args: map[string]any{
+				"limit":  float64(10),
+				"offset": float64(20),
+			},
+			expected: Options{
+				Limit:  10,
+				Offset: 20,
+			},
+		},
+		{
+			name: "aggregation",
+			args: map[string]any{
+				"group_by":  "network_id",
+				"aggregate": map[string]any{"sum": []any{"rx_bytes", "tx_bytes"}, "avg": "satisfaction", "max": 1},
+				"facets":    []any{"version", "model"},
+			},
+			expected: Options{
+				GroupBy:   []string{"network_id"},
+				Aggregate: map[string][]string{"sum": {"rx_bytes", "tx_bytes"}, "avg": {"satisfaction"}},
+				Facets:    []string{"version", "model"},
+			},
+		},
 		{
 			name: "extra args ignored",
 			args: map[string]any{
@@ -94,12 +138,39 @@ func TestParseOptions(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ParseOptions(tt.args)
+			result, err := ParseOptions(tt.args)
+			require.NoError(t, err)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
 }
 
+func TestParseOptions_InvalidPagination(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]any
+		wantErr string
+	}{
+		{"negative limit", map[string]any{"limit": float64(-1)}, "invalid limit: must be a non-negative integer, got -1"},
+		{"fractional limit", map[string]any{"limit": 2.5}, "invalid limit: must be a non-negative integer, got 2.5"},
+		{"string limit", map[string]any{"limit": "10"}, "invalid limit: must be a non-negative integer, got string"},
+		{"negative offset", map[string]any{"offset": float64(-5)}, "invalid offset: must be a non-negative integer, got -5"},
+		{"fractional offset", map[string]any{"offset": 1.5}, "invalid offset: must be a non-negative integer, got 1.5"},
+		{"boolean offset", map[string]any{"limit": float64(10), "offset": true}, "invalid offset: must be a non-negative integer, got boolean"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseOptions(tt.args)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+
+	// Zero and null mean no limit or offset
+	opts, err := ParseOptions(map[string]any{"limit": float64(0), "offset": nil})
+	require.NoError(t, err)
+	assert.Equal(t, Options{}, opts)
+}
+
 func TestHasQuery(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -152,28 +223,33 @@ var testItems = []map[string]any{
 }
 
 func TestApply_NoOptions(t *testing.T) {
-	result := Apply(testItems, Options{})
+	result, err := Apply(testItems, Options{})
+	require.NoError(t, err)
 	assert.Equal(t, testItems, result)
 }
 
 func TestApply_EmptyInput(t *testing.T) {
-	result := Apply([]map[string]any{}, Options{Search: "anything"})
+	result, err := Apply([]map[string]any{}, Options{Search: "anything"})
+	require.NoError(t, err)
 	assert.Empty(t, result)
 }
 
 func TestApply_NilInput(t *testing.T) {
-	result := Apply(nil, Options{Search: "anything"})
+	result, err := Apply(nil, Options{Search: "anything"})
+	require.NoError(t, err)
 	assert.Nil(t, result)
 }
 
 func TestApply_FilterNoMatch_ReturnsEmptySlice(t *testing.T) {
-	result := Apply(testItems, Options{Filter: map[string]any{"type": "nonexistent"}})
+	result, err := Apply(testItems, Options{Filter: map[string]any{"type": "nonexistent"}})
+	require.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Empty(t, result)
 }
 
 func TestApply_SearchNoMatch_ReturnsEmptySlice(t *testing.T) {
-	result := Apply(testItems, Options{Search: "nonexistent"})
+	result, err := Apply(testItems, Options{Search: "nonexistent"})
+	require.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Empty(t, result)
 }
@@ -203,7 +279,8 @@ func TestApply_FilterExact(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := Apply(testItems, Options{Filter: tt.filter})
+			result, err := Apply(testItems, Options{Filter: tt.filter})
+			require.NoError(t, err)
 			assert.Len(t, result, tt.expected)
 		})
 	}
@@ -214,67 +291,73 @@ func TestApply_FilterExactBool(t *testing.T) {
 		{"name": "a", "enabled": true},
 		{"name": "b", "enabled": false},
 	}
-	result := Apply(items, Options{Filter: map[string]any{"enabled": true}})
+	result, err := Apply(items, Options{Filter: map[string]any{"enabled": true}})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "a", result[0]["name"])
 }
 
 func TestApply_FilterContains(t *testing.T) {
-	result := Apply(testItems, Options{
+	result, err := Apply(testItems, Options{
 		Filter: map[string]any{
 			"name": map[string]any{"contains": "amazon"},
 		},
 	})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "amazon-echo", result[0]["name"])
 }
 
 func TestApply_FilterContainsCaseInsensitive(t *testing.T) {
-	result := Apply(testItems, Options{
+	result, err := Apply(testItems, Options{
 		Filter: map[string]any{
 			"name": map[string]any{"contains": "LIVING"},
 		},
 	})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "ap-living-room", result[0]["name"])
 }
 
 func TestApply_FilterRegex(t *testing.T) {
-	result := Apply(testItems, Options{
+	result, err := Apply(testItems, Options{
 		Filter: map[string]any{
 			"name": map[string]any{"regex": "^ap-.*"},
 		},
 	})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "ap-living-room", result[0]["name"])
 }
 
 func TestApply_FilterRegexInvalid(t *testing.T) {
-	// Invalid regex should gracefully not match (no error)
-	result := Apply(testItems, Options{
+	_, err := Apply(testItems, Options{
 		Filter: map[string]any{
 			"name": map[string]any{"regex": "[invalid"},
 		},
 	})
-	assert.Empty(t, result)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid filter for field "name": invalid regex`)
 }
 
 func TestApply_FilterMultipleFields(t *testing.T) {
 	// AND logic: must match both conditions
-	result := Apply(testItems, Options{
+	result, err := Apply(testItems, Options{
 		Filter: map[string]any{
 			"type": "uap",
 			"name": map[string]any{"contains": "echo"},
 		},
 	})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "amazon-echo", result[0]["name"])
 }
 
 func TestApply_FilterNonExistentField(t *testing.T) {
-	result := Apply(testItems, Options{
+	result, err := Apply(testItems, Options{
 		Filter: map[string]any{"nonexistent": "value"},
 	})
+	require.NoError(t, err)
 	assert.Empty(t, result)
 }
 
@@ -283,55 +366,201 @@ func TestApply_FilterNilFieldValue(t *testing.T) {
 		{"name": "a", "ip": nil},
 		{"name": "b", "ip": "10.0.0.1"},
 	}
-	result := Apply(items, Options{
+	result, err := Apply(items, Options{
 		Filter: map[string]any{"ip": "10.0.0.1"},
 	})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "b", result[0]["name"])
 }
 
 func TestApply_FilterUnknownOperator(t *testing.T) {
-	// Unknown operator object should not match
-	result := Apply(testItems, Options{
+	_, err := Apply(testItems, Options{
 		Filter: map[string]any{
 			"name": map[string]any{"startsWith": "ap"},
 		},
 	})
-	assert.Empty(t, result)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown operator "startsWith" (supported operators: eq, ne,`)
+}
+
+func TestApply_FilterInvalidEmptyItems(t *testing.T) {
+	// Invalid filters are reported even when there is nothing to filter
+	_, err := Apply(nil, Options{Filter: map[string]any{"name": map[string]any{}}})
+	assert.ErrorContains(t, err, "empty operator object")
+}
+
+func TestApply_FilterOperators(t *testing.T) {
+	items := []map[string]any{
+		{"name": "a", "rx_bytes": float64(2e9), "vlan": float64(10), "last_seen": float64(1700000000), "version": "6.5.28"},
+		{"name": "b", "rx_bytes": float64(5e8), "vlan": "20", "last_seen": float64(1600000000000), "version": "6.10.1"},
+		{"name": "c", "vlan": float64(30), "last_seen": "2024-01-02T00:00:00Z", "note": nil},
+		{"vlan": float64(40)},
+	}
+
+	tests := []struct {
+		name     string
+		filter   map[string]any
+		expected []any
+	}{
+		{"gt", map[string]any{"rx_bytes": map[string]any{"gt": 1e9}}, []any{"a"}},
+		{"gte and lt", map[string]any{"vlan": map[string]any{"gte": float64(20), "lt": float64(40)}}, []any{"b", "c"}},
+		{"lte numeric string", map[string]any{"vlan": map[string]any{"lte": "20"}}, []any{"a", "b"}},
+		{"timestamp", map[string]any{"last_seen": map[string]any{"gte": "2023-01-01"}}, []any{"a", "c"}},
+		{"timestamp milliseconds", map[string]any{"last_seen": map[string]any{"lt": "2021-01-01T00:00:00Z"}}, []any{"b"}},
+		{"natural string", map[string]any{"version": map[string]any{"gt": "6.9"}}, []any{"b"}},
+		{"in", map[string]any{"vlan": map[string]any{"in": []any{float64(10), float64(20)}}}, []any{"a", "b"}},
+		{"not_in matches missing", map[string]any{"name": map[string]any{"not_in": []any{"a", "b"}}}, []any{"c", nil}},
+		{"exists", map[string]any{"rx_bytes": map[string]any{"exists": true}}, []any{"a", "b"}},
+		{"not exists includes null", map[string]any{"note": map[string]any{"exists": false}}, []any{"a", "b", "c", nil}},
+		{"missing name", map[string]any{"name": map[string]any{"exists": false}}, []any{nil}},
+		{"eq", map[string]any{"name": map[string]any{"eq": "b"}}, []any{"b"}},
+		{"ne matches missing", map[string]any{"name": map[string]any{"ne": "b"}}, []any{"a", "c", nil}},
+		{"not regex", map[string]any{"name": map[string]any{"not": map[string]any{"regex": "^[ab]$"}}}, []any{"c", nil}},
+		{"not plain value", map[string]any{"vlan": map[string]any{"not": float64(10)}}, []any{"b", "c", nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Apply(items, Options{Filter: tt.filter})
+			require.NoError(t, err)
+			var names []any
+			for _, item := range result {
+				names = append(names, item["name"])
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestApply_FilterGroups(t *testing.T) {
+	wlans := []map[string]any{
+		{"name": "home", "is_guest": false, "hide_ssid": false, "security": "wpapsk"},
+		{"name": "guest", "is_guest": true, "hide_ssid": false, "security": "open"},
+		{"name": "iot", "is_guest": false, "hide_ssid": true, "security": "wpapsk"},
+		{"name": "lab", "is_guest": true, "hide_ssid": true, "security": "wpapsk"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   map[string]any
+		expected []any
+	}{
+		{
+			name: "or",
+			filter: map[string]any{"$or": []any{
+				map[string]any{"is_guest": true},
+				map[string]any{"hide_ssid": true},
+			}},
+			expected: []any{"guest", "iot", "lab"},
+		},
+		{
+			name: "or combined with field",
+			filter: map[string]any{
+				"security": "wpapsk",
+				"$or": []any{
+					map[string]any{"is_guest": true},
+					map[string]any{"hide_ssid": true},
+				},
+			},
+			expected: []any{"iot", "lab"},
+		},
+		{
+			name: "nested",
+			filter: map[string]any{"$or": []any{
+				map[string]any{"name": "home"},
+				map[string]any{"$and": []any{
+					map[string]any{"is_guest": true},
+					map[string]any{"$or": []any{
+						map[string]any{"security": "open"},
+						map[string]any{"name": map[string]any{"regex": "^l"}},
+					}},
+				}},
+			}},
+			expected: []any{"home", "guest", "lab"},
+		},
+		{
+			name:     "and",
+			filter:   map[string]any{"$and": []any{map[string]any{"is_guest": true}, map[string]any{"hide_ssid": true}}},
+			expected: []any{"lab"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Apply(wlans, Options{Filter: tt.filter})
+			require.NoError(t, err)
+			var names []any
+			for _, item := range result {
+				names = append(names, item["name"])
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestApply_FilterOperatorErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  map[string]any
+		wantErr string
+	}{
+		{"contains non-string", map[string]any{"name": map[string]any{"contains": float64(1)}}, "contains requires a string, got number"},
+		{"regex non-string", map[string]any{"name": map[string]any{"regex": true}}, "regex requires a string, got boolean"},
+		{"gt object", map[string]any{"rx": map[string]any{"gt": map[string]any{}}}, "gt requires a number, timestamp or string, got object"},
+		{"in non-array", map[string]any{"vlan": map[string]any{"in": "10"}}, "in requires an array, got string"},
+		{"exists non-bool", map[string]any{"vlan": map[string]any{"exists": "yes"}}, "exists requires true or false, got string"},
+		{"nested not", map[string]any{"name": map[string]any{"not": map[string]any{"regex": "("}}}, "not: invalid regex"},
+		{"or not array", map[string]any{"$or": map[string]any{"a": "b"}}, "$or requires a non-empty array of filters, got object"},
+		{"or empty", map[string]any{"$or": []any{}}, "$or requires a non-empty array of filters, got array"},
+		{"and element", map[string]any{"$and": []any{"x"}}, "$and[0] must be a filter object, got string"},
+		{"unknown group", map[string]any{"$nor": []any{}}, `unknown group "$nor"`},
+		{"nested error", map[string]any{"$or": []any{map[string]any{"name": map[string]any{"bad": 1}}}}, `invalid filter for field "name": unknown operator "bad"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Apply(testItems, Options{Filter: tt.filter})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
 }
 
 func TestApply_Search(t *testing.T) {
-	result := Apply(testItems, Options{Search: "amazon"})
+	result, err := Apply(testItems, Options{Search: "amazon"})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "amazon-echo", result[0]["name"])
 }
 
 func TestApply_SearchCaseInsensitive(t *testing.T) {
-	result := Apply(testItems, Options{Search: "SWITCH"})
+	result, err := Apply(testItems, Options{Search: "SWITCH"})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "switch-1", result[0]["name"])
 }
 
 func TestApply_SearchNoMatch(t *testing.T) {
-	result := Apply(testItems, Options{Search: "nonexistent"})
+	result, err := Apply(testItems, Options{Search: "nonexistent"})
+	require.NoError(t, err)
 	assert.Empty(t, result)
 }
 
 func TestApply_SearchMatchesAnyStringField(t *testing.T) {
 	// Search should match across any string field
-	result := Apply(testItems, Options{Search: "10.0.0.2"})
+	result, err := Apply(testItems, Options{Search: "10.0.0.2"})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "ap-living-room", result[0]["name"])
 }
 
 func TestApply_SearchSkipsNonStringValues(t *testing.T) {
 	// "24" appears in port_count (float64) but search only checks strings
-	result := Apply(testItems, Options{Search: "24"})
+	result, err := Apply(testItems, Options{Search: "24"})
+	require.NoError(t, err)
 	assert.Empty(t, result)
 }
 
 func TestApply_Fields(t *testing.T) {
-	result := Apply(testItems, Options{Fields: []string{"name", "ip"}})
+	result, err := Apply(testItems, Options{Fields: []string{"name", "ip"}})
+	require.NoError(t, err)
 	assert.Len(t, result, 3)
 	for _, item := range result {
 		assert.Len(t, item, 2)
@@ -341,7 +570,8 @@ func TestApply_Fields(t *testing.T) {
 }
 
 func TestApply_FieldsNonExistentSilentlyIgnored(t *testing.T) {
-	result := Apply(testItems, Options{Fields: []string{"name", "nonexistent"}})
+	result, err := Apply(testItems, Options{Fields: []string{"name", "nonexistent"}})
+	require.NoError(t, err)
 	assert.Len(t, result, 3)
 	for _, item := range result {
 		assert.Len(t, item, 1)
@@ -350,11 +580,214 @@ func TestApply_FieldsNonExistentSilentlyIgnored(t *testing.T) {
 }
 
 func TestApply_Combined(t *testing.T) {
-	result := Apply(testItems, Options{
+	result, err := Apply(testItems, Options{
 		Filter: map[string]any{"type": "uap"},
 		Search: "echo",
 		Fields: []string{"name", "ip"},
 	})
+	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, map[string]any{"name": "amazon-echo", "ip": "10.0.0.3"}, result[0])
 }
+
+func TestApply_Sort(t *testing.T) {
+	items := []map[string]any{
+		{"name": "b", "ip": "10.0.0.10", "rx": float64(5)},
+		{"name": "a", "ip": "10.0.0.9", "rx": float64(50)},
+		{"name": "c", "ip": "10.0.0.100"},
+		{"name": "d", "ip": "10.0.0.9", "rx": nil},
+	}
+	names := func(items []map[string]any) []any {
+		var out []any
+		for _, item := range items {
+			out = append(out, item["name"])
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		sort     []SortKey
+		expected []any
+	}{
+		{"numeric", []SortKey{{Field: "rx"}}, []any{"b", "a", "c", "d"}},
+		{"numeric desc keeps missing last", []SortKey{{Field: "rx", Desc: true}}, []any{"a", "b", "c", "d"}},
+		{"natural", []SortKey{{Field: "ip"}}, []any{"a", "d", "b", "c"}},
+		{"multiple keys", []SortKey{{Field: "ip"}, {Field: "name", Desc: true}}, []any{"d", "a", "b", "c"}},
+		{"missing field keeps input order", []SortKey{{Field: "nope"}}, []any{"b", "a", "c", "d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Apply(items, Options{Sort: tt.sort})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, names(result))
+		})
+	}
+
+	assert.Equal(t, []any{"b", "a", "c", "d"}, names(items), "input must not be reordered")
+}
+
+func TestApplyPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     Options
+		names    []string
+		expected Page
+	}{
+		{
+			name:     "first page",
+			opts:     Options{Limit: 2},
+			names:    []string{"switch-1", "ap-living-room"},
+			expected: Page{Total: 3, Returned: 2, NextOffset: ptr(2)},
+		},
+		{
+			name:     "last page",
+			opts:     Options{Limit: 2, Offset: 2},
+			names:    []string{"amazon-echo"},
+			expected: Page{Total: 3, Returned: 1},
+		},
+		{
+			name:     "offset past end",
+			opts:     Options{Offset: 5},
+			expected: Page{Total: 3},
+		},
+		{
+			name:     "total counts filtered items",
+			opts:     Options{Filter: map[string]any{"type": "uap"}, Sort: []SortKey{{Field: "name"}}, Limit: 1},
+			names:    []string{"amazon-echo"},
+			expected: Page{Total: 2, Returned: 1, NextOffset: ptr(1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, page, err := ApplyPage(testItems, tt.opts)
+			require.NoError(t, err)
+			var names []string
+			for _, item := range result {
+				names = append(names, item["name"].(string))
+			}
+			assert.Equal(t, tt.names, names)
+			assert.Equal(t, tt.expected, page)
+		})
+	}
+}
+
+func ptr(n int) *int {
+	return &n
+}
+
+func TestNaturalCompare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"port2", "port10", -1},
+		{"10.0.0.10", "10.0.0.9", 1},
+		{"Alpha", "beta", -1},
+		{"a01", "a1", -1}, // equal numerically, plain comparison breaks the tie
+		{"abc", "abc", 0},
+		{"ab", "abc", -1},
+		{"99999999999999999999", "100000000000000000000", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.expected, naturalCompare(tt.a, tt.b))
+			assert.Equal(t, -tt.expected, naturalCompare(tt.b, tt.a))
+		})
+	}
+}
+
+// benchmarkItems builds n client-like items with a mix of string, numeric,
+// boolean and nested values, similar to a large site's list_user output.
+func benchmarkItems(n int) []map[string]any {
+	items := make([]map[string]any, n)
+	for i := range items {
+		items[i] = map[string]any{
+			"_id":          fmt.Sprintf("id%05d", i),
+			"name":         fmt.Sprintf("client-%05d", i),
+			"hostname":     fmt.Sprintf("host-%05d.lan", i),
+			"mac":          fmt.Sprintf("aa:bb:cc:%02x:%02x:%02x", i>>16&0xff, i>>8&0xff, i&0xff),
+			"ip":           fmt.Sprintf("10.0.%d.%d", i/250, i%250),
+			"network_id":   fmt.Sprintf("net%d", i%8),
+			"is_wired":     i%3 == 0,
+			"blocked":      i%50 == 0,
+			"rx_bytes":     float64(i * 1024),
+			"tx_bytes":     float64(i * 2048),
+			"last_seen":    float64(1700000000 + i),
+			"oui":          "Ubiquiti",
+			"usergroup_id": "",
+			"fixed_ip":     "",
+			"note":         "",
+			"tags":         []any{"a", "b"},
+			"stats":        map[string]any{"rssi": float64(-40 - i%40)},
+		}
+	}
+	return items
+}
+
+func BenchmarkApply(b *testing.B) {
+	items := benchmarkItems(10000)
+	cases := []struct {
+		name string
+		opts Options
+	}{
+		{"filter_exact", Options{Filter: map[string]any{"network_id": "net3"}}},
+		{"filter_regex", Options{Filter: map[string]any{"hostname": map[string]any{"regex": "^host-0[0-4]"}}}},
+		{"search", Options{Search: "client-09"}},
+		{"fields", Options{Fields: []string{"_id", "name", "ip"}}},
+	}
+	for _, tc := range cases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				Apply(items, tc.opts)
+			}
+		})
+	}
+}
+
+func TestProjectItem(t *testing.T) {
+	item := map[string]any{
+		"name":       "switch",
+		"mgmt_ssh":   true,
+		"port_table": []any{map[string]any{"port_idx": float64(1), "poe_mode": "auto", "speed": float64(1000)}},
+	}
+	valid := []string{"port_table", "name", "mgmt_ssh", "mgmt_led"}
+
+	result, err := ProjectItem(item, []string{"name", "port_table[*].poe_mode", "mgmt_led"}, valid)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"name":       "switch",
+		"port_table": []any{map[string]any{"poe_mode": "auto"}},
+	}, result)
+
+	_, err = ProjectItem(item, []string{"name", "ports[*].poe_mode"}, valid)
+	assert.EqualError(t, err, `invalid fields: unknown field "ports[*].poe_mode" (valid fields: mgmt_led, mgmt_ssh, name, port_table)`)
+	assert.Equal(t, []string{"port_table", "name", "mgmt_ssh", "mgmt_led"}, valid, "valid must not be reordered")
+
+	_, err = ProjectItem(item, []string{"port_table["}, valid)
+	assert.ErrorContains(t, err, "invalid fields: invalid path")
+}
+
+func TestValidateItemFields(t *testing.T) {
+	valid := []string{"name", "port_table"}
+
+	assert.NoError(t, ValidateItemFields([]string{"name", "port_table[*].poe_mode"}, valid))
+	assert.EqualError(t, ValidateItemFields([]string{"nmae"}, valid),
+		`invalid fields: unknown field "nmae" (valid fields: name, port_table)`)
+	assert.ErrorContains(t, ValidateItemFields([]string{"port_table["}, valid), "invalid fields: invalid path")
+
+	// Without valid fields only the paths are checked
+	assert.NoError(t, ValidateItemFields([]string{"nmae"}, nil))
+	assert.ErrorContains(t, ValidateItemFields([]string{"port_table["}, nil), "invalid fields: invalid path")
+}
+
+func TestOptions_Validate(t *testing.T) {
+	assert.NoError(t, Options{}.Validate())
+	assert.NoError(t, Options{Filter: map[string]any{"name": "ap"}, Fields: []string{"name"}}.Validate())
+	assert.ErrorContains(t, Options{Filter: map[string]any{"name": map[string]any{"regex": "[unclosed"}}}.Validate(),
+		`invalid filter for field "name": invalid regex`)
+	assert.ErrorContains(t, Options{Filter: map[string]any{"name": map[string]any{"nope": "x"}}}.Validate(),
+		`invalid filter for field "name"`)
+	assert.ErrorContains(t, Options{Fields: []string{"port_table["}}.Validate(), "invalid fields: invalid path")
+}
@@ -0,0 +1,148 @@
+package query
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// sortKey is a SortKey with its field parsed as a path. Fields addressing
+// several values sort by the first.
+type sortKey struct {
+	path path
+	desc bool
+}
+
+func compileSort(keys []SortKey) ([]sortKey, error) {
+	compiled := make([]sortKey, len(keys))
+	for i, key := range keys {
+		p, err := parsePath(key.Field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sort: %w", err)
+		}
+		compiled[i] = sortKey{path: p, desc: key.Desc}
+	}
+	return compiled, nil
+}
+
+// applySort returns the items stably sorted by keys. Items missing a field,
+// or holding null, sort after all others in either direction.
+func applySort(items []map[string]any, keys []sortKey) []map[string]any {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b map[string]any) int {
+		for _, key := range keys {
+			av, bv := key.path.first(a), key.path.first(b)
+			switch {
+			case av == nil && bv == nil:
+				continue
+			case av == nil:
+				return 1
+			case bv == nil:
+				return -1
+			}
+			c := compareValues(av, bv)
+			if key.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+	return sorted
+}
+
+// compareValues orders two non-null JSON values. Values of different types
+// order as booleans < numbers < strings < others.
+func compareValues(a, b any) int {
+	if c := cmp.Compare(typeRank(a), typeRank(b)); c != 0 {
+		return c
+	}
+	switch a := a.(type) {
+	case bool:
+		b := b.(bool)
+		switch {
+		case a == b:
+			return 0
+		case !a:
+			return -1
+		default:
+			return 1
+		}
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	case string:
+		return naturalCompare(a, b.(string))
+	}
+	return 0
+}
+
+func typeRank(v any) int {
+	switch v.(type) {
+	case bool:
+		return 0
+	case float64:
+		return 1
+	case string:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// naturalCompare compares strings case-insensitively, treating runs of
+// digits as numbers so that "10.0.0.9" < "10.0.0.10" and "port2" < "port10".
+// Strings equal under that ordering fall back to a plain comparison.
+func naturalCompare(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			ai, bj := digitsEnd(a, i), digitsEnd(b, j)
+			if c := compareDigits(a[i:ai], b[j:bj]); c != 0 {
+				return c
+			}
+			i, j = ai, bj
+			continue
+		}
+		ra, sa := utf8.DecodeRuneInString(a[i:])
+		rb, sb := utf8.DecodeRuneInString(b[j:])
+		if c := cmp.Compare(unicode.ToLower(ra), unicode.ToLower(rb)); c != 0 {
+			return c
+		}
+		i += sa
+		j += sb
+	}
+	if c := cmp.Compare(len(a)-i, len(b)-j); c != 0 {
+		return c
+	}
+	return cmp.Compare(a, b)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func digitsEnd(s string, i int) int {
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return i
+}
+
+// compareDigits compares two digit runs by numeric value without parsing,
+// so arbitrarily long runs work.
+func compareDigits(a, b string) int {
+	for len(a) > 1 && a[0] == '0' {
+		a = a[1:]
+	}
+	for len(b) > 1 && b[0] == '0' {
+		b = b[1:]
+	}
+	if c := cmp.Compare(len(a), len(b)); c != 0 {
+		return c
+	}
+	return cmp.Compare(a, b)
+}
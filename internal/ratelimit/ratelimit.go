@@ -0,0 +1,177 @@
+// Package ratelimit throttles calls to the UniFi controller. A token bucket
+// bounds the sustained request rate and a semaphore bounds the number of
+// requests in flight, so that bursts from batch calls and resolver prefetches
+// do not trip the console's own throttling. Waiting calls give up as soon as
+// their context is canceled.
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+)
+
+// Options configures a Limiter. Zero values disable the corresponding limit.
+type Options struct {
+	Rate           float64 // sustained requests per second
+	Burst          int     // requests allowed at once before Rate applies, at least 1
+	MaxConcurrency int     // requests in flight at any time
+}
+
+// Stats reports cumulative limiter metrics.
+type Stats struct {
+	Calls     uint64        // calls admitted
+	Waited    uint64        // calls that had to wait
+	TotalWait time.Duration // time spent waiting across all calls
+	MaxWait   time.Duration // longest single wait
+	InFlight  int           // calls currently running
+}
+
+// Limiter applies a rate limit and a concurrency cap to client calls.
+// It is safe for concurrent use.
+type Limiter struct {
+	rate   float64
+	burst  float64
+	slots  chan struct{}
+	logger *slog.Logger
+	now    func() time.Time
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	stats  Stats
+}
+
+// New creates a Limiter. Wait times are logged at debug level to logger.
+func New(opts Options, logger *slog.Logger) *Limiter {
+	l := &Limiter{
+		rate:   opts.Rate,
+		burst:  math.Max(float64(opts.Burst), 1),
+		logger: logger,
+		now:    time.Now,
+	}
+	l.tokens = l.burst
+	if opts.MaxConcurrency > 0 {
+		l.slots = make(chan struct{}, opts.MaxConcurrency)
+	}
+	return l
+}
+
+// Interceptor returns a client interceptor that admits each call through the
+// limiter. Time spent waiting is recorded in the limiter's Stats and logged at
+// debug level; it is not added to tool results, whose shape must not depend on
+// timing.
+func (l *Limiter) Interceptor() generated.Interceptor {
+	return func(ctx context.Context, call generated.Call, next func(context.Context) error) error {
+		wait, err := l.acquire(ctx)
+		if err != nil {
+			return err
+		}
+		defer l.release()
+
+		if wait > 0 {
+			l.logger.Debug("rate limit: call queued",
+				"method", call.Method,
+				"wait", wait,
+				"stats", l.Stats(),
+			)
+		}
+		return next(ctx)
+	}
+}
+
+// Stats returns a snapshot of the limiter metrics.
+func (l *Limiter) Stats() Stats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// acquire blocks until a concurrency slot and a rate token are available, and
+// returns how long the call was held back (zero if it was admitted at once).
+func (l *Limiter) acquire(ctx context.Context) (time.Duration, error) {
+	start := l.now()
+	blocked := false
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			blocked = true
+			select {
+			case l.slots <- struct{}{}:
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		}
+	}
+	throttled, err := l.waitToken(ctx)
+	if err != nil {
+		if l.slots != nil {
+			<-l.slots
+		}
+		return 0, err
+	}
+
+	var wait time.Duration
+	if blocked || throttled {
+		wait = l.now().Sub(start)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.stats.Calls++
+	l.stats.InFlight++
+	if wait > 0 {
+		l.stats.Waited++
+		l.stats.TotalWait += wait
+		l.stats.MaxWait = max(l.stats.MaxWait, wait)
+	}
+	return wait, nil
+}
+
+func (l *Limiter) release() {
+	l.mu.Lock()
+	l.stats.InFlight--
+	l.mu.Unlock()
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// waitToken takes a token from the bucket, sleeping until one is available,
+// and reports whether it had to sleep. The token is reserved up front so that
+// concurrent waiters are served in order; it is returned if ctx is canceled
+// while waiting.
+func (l *Limiter) waitToken(ctx context.Context) (bool, error) {
+	if l.rate <= 0 {
+		return false, nil
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+	deficit := -l.tokens
+	l.mu.Unlock()
+
+	if deficit <= 0 {
+		return false, nil
+	}
+	timer := time.NewTimer(time.Duration(deficit / l.rate * float64(time.Second)))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true, nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens = math.Min(l.burst, l.tokens+1)
+		l.mu.Unlock()
+		return true, ctx.Err()
+	}
+}
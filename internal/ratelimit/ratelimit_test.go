@@ -0,0 +1,171 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var discard = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// gatedClient blocks ListNetwork until release is closed, when set.
+type gatedClient struct {
+	unifi.Client
+	started chan struct{}
+	release chan struct{}
+}
+
+func (c *gatedClient) ListNetwork(_ context.Context, _ string) ([]unifi.Network, error) {
+	if c.started != nil {
+		c.started <- struct{}{}
+	}
+	if c.release != nil {
+		<-c.release
+	}
+	return []unifi.Network{{ID: "net1"}}, nil
+}
+
+func newTestClient(opts Options) (*gatedClient, *generated.InterceptedClient, *Limiter) {
+	base := &gatedClient{}
+	limiter := New(opts, discard)
+	return base, generated.NewInterceptedClient(base, limiter.Interceptor()), limiter
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	_, client, limiter := newTestClient(Options{})
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	for range 50 {
+		_, err := client.ListNetwork(ctx, "default")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, uint64(50), limiter.Stats().Calls)
+	assert.Equal(t, uint64(0), limiter.Stats().Waited)
+	assert.Equal(t, 0, annotations.Len())
+}
+
+func TestLimiter_BurstThenRate(t *testing.T) {
+	_, client, limiter := newTestClient(Options{Rate: 50, Burst: 2})
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	for range 2 {
+		_, err := client.ListNetwork(ctx, "default")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 0, annotations.Len(), "burst should be admitted immediately")
+
+	start := time.Now()
+	_, err := client.ListNetwork(ctx, "default")
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond)
+
+	stats := limiter.Stats()
+	assert.Equal(t, uint64(3), stats.Calls)
+	assert.Equal(t, uint64(1), stats.Waited)
+	assert.Positive(t, stats.TotalWait)
+	assert.Equal(t, stats.TotalWait, stats.MaxWait)
+
+	// Waits are not reported in the tool result.
+	assert.Equal(t, 0, annotations.Len())
+}
+
+func TestLimiter_RefillsOverTime(t *testing.T) {
+	limiter := New(Options{Rate: 10, Burst: 1}, discard)
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	throttled, err := limiter.waitToken(context.Background())
+	require.NoError(t, err)
+	assert.False(t, throttled)
+
+	// 100ms at 10/s refills exactly one token.
+	now = now.Add(100 * time.Millisecond)
+	throttled, err = limiter.waitToken(context.Background())
+	require.NoError(t, err)
+	assert.False(t, throttled)
+
+	// The bucket never holds more than Burst tokens.
+	now = now.Add(time.Hour)
+	_, _ = limiter.waitToken(context.Background())
+	assert.InDelta(t, 0, limiter.tokens, 1e-9)
+}
+
+func TestLimiter_MaxConcurrency(t *testing.T) {
+	base, client, limiter := newTestClient(Options{MaxConcurrency: 1})
+	base.started = make(chan struct{}, 2)
+	base.release = make(chan struct{})
+
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.ListNetwork(context.Background(), "default")
+			assert.NoError(t, err)
+		}()
+	}
+
+	<-base.started
+	select {
+	case <-base.started:
+		t.Fatal("second call started while the first was in flight")
+	case <-time.After(20 * time.Millisecond):
+	}
+	assert.Equal(t, 1, limiter.Stats().InFlight)
+
+	close(base.release)
+	wg.Wait()
+
+	stats := limiter.Stats()
+	assert.Equal(t, uint64(2), stats.Calls)
+	assert.Equal(t, uint64(1), stats.Waited)
+	assert.Equal(t, 0, stats.InFlight)
+}
+
+func TestLimiter_CanceledWhileQueuedForSlot(t *testing.T) {
+	base, client, limiter := newTestClient(Options{MaxConcurrency: 1})
+	base.started = make(chan struct{}, 1)
+	base.release = make(chan struct{})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = client.ListNetwork(context.Background(), "default")
+	}()
+	<-base.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.ListNetwork(ctx, "default")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(base.release)
+	<-done
+	assert.Equal(t, uint64(1), limiter.Stats().Calls)
+	assert.Equal(t, 0, limiter.Stats().InFlight)
+}
+
+func TestLimiter_CanceledWhileWaitingForToken(t *testing.T) {
+	_, client, limiter := newTestClient(Options{Rate: 0.01, Burst: 1, MaxConcurrency: 1})
+
+	_, err := client.ListNetwork(context.Background(), "default")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.ListNetwork(ctx, "default")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The reserved token and the slot are handed back.
+	assert.InDelta(t, 0, limiter.tokens, 0.01)
+	assert.Len(t, limiter.slots, 0)
+}
@@ -0,0 +1,84 @@
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/claytono/go-unifi-mcp/internal/resolve/refs"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Rules reported by Explain.
+const (
+	RuleSkip       = refs.RuleSkip
+	RuleMAC        = refs.RuleMAC
+	RuleOverride   = refs.RuleOverride
+	RuleConvention = refs.RuleConvention
+	RuleNone       = refs.RuleNone
+)
+
+// Explanation describes how the resolver treats a field name.
+type Explanation struct {
+	Field          string   `json:"field"`
+	Rule           string   `json:"rule"`
+	StrippedPrefix string   `json:"stripped_prefix,omitempty"`
+	Resources      []string `json:"resources,omitempty"`
+	NameField      string   `json:"name_field,omitempty"`
+	DisplayFields  []string `json:"display_fields,omitempty"`
+	Detail         string   `json:"detail"`
+}
+
+// Explain reports how a field name maps to a resource, following the same
+// rules as response resolution.
+func (r *Resolver) Explain(field string) Explanation {
+	m := r.match(field)
+	e := Explanation{Field: field, Rule: m.Rule, StrippedPrefix: m.StrippedPrefix, NameField: m.NameField}
+
+	switch m.Rule {
+	case RuleSkip:
+		e.Detail = "field is in the skip list and is never resolved"
+	case RuleMAC:
+		e.Resources = []string{"Device", "User"}
+		e.Detail = "MAC addresses are looked up in the device list, then the client list"
+	case RuleOverride:
+		e.Resources = []string{m.Resource}
+		e.DisplayFields = r.displayFieldsFor(m.Resource)
+		e.Detail = fmt.Sprintf("%q is in the override table", m.Key)
+	case RuleConvention:
+		e.Resources = []string{m.Resource}
+		e.DisplayFields = r.displayFieldsFor(m.Resource)
+		e.Detail = fmt.Sprintf("%q matches the %s resource", m.Key, m.Resource)
+	case RuleNone:
+		if m.Key == "" {
+			e.Detail = "field does not end in _id, _ids, _mac or _macs and has no override"
+		} else {
+			e.Detail = fmt.Sprintf("no resource named %q; add an override to resolve it", refs.SnakeToPascal(m.Key))
+		}
+	}
+	return e
+}
+
+// ExplainTool returns the resolve_explain debug tool definition.
+func ExplainTool() mcp.Tool {
+	return mcp.NewTool("resolve_explain",
+		mcp.WithDescription("Debug tool: explains how ID resolution maps a field name (e.g. 'src_networkconf_id', 'uplink_mac') to a resource."),
+		mcp.WithString("field", mcp.Required(), mcp.Description("Field name to explain")),
+	)
+}
+
+// ExplainHandler returns the handler for the resolve_explain tool.
+func ExplainHandler(resolver *Resolver) server.ToolHandlerFunc {
+	return func(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		field, _ := req.GetArguments()["field"].(string)
+		if field == "" {
+			return mcp.NewToolResultError("field is required"), nil
+		}
+		data, err := json.MarshalIndent(resolver.Explain(field), "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal explanation: %v", err)), nil
+		}
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
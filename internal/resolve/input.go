@@ -0,0 +1,122 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ResolveInput translates name references in tool arguments into the ID
+// fields they stand for: "usergroup_name" becomes "usergroup_id" and
+// "excluded_networkconf_names" becomes "excluded_networkconf_ids". Only
+// top-level arguments are translated, and only when the _id/_ids field is in
+// fields and the _name/_names key itself is not. When both the ID and the name
+// are given they must refer to the same object. The returned map is a copy;
+// args is returned unchanged when it holds no name references.
+func (r *Resolver) ResolveInput(ctx context.Context, site string, args map[string]any, fields map[string]bool) (map[string]any, error) {
+	var nameKeys []string
+	for key := range args {
+		if _, ok := inputIDKey(key, fields); ok {
+			nameKeys = append(nameKeys, key)
+		}
+	}
+	if len(nameKeys) == 0 {
+		return args, nil
+	}
+	sort.Strings(nameKeys)
+	ctx = withRequestCache(ctx)
+
+	out := make(map[string]any, len(args))
+	for k, v := range args {
+		out[k] = v
+	}
+
+	for _, nameKey := range nameKeys {
+		idKey, _ := inputIDKey(nameKey, fields)
+		resource, ok := r.ResourceForField(idKey)
+		if !ok {
+			continue
+		}
+
+		resolved, err := r.resolveInputValue(ctx, site, resource, nameKey, args[nameKey])
+		if err != nil {
+			return nil, err
+		}
+		if existing, ok := args[idKey]; ok && !sameIDs(existing, resolved) {
+			return nil, fmt.Errorf("%s and %s refer to different %s objects", idKey, nameKey, resource)
+		}
+		delete(out, nameKey)
+		out[idKey] = resolved
+	}
+	return out, nil
+}
+
+// inputIDKey returns the ID field a _name/_names argument stands for, if that
+// field is known and the name key is not a field in its own right.
+func inputIDKey(key string, fields map[string]bool) (string, bool) {
+	if fields[key] {
+		return "", false
+	}
+	var idKey string
+	switch {
+	case strings.HasSuffix(key, "_names"):
+		idKey = strings.TrimSuffix(key, "_names") + "_ids"
+	case strings.HasSuffix(key, "_name"):
+		idKey = strings.TrimSuffix(key, "_name") + "_id"
+	default:
+		return "", false
+	}
+	return idKey, fields[idKey]
+}
+
+// resolveInputValue looks up the ID for a name, or the IDs for a list of names.
+func (r *Resolver) resolveInputValue(ctx context.Context, site, resource, nameKey string, value any) (any, error) {
+	if strings.HasSuffix(nameKey, "_names") {
+		names, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an array of names", nameKey)
+		}
+		ids := make([]any, 0, len(names))
+		for _, n := range names {
+			name, ok := n.(string)
+			if !ok || name == "" {
+				return nil, fmt.Errorf("%s must be an array of names", nameKey)
+			}
+			id, err := r.LookupID(ctx, site, resource, "name", name)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", nameKey, err)
+			}
+			ids = append(ids, id)
+		}
+		return ids, nil
+	}
+
+	name, ok := value.(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("%s must be a non-empty string", nameKey)
+	}
+	id, err := r.LookupID(ctx, site, resource, "name", name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", nameKey, err)
+	}
+	return id, nil
+}
+
+// sameIDs reports whether an ID argument matches the IDs resolved from names.
+func sameIDs(existing, resolved any) bool {
+	if id, ok := resolved.(string); ok {
+		return existing == id
+	}
+	want, _ := resolved.([]any)
+	got, ok := existing.([]any)
+	if !ok || len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
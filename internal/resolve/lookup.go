@@ -0,0 +1,88 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// LookupID returns the ID of the single resource whose field equals value.
+// The "name" field matches the display name (name, falling back to hostname),
+// exactly or else case-insensitively; "mac" compares normalized MAC addresses;
+// any other field compares string values exactly. It fails when nothing
+// matches or when several objects do, listing the candidates.
+func (r *Resolver) LookupID(ctx context.Context, site, resource, field, value string) (string, error) {
+	items, err := r.cachedList(ctx, site, resource, cacheFromContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %s by %s: %w", resource, field, err)
+	}
+
+	var matches []map[string]any
+	switch field {
+	case "name":
+		matches = filterItems(items, func(item map[string]any) bool {
+			return r.displayName(resource, item) == value
+		})
+		if len(matches) == 0 {
+			matches = filterItems(items, func(item map[string]any) bool {
+				return strings.EqualFold(r.displayName(resource, item), value)
+			})
+		}
+	case "mac":
+		want := normalizeMAC(value)
+		matches = filterItems(items, func(item map[string]any) bool {
+			mac, _ := item["mac"].(string)
+			return mac != "" && normalizeMAC(mac) == want
+		})
+	default:
+		matches = filterItems(items, func(item map[string]any) bool {
+			v, _ := item[field].(string)
+			return v == value
+		})
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s with %s %q", resource, field, value)
+	case 1:
+		id, _ := matches[0]["_id"].(string)
+		return id, nil
+	}
+
+	candidates := make([]string, 0, len(matches))
+	for _, m := range matches {
+		id, _ := m["_id"].(string)
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", r.displayName(resource, m), id))
+	}
+	return "", fmt.Errorf("%s %q matches %d %s objects, use id to select one: %s",
+		field, value, len(matches), resource, strings.Join(candidates, ", "))
+}
+
+func filterItems(items []map[string]any, keep func(map[string]any) bool) []map[string]any {
+	var out []map[string]any
+	for _, item := range items {
+		if keep(item) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// normalizeMAC lowercases a MAC address and rewrites it in colon-separated
+// form, accepting colon, dash, dot (Cisco style) and bare notations. Values
+// that are not MAC addresses are only lowercased.
+func normalizeMAC(mac string) string {
+	mac = strings.ToLower(strings.TrimSpace(mac))
+	hex := strings.NewReplacer(":", "", "-", "", ".", "").Replace(mac)
+	if len(hex) != 12 || strings.Trim(hex, "0123456789abcdef") != "" {
+		return mac
+	}
+	var b strings.Builder
+	for i := 0; i < 12; i += 2 {
+		if i > 0 {
+			b.WriteByte(':')
+		}
+		b.WriteString(hex[i : i+2])
+	}
+	return b.String()
+}
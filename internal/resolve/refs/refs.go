@@ -0,0 +1,170 @@
+// Package refs holds the rules that map ID fields to the resources they
+// reference, and the built-in tables they start from. It has no dependencies
+// on the generated tools, so the tool generator applies the same rules as the
+// resolver when it advertises name inputs for ID fields.
+package refs
+
+import "strings"
+
+// Overrides maps non-standard field suffixes to their resource names.
+// These fields don't follow the convention of foo_id -> Foo.
+var Overrides = map[string]string{
+	"networkconf_id":    "Network",
+	"networkconf_ids":   "Network",
+	"radiusprofile_id":  "RADIUSProfile",
+	"firewallgroup_ids": "FirewallGroup",
+	"firewall_group_id": "FirewallGroup",
+}
+
+// SkipFields contains field names that should never be resolved.
+var SkipFields = map[string]bool{
+	"_id":                         true,
+	"attr_hidden_id":              true,
+	"site_id":                     true,
+	"ulp_user_id":                 true,
+	"facebook_app_id":             true,
+	"wechat_app_id":               true,
+	"wechat_shop_id":              true,
+	"google_client_id":            true,
+	"facebook_wifi_gw_id":         true,
+	"engine_id":                   true,
+	"anqp_domain_id":              true,
+	"roam_cluster_id":             true,
+	"remote_site_id":              true,
+	"sdwan_remote_site_id":        true,
+	"virtual_network_override_id": true,
+	"dev_id_override":             true,
+	"filter_ids":                  true,
+	"dismissed_ids":               true,
+	"dpigroup_id":                 true,
+}
+
+// MACFields maps fields holding device or client MAC addresses that don't
+// follow the foo_mac / foo_macs convention to whether they hold a list.
+var MACFields = map[string]bool{
+	"chassis_id":      false, // lldp_table entries
+	"mac_filter_list": true,
+}
+
+// Prefixes are prefixes stripped from field names before resource lookup.
+var Prefixes = []string{
+	"igmp_proxy_downstream_",
+	"multicast_router_",
+	"dot1x_fallback_",
+	"excluded_",
+	"native_",
+	"voice_",
+	"src_",
+	"dst_",
+}
+
+// Rules reported by Tables.Match.
+const (
+	RuleSkip       = "skip"       // listed in the skip fields
+	RuleMAC        = "mac"        // MAC address resolved against devices and clients
+	RuleOverride   = "override"   // resource taken from the override table
+	RuleConvention = "convention" // resource derived from the foo_id naming convention
+	RuleNone       = "none"       // not a reference field, or no matching resource
+)
+
+// Tables are the rules mapping field names to the resources they reference.
+type Tables struct {
+	Overrides  map[string]string // field, with or without a prefix -> resource
+	SkipFields map[string]bool   // fields that are never resolved
+	Prefixes   []string          // prefixes stripped before resource lookup
+	MACFields  map[string]bool   // MAC fields off the foo_mac convention -> whether they hold a list
+	Resources  map[string]string // lowercase resource name -> PascalCase
+}
+
+// Defaults returns the built-in tables for the given resources.
+func Defaults(resources map[string]string) Tables {
+	return Tables{
+		Overrides:  Overrides,
+		SkipFields: SkipFields,
+		Prefixes:   Prefixes,
+		MACFields:  MACFields,
+		Resources:  resources,
+	}
+}
+
+// Match describes how a field maps to a resource.
+type Match struct {
+	Rule           string // one of the Rule constants
+	Resource       string // resource referenced, with RuleOverride and RuleConvention
+	List           bool   // whether the field holds a list
+	StrippedPrefix string // prefix removed before the lookup, if any
+	Key            string // override table key, or the base name looked up by convention
+	NameField      string // _name or _names field standing for the field
+}
+
+// Match reports how field maps to a resource. The resolver and the tool
+// generator both use it, so the name inputs advertised in tool schemas are
+// the ones the resolver accepts.
+func (t Tables) Match(field string) Match {
+	if t.SkipFields[field] {
+		return Match{Rule: RuleSkip}
+	}
+
+	isList, isMAC := t.MACFields[field]
+	if !isMAC {
+		isList = strings.HasSuffix(field, "_macs")
+		isMAC = isList || strings.HasSuffix(field, "_mac")
+	}
+	if isMAC {
+		m := Match{Rule: RuleMAC, List: isList, NameField: field + "_name"}
+		if isList {
+			m.NameField = field + "_names"
+		}
+		return m
+	}
+
+	// Overrides may name the full field or the field without its prefix.
+	var m Match
+	suffix := field
+	if _, ok := t.Overrides[field]; !ok {
+		for _, prefix := range t.Prefixes {
+			if strings.HasPrefix(suffix, prefix) {
+				suffix = strings.TrimPrefix(suffix, prefix)
+				m.StrippedPrefix = prefix
+				break
+			}
+		}
+	}
+
+	var base string
+	switch {
+	case strings.HasSuffix(field, "_ids"):
+		base = strings.TrimSuffix(suffix, "_ids")
+		m.List = true
+		m.NameField = strings.TrimSuffix(field, "_ids") + "_names"
+	case strings.HasSuffix(field, "_id"):
+		base = strings.TrimSuffix(suffix, "_id")
+		m.NameField = strings.TrimSuffix(field, "_id") + "_name"
+	}
+
+	if resource, ok := t.Overrides[suffix]; ok {
+		m.Rule, m.Resource, m.Key = RuleOverride, resource, suffix
+		return m
+	}
+	m.Key = base
+	if base != "" {
+		if resource, ok := t.Resources[strings.ToLower(SnakeToPascal(base))]; ok {
+			m.Rule, m.Resource = RuleConvention, resource
+			return m
+		}
+	}
+	return Match{Rule: RuleNone, StrippedPrefix: m.StrippedPrefix, Key: m.Key}
+}
+
+// SnakeToPascal converts a snake_case string to PascalCase.
+func SnakeToPascal(s string) string {
+	parts := strings.Split(s, "_")
+	var b strings.Builder
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
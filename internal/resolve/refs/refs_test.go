@@ -0,0 +1,56 @@
+package refs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	tables := Defaults(map[string]string{"network": "Network", "usergroup": "UserGroup", "firewallgroup": "FirewallGroup"})
+	tests := []struct {
+		field string
+		want  Match
+	}{
+		{"usergroup_id", Match{Rule: RuleConvention, Resource: "UserGroup", Key: "usergroup", NameField: "usergroup_name"}},
+		{"networkconf_id", Match{Rule: RuleOverride, Resource: "Network", Key: "networkconf_id", NameField: "networkconf_name"}},
+		{"excluded_networkconf_ids", Match{Rule: RuleOverride, Resource: "Network", List: true, StrippedPrefix: "excluded_", Key: "networkconf_ids", NameField: "excluded_networkconf_names"}},
+		{"src_firewall_group_id", Match{Rule: RuleOverride, Resource: "FirewallGroup", StrippedPrefix: "src_", Key: "firewall_group_id", NameField: "src_firewall_group_name"}},
+		{"site_id", Match{Rule: RuleSkip}},
+		{"uplink_mac", Match{Rule: RuleMAC, NameField: "uplink_mac_name"}},
+		{"chassis_id", Match{Rule: RuleMAC, NameField: "chassis_id_name"}},
+		{"mac_filter_list", Match{Rule: RuleMAC, List: true, NameField: "mac_filter_list_names"}},
+		{"widget_id", Match{Rule: RuleNone, Key: "widget"}},
+		{"name", Match{Rule: RuleNone}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.field, func(t *testing.T) {
+			assert.Equal(t, tc.want, tables.Match(tc.field))
+		})
+	}
+
+	// Tables other than the defaults
+	tables.Overrides = map[string]string{"guest_lan_id": "Network"}
+	tables.Prefixes = []string{"backup_"}
+	assert.Equal(t, "Network", tables.Match("backup_guest_lan_id").Resource)
+	assert.Equal(t, RuleNone, tables.Match("networkconf_id").Rule)
+}
+
+func TestSnakeToPascal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"network", "Network"},
+		{"user_group", "UserGroup"},
+		{"ap_group", "ApGroup"},
+		{"firewall_rule", "FirewallRule"},
+		{"wlan", "Wlan"},
+		{"dhcp_option", "DhcpOption"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.want, SnakeToPascal(tc.input))
+		})
+	}
+}
@@ -1,5 +1,5 @@
 // Package resolve provides ID-to-name resolution for UniFi MCP tool responses.
-// When enabled, it scans JSON responses for _id/_ids fields, looks up referenced
+// When enabled, it scans tool responses for _id/_ids fields, looks up referenced
 // resources via the UniFi API, and injects human-readable _name/_names sibling fields.
 package resolve
 
@@ -8,74 +8,57 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"maps"
 	"reflect"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/iancoleman/orderedmap"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/claytono/go-unifi-mcp/internal/resolve/refs"
 )
 
-// Overrides maps non-standard field suffixes to their resource names.
-// These fields don't follow the convention of foo_id -> Foo.
-var Overrides = map[string]string{
-	"networkconf_id":    "Network",
-	"networkconf_ids":   "Network",
-	"radiusprofile_id":  "RADIUSProfile",
-	"firewallgroup_ids": "FirewallGroup",
-	"firewall_group_id": "FirewallGroup",
-}
+// DisplayFields maps resources to the item fields tried, in order, for their
+// human-readable name. Resources not listed use defaultDisplayFields.
+var DisplayFields = map[string][]string{}
 
-// SkipFields contains field names that should never be resolved.
-var SkipFields = map[string]bool{
-	"_id":                         true,
-	"attr_hidden_id":              true,
-	"site_id":                     true,
-	"ulp_user_id":                 true,
-	"facebook_app_id":             true,
-	"wechat_app_id":               true,
-	"wechat_shop_id":              true,
-	"google_client_id":            true,
-	"facebook_wifi_gw_id":         true,
-	"engine_id":                   true,
-	"anqp_domain_id":              true,
-	"roam_cluster_id":             true,
-	"remote_site_id":              true,
-	"sdwan_remote_site_id":        true,
-	"virtual_network_override_id": true,
-	"dev_id_override":             true,
-	"filter_ids":                  true,
-	"dismissed_ids":               true,
-	"dpigroup_id":                 true,
-}
-
-// knownPrefixes are prefixes stripped from field names before resource lookup.
-var knownPrefixes = []string{
-	"igmp_proxy_downstream_",
-	"multicast_router_",
-	"dot1x_fallback_",
-	"excluded_",
-	"native_",
-	"voice_",
-	"src_",
-	"dst_",
-}
+var defaultDisplayFields = []string{"name", "hostname", "alias"}
 
 // Resolver resolves ID references in JSON responses to human-readable names.
 type Resolver struct {
 	client    any               // unifi.Client for reflection calls
 	resources map[string]string // lowercase resource -> PascalCase
 	logger    *slog.Logger
+
+	// Resolution rules, seeded from the package defaults and extended by
+	// Extend.
+	overrides     map[string]string
+	skipFields    map[string]bool
+	prefixes      []string
+	macFields     map[string]bool
+	displayFields map[string][]string
+
+	// matches memoizes match, since the same field names repeat in every
+	// item of a list.
+	matches sync.Map // field name -> refs.Match
 }
 
-// New creates a new Resolver.
+// New creates a new Resolver using the default resolution rules.
 func New(client any, resources map[string]string, logger *slog.Logger) *Resolver {
 	if logger == nil {
 		logger = slog.New(discardHandler{})
 	}
 	return &Resolver{
-		client:    client,
-		resources: resources,
-		logger:    logger,
+		client:        client,
+		resources:     resources,
+		logger:        logger,
+		overrides:     maps.Clone(refs.Overrides),
+		skipFields:    maps.Clone(refs.SkipFields),
+		prefixes:      slices.Clone(refs.Prefixes),
+		macFields:     maps.Clone(refs.MACFields),
+		displayFields: maps.Clone(DisplayFields),
 	}
 }
 
@@ -87,197 +70,370 @@ func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
 func (d discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return d }
 func (d discardHandler) WithGroup(string) slog.Handler           { return d }
 
-// requestCache holds per-request cached list results.
+// requestCache holds per-request cached list results. It is shared through the
+// context so that selector lookups and response resolution within one tool
+// call list each resource at most once.
 type requestCache struct {
-	// resource -> id -> name
-	data map[string]map[string]string
+	lists map[string][]map[string]any  // resource -> list results
+	errs  map[string]error             // resource -> list error
+	names map[string]map[string]string // resource -> id -> name
+	macs  map[string]string            // normalized MAC -> device/client name
 }
 
 func newRequestCache() *requestCache {
-	return &requestCache{data: make(map[string]map[string]string)}
+	return &requestCache{
+		lists: make(map[string][]map[string]any),
+		errs:  make(map[string]error),
+		names: make(map[string]map[string]string),
+	}
+}
+
+type cacheKey struct{}
+
+// withRequestCache returns a context carrying a request cache, reusing one
+// that is already present.
+func withRequestCache(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(cacheKey{}).(*requestCache); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, cacheKey{}, newRequestCache())
 }
 
+// cacheFromContext returns the request cache carried by ctx, or a fresh one.
+func cacheFromContext(ctx context.Context) *requestCache {
+	if cache, ok := ctx.Value(cacheKey{}).(*requestCache); ok {
+		return cache
+	}
+	return newRequestCache()
+}
+
+// prefetchConcurrency bounds the list calls issued in parallel by prefetch.
+const prefetchConcurrency = 4
+
 // ResolveJSON takes a JSON string, resolves ID references, and returns the modified JSON.
-// It preserves the original key order by using an ordered map for unmarshaling/marshaling.
+// The original key order is preserved, with each _name field placed right after
+// the field it was resolved from. Handlers returning structured output are
+// resolved in place through ResolveValue instead.
 func (r *Resolver) ResolveJSON(ctx context.Context, site, jsonStr string) (string, error) {
+	trimmed := strings.TrimSpace(jsonStr)
+	kind := "object"
+	switch {
+	case strings.HasPrefix(trimmed, "["):
+		kind = "array"
+	case strings.HasPrefix(trimmed, "{"):
+	default:
+		return jsonStr, nil
+	}
+
+	v, err := payload.FromJSON([]byte(jsonStr))
+	if err != nil {
+		return jsonStr, fmt.Errorf("failed to parse JSON %s: %w", kind, err)
+	}
+	r.ResolveValue(ctx, site, v.Data)
+	result, err := v.MarshalIndent()
+	if err != nil {
+		return jsonStr, fmt.Errorf("failed to marshal resolved JSON: %w", err)
+	}
+	return string(result), nil
+}
+
+// ResolveValue resolves ID references in decoded JSON data (objects, arrays
+// of objects and nested values as produced by encoding/json) in place,
+// adding _name/_names fields to the objects holding the references.
+// All referenced resource lists are fetched concurrently before names are injected.
+func (r *Resolver) ResolveValue(ctx context.Context, site string, data any) {
 	start := time.Now()
-	cache := newRequestCache()
-	var fieldsResolved int
+	cache := cacheFromContext(ctx)
 
-	trimmed := strings.TrimSpace(jsonStr)
-	if strings.HasPrefix(trimmed, "[") {
-		var items []*orderedmap.OrderedMap
-		if err := json.Unmarshal([]byte(jsonStr), &items); err != nil {
-			return jsonStr, fmt.Errorf("failed to parse JSON array: %w", err)
+	prefetchStart := time.Now()
+	listsFetched := r.prefetch(ctx, site, data, cache)
+	prefetchDuration := time.Since(prefetchStart)
+
+	fieldsResolved := r.resolveValue(ctx, site, data, cache)
+	r.logger.Debug("resolve: completed",
+		"fields_resolved", fieldsResolved,
+		"lists_fetched", listsFetched,
+		"prefetch_duration", prefetchDuration,
+		"duration", time.Since(start))
+}
+
+// prefetch collects the resources referenced anywhere in data and fetches
+// the lists not yet cached concurrently, at most prefetchConcurrency at a
+// time. It returns the number of lists fetched.
+func (r *Resolver) prefetch(ctx context.Context, site string, data any, cache *requestCache) int {
+	need := make(map[string]bool)
+	r.collectReferences(data, need)
+	var missing []string
+	for resource := range need {
+		_, cached := cache.lists[resource]
+		_, failed := cache.errs[resource]
+		if !cached && !failed {
+			missing = append(missing, resource)
 		}
-		for _, item := range items {
-			if item == nil {
+	}
+	if len(missing) == 0 {
+		return 0
+	}
+	sort.Strings(missing)
+
+	type fetched struct {
+		items    []map[string]any
+		err      error
+		duration time.Duration
+	}
+	results := make([]fetched, len(missing))
+	sem := make(chan struct{}, prefetchConcurrency)
+	var wg sync.WaitGroup
+	for i, resource := range missing {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			start := time.Now()
+			items, err := r.listResource(ctx, site, resource)
+			results[i] = fetched{items, err, time.Since(start)}
+		}()
+	}
+	wg.Wait()
+
+	for i, resource := range missing {
+		r.recordList(cache, resource, results[i].items, results[i].err, results[i].duration)
+	}
+	return len(missing)
+}
+
+// collectReferences adds the resources referenced anywhere in data to need.
+func (r *Resolver) collectReferences(data any, need map[string]bool) {
+	switch v := data.(type) {
+	case map[string]any:
+		for key, value := range v {
+			r.collectReferences(value, need)
+			if isEmptyReference(value) {
+				continue
+			}
+			if single, list := r.isMACField(key); single || list {
+				need["Device"] = true
+				need["User"] = true
+				continue
+			}
+			if !strings.HasSuffix(key, "_id") && !strings.HasSuffix(key, "_ids") {
 				continue
 			}
-			fieldsResolved += r.resolveOrderedMap(ctx, site, item, cache)
+			if resource, ok := r.ResourceForField(key); ok {
+				need[resource] = true
+			}
 		}
-		result, err := json.MarshalIndent(items, "", "  ")
-		if err != nil {
-			return jsonStr, fmt.Errorf("failed to marshal resolved JSON: %w", err)
+	case []map[string]any:
+		for _, item := range v {
+			r.collectReferences(item, need)
 		}
-		r.logger.Debug("resolve: completed",
-			"fields_resolved", fieldsResolved,
-			"duration", time.Since(start))
-		return string(result), nil
-	}
-
-	if strings.HasPrefix(trimmed, "{") {
-		om := orderedmap.New()
-		if err := json.Unmarshal([]byte(jsonStr), om); err != nil {
-			return jsonStr, fmt.Errorf("failed to parse JSON object: %w", err)
+	case []any:
+		for _, elem := range v {
+			r.collectReferences(elem, need)
 		}
-		fieldsResolved = r.resolveOrderedMap(ctx, site, om, cache)
-		result, err := json.MarshalIndent(om, "", "  ")
-		if err != nil {
-			return jsonStr, fmt.Errorf("failed to marshal resolved JSON: %w", err)
+	case *payload.Value:
+		if v != nil {
+			r.collectReferences(v.Data, need)
 		}
-		r.logger.Debug("resolve: completed",
-			"fields_resolved", fieldsResolved,
-			"duration", time.Since(start))
-		return string(result), nil
 	}
-
-	return jsonStr, nil
 }
 
-// resolveOrderedMap resolves ID references in a single ordered map, inserting
-// _name fields immediately after their corresponding _id fields.
-// It recurses into nested objects and arrays.
-func (r *Resolver) resolveOrderedMap(ctx context.Context, site string, om *orderedmap.OrderedMap, cache *requestCache) int {
-	// First pass: collect resolutions keyed by the _id field name.
-	type insertion struct {
-		nameKey   string
-		nameValue any
+// isEmptyReference reports whether a reference field's value has nothing to
+// resolve.
+func isEmptyReference(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	default:
+		return true
 	}
-	insertions := make(map[string]insertion)
-	var nestedResolved int
+}
 
-	for _, key := range om.Keys() {
-		value, _ := om.Get(key)
+// insertion is a _name field to add next to the field it was resolved from.
+type insertion struct {
+	nameKey   string
+	nameValue any
+}
 
-		// Recurse into nested objects and arrays.
-		// orderedmap unmarshals nested objects as value types (orderedmap.OrderedMap),
-		// so we handle both pointer and value types.
-		switch nested := value.(type) {
-		case *orderedmap.OrderedMap:
-			if nested != nil {
-				nestedResolved += r.resolveOrderedMap(ctx, site, nested, cache)
-			}
-		case orderedmap.OrderedMap:
-			nestedResolved += r.resolveOrderedMap(ctx, site, &nested, cache)
-			om.Set(key, nested)
-		case []any:
-			for i, elem := range nested {
-				switch nestedOM := elem.(type) {
-				case *orderedmap.OrderedMap:
-					if nestedOM != nil {
-						nestedResolved += r.resolveOrderedMap(ctx, site, nestedOM, cache)
-					}
-				case orderedmap.OrderedMap:
-					nestedResolved += r.resolveOrderedMap(ctx, site, &nestedOM, cache)
-					nested[i] = nestedOM
-				}
-			}
+// resolveValue resolves the objects in data and returns the number of fields
+// resolved.
+func (r *Resolver) resolveValue(ctx context.Context, site string, data any, cache *requestCache) int {
+	var resolved int
+	switch v := data.(type) {
+	case map[string]any:
+		resolved += r.resolveMap(ctx, site, v, cache)
+	case []map[string]any:
+		for _, item := range v {
+			resolved += r.resolveMap(ctx, site, item, cache)
 		}
-
-		if !strings.HasSuffix(key, "_id") && !strings.HasSuffix(key, "_ids") {
-			continue
+	case []any:
+		for _, elem := range v {
+			resolved += r.resolveValue(ctx, site, elem, cache)
+		}
+	case *payload.Value:
+		if v != nil {
+			resolved += r.resolveValue(ctx, site, v.Data, cache)
 		}
+	}
+	return resolved
+}
 
-		resource, ok := r.ResourceForField(key)
-		if !ok {
-			continue
+// resolveMap resolves ID references in a single object, adding a _name field
+// for each, and recurses into nested objects and arrays. Rendering places the
+// _name fields right after their _id fields.
+func (r *Resolver) resolveMap(ctx context.Context, site string, m map[string]any, cache *requestCache) int {
+	// Collect first: adding keys while ranging over m could visit them.
+	var insertions []insertion
+	var nestedResolved int
+
+	for key, value := range m {
+		nestedResolved += r.resolveValue(ctx, site, value, cache)
+		if ins, ok := r.resolveField(ctx, site, key, value, cache); ok {
+			insertions = append(insertions, ins)
 		}
+	}
+	for _, ins := range insertions {
+		m[ins.nameKey] = ins.nameValue
+	}
+	return len(insertions) + nestedResolved
+}
 
-		if strings.HasSuffix(key, "_ids") {
-			ids, ok := value.([]any)
-			if !ok || len(ids) == 0 {
-				continue
-			}
-			names := make([]string, 0, len(ids))
-			for _, idRaw := range ids {
-				id, ok := idRaw.(string)
-				if !ok {
-					continue
-				}
-				name, err := r.lookupName(ctx, site, resource, id, cache)
-				if err != nil || name == "" {
-					continue
-				}
-				names = append(names, name)
-			}
-			if len(names) > 0 {
-				nameKey := strings.TrimSuffix(key, "_ids") + "_names"
-				insertions[key] = insertion{nameKey, names}
-			}
-		} else {
-			id, ok := value.(string)
-			if !ok || id == "" {
+// resolveField resolves a single reference field to its _name insertion.
+func (r *Resolver) resolveField(ctx context.Context, site, key string, value any, cache *requestCache) (insertion, bool) {
+	if single, list := r.isMACField(key); single || list {
+		return r.resolveMACField(ctx, site, key, value, list, cache)
+	}
+
+	if !strings.HasSuffix(key, "_id") && !strings.HasSuffix(key, "_ids") {
+		return insertion{}, false
+	}
+
+	resource, ok := r.ResourceForField(key)
+	if !ok {
+		return insertion{}, false
+	}
+
+	if strings.HasSuffix(key, "_ids") {
+		ids, ok := value.([]any)
+		if !ok || len(ids) == 0 {
+			return insertion{}, false
+		}
+		names := make([]string, 0, len(ids))
+		for _, idRaw := range ids {
+			id, ok := idRaw.(string)
+			if !ok {
 				continue
 			}
 			name, err := r.lookupName(ctx, site, resource, id, cache)
 			if err != nil || name == "" {
 				continue
 			}
-			nameKey := strings.TrimSuffix(key, "_id") + "_name"
-			insertions[key] = insertion{nameKey, name}
+			names = append(names, name)
+		}
+		if len(names) == 0 {
+			return insertion{}, false
 		}
+		return insertion{strings.TrimSuffix(key, "_ids") + "_names", names}, true
 	}
 
-	if len(insertions) == 0 {
-		return nestedResolved
+	id, ok := value.(string)
+	if !ok || id == "" {
+		return insertion{}, false
+	}
+	name, err := r.lookupName(ctx, site, resource, id, cache)
+	if err != nil || name == "" {
+		return insertion{}, false
 	}
+	return insertion{strings.TrimSuffix(key, "_id") + "_name", name}, true
+}
 
-	// Second pass: rebuild the ordered map with _name fields after their _id fields.
-	newOM := orderedmap.New()
-	for _, key := range om.Keys() {
-		value, _ := om.Get(key)
-		newOM.Set(key, value)
-		if ins, ok := insertions[key]; ok {
-			newOM.Set(ins.nameKey, ins.nameValue)
+// isMACField reports whether a field holds a single MAC address or a list of
+// them. The device's or client's own "mac" field is not a reference.
+func (r *Resolver) isMACField(key string) (single, list bool) {
+	m := r.match(key)
+	if m.Rule != RuleMAC {
+		return false, false
+	}
+	return !m.List, m.List
+}
+
+// resolveMACField looks up the device or client names for a MAC-bearing
+// field, producing a key_name (or key_names) insertion.
+func (r *Resolver) resolveMACField(ctx context.Context, site, key string, value any, list bool, cache *requestCache) (insertion, bool) {
+	if !list {
+		mac, ok := value.(string)
+		if !ok || mac == "" {
+			return insertion{}, false
 		}
+		name := r.lookupMAC(ctx, site, mac, cache)
+		if name == "" {
+			return insertion{}, false
+		}
+		return insertion{key + "_name", name}, true
 	}
 
-	// Replace contents of the original map.
-	// Snapshot keys first since Delete mutates the underlying slice.
-	oldKeys := make([]string, len(om.Keys()))
-	copy(oldKeys, om.Keys())
-	for _, key := range oldKeys {
-		om.Delete(key)
+	macs, ok := value.([]any)
+	if !ok || len(macs) == 0 {
+		return insertion{}, false
+	}
+	names := make([]string, 0, len(macs))
+	for _, raw := range macs {
+		mac, ok := raw.(string)
+		if !ok {
+			continue
+		}
+		if name := r.lookupMAC(ctx, site, mac, cache); name != "" {
+			names = append(names, name)
+		}
 	}
-	for _, key := range newOM.Keys() {
-		value, _ := newOM.Get(key)
-		om.Set(key, value)
+	if len(names) == 0 {
+		return insertion{}, false
 	}
+	return insertion{key + "_names", names}, true
+}
 
-	return len(insertions) + nestedResolved
+// lookupMAC returns the name of the device or client with the given MAC,
+// building a MAC index from ListDevice and ListUser once per request.
+// Devices win when both lists contain the MAC.
+func (r *Resolver) lookupMAC(ctx context.Context, site, mac string, cache *requestCache) string {
+	if cache.macs == nil {
+		cache.macs = make(map[string]string)
+		for _, resource := range []string{"Device", "User"} {
+			items, err := r.cachedList(ctx, site, resource, cache)
+			if err != nil {
+				continue
+			}
+			for _, item := range items {
+				itemMAC, _ := item["mac"].(string)
+				name := r.displayName(resource, item)
+				if itemMAC == "" || name == "" {
+					continue
+				}
+				if _, ok := cache.macs[normalizeMAC(itemMAC)]; !ok {
+					cache.macs[normalizeMAC(itemMAC)] = name
+				}
+			}
+		}
+	}
+	return cache.macs[normalizeMAC(mac)]
 }
 
 // lookupName looks up the name for a resource ID, using the per-request cache.
 func (r *Resolver) lookupName(ctx context.Context, site, resource, id string, cache *requestCache) (string, error) {
-	if idMap, ok := cache.data[resource]; ok {
+	if idMap, ok := cache.names[resource]; ok {
 		return idMap[id], nil
 	}
 
-	// Fetch the list for this resource type
-	start := time.Now()
-	items, err := r.listResource(ctx, site, resource)
+	items, err := r.cachedList(ctx, site, resource, cache)
 	if err != nil {
 		// Cache empty map to avoid retrying on every field
-		cache.data[resource] = make(map[string]string)
+		cache.names[resource] = make(map[string]string)
 		return "", err
 	}
 
-	r.logger.Debug("resolve: fetched resource list",
-		"resource", resource,
-		"count", len(items),
-		"duration", time.Since(start))
-
 	// Build id -> name index
 	idMap := make(map[string]string, len(items))
 	for _, item := range items {
@@ -285,20 +441,68 @@ func (r *Resolver) lookupName(ctx context.Context, site, resource, id string, ca
 		if itemID == "" {
 			continue
 		}
-		// Try "name" first, then "hostname"
-		name, _ := item["name"].(string)
-		if name == "" {
-			name, _ = item["hostname"].(string)
-		}
-		if name != "" {
+		if name := r.displayName(resource, item); name != "" {
 			idMap[itemID] = name
 		}
 	}
-	cache.data[resource] = idMap
+	cache.names[resource] = idMap
 
 	return idMap[id], nil
 }
 
+// cachedList returns the list results for a resource, fetching them at most
+// once per request.
+func (r *Resolver) cachedList(ctx context.Context, site, resource string, cache *requestCache) ([]map[string]any, error) {
+	if err, ok := cache.errs[resource]; ok {
+		return nil, err
+	}
+	if items, ok := cache.lists[resource]; ok {
+		return items, nil
+	}
+
+	start := time.Now()
+	items, err := r.listResource(ctx, site, resource)
+	r.recordList(cache, resource, items, err, time.Since(start))
+	return items, err
+}
+
+// recordList stores a list result, or the error fetching it, in the cache.
+func (r *Resolver) recordList(cache *requestCache, resource string, items []map[string]any, err error, duration time.Duration) {
+	if err != nil {
+		cache.errs[resource] = err
+		r.logger.Debug("resolve: failed to fetch resource list",
+			"resource", resource,
+			"error", err,
+			"duration", duration)
+		return
+	}
+
+	r.logger.Debug("resolve: fetched resource list",
+		"resource", resource,
+		"count", len(items),
+		"duration", duration)
+
+	cache.lists[resource] = items
+}
+
+// displayName returns the human-readable name of a list item: the first
+// non-empty display field configured for the resource.
+func (r *Resolver) displayName(resource string, item map[string]any) string {
+	for _, key := range r.displayFieldsFor(resource) {
+		if name, _ := item[key].(string); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+func (r *Resolver) displayFieldsFor(resource string) []string {
+	if fields, ok := r.displayFields[resource]; ok {
+		return fields
+	}
+	return defaultDisplayFields
+}
+
 // listResource calls List<Resource>(ctx, site) via reflection and returns the results as maps.
 func (r *Resolver) listResource(ctx context.Context, site, resource string) ([]map[string]any, error) {
 	methodName := "List" + resource
@@ -340,53 +544,22 @@ func (r *Resolver) listResource(ctx context.Context, site, resource string) ([]m
 
 // ResourceForField returns the resource name for a given field name, or false if unresolvable.
 func (r *Resolver) ResourceForField(fieldName string) (string, bool) {
-	// Check skip list
-	if SkipFields[fieldName] {
-		return "", false
-	}
-
-	// Strip known prefixes
-	suffix := fieldName
-	for _, prefix := range knownPrefixes {
-		if strings.HasPrefix(suffix, prefix) {
-			suffix = strings.TrimPrefix(suffix, prefix)
-			break
-		}
-	}
-
-	// Check override table
-	if resource, ok := Overrides[suffix]; ok {
-		return resource, true
-	}
-
-	// Auto-resolve: strip _id/_ids suffix, snake_to_PascalCase, case-insensitive lookup
-	var base string
-	switch {
-	case strings.HasSuffix(suffix, "_ids"):
-		base = strings.TrimSuffix(suffix, "_ids")
-	case strings.HasSuffix(suffix, "_id"):
-		base = strings.TrimSuffix(suffix, "_id")
-	default:
-		return "", false
-	}
-
-	pascal := snakeToPascal(base)
-	if resource, ok := r.resources[strings.ToLower(pascal)]; ok {
-		return resource, true
-	}
-
-	return "", false
+	m := r.match(fieldName)
+	return m.Resource, m.Rule == RuleOverride || m.Rule == RuleConvention
 }
 
-// snakeToPascal converts a snake_case string to PascalCase.
-func snakeToPascal(s string) string {
-	parts := strings.Split(s, "_")
-	var b strings.Builder
-	for _, part := range parts {
-		if part == "" {
-			continue
-		}
-		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+// match applies the resolver's rules to a field name.
+func (r *Resolver) match(field string) refs.Match {
+	if cached, ok := r.matches.Load(field); ok {
+		return cached.(refs.Match)
 	}
-	return b.String()
+	m := refs.Tables{
+		Overrides:  r.overrides,
+		SkipFields: r.skipFields,
+		Prefixes:   r.prefixes,
+		MACFields:  r.macFields,
+		Resources:  r.resources,
+	}.Match(field)
+	r.matches.Store(field, m)
+	return m
 }
@@ -1,15 +1,22 @@
 package resolve
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
+	"os"
 	"reflect"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/claytono/go-unifi-mcp/internal/resolve/refs"
 	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
-	"github.com/iancoleman/orderedmap"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,10 +27,19 @@ type mockClient struct {
 	networks    []mockNetwork
 	userGroups  []mockUserGroup
 	listErr     error
+	mu          sync.Mutex
 	listCalls   int
 	listCallLog []string
 }
 
+// record counts a list call; the resolver may issue them concurrently.
+func (m *mockClient) record(method string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listCalls++
+	m.listCallLog = append(m.listCallLog, method)
+}
+
 type mockNetwork struct {
 	ID   string `json:"_id"`
 	Name string `json:"name"`
@@ -35,8 +51,7 @@ type mockUserGroup struct {
 }
 
 func (m *mockClient) ListNetwork(_ context.Context, _ string) ([]mockNetwork, error) {
-	m.listCalls++
-	m.listCallLog = append(m.listCallLog, "ListNetwork")
+	m.record("ListNetwork")
 	if m.listErr != nil {
 		return nil, m.listErr
 	}
@@ -44,8 +59,7 @@ func (m *mockClient) ListNetwork(_ context.Context, _ string) ([]mockNetwork, er
 }
 
 func (m *mockClient) ListUserGroup(_ context.Context, _ string) ([]mockUserGroup, error) {
-	m.listCalls++
-	m.listCallLog = append(m.listCallLog, "ListUserGroup")
+	m.record("ListUserGroup")
 	if m.listErr != nil {
 		return nil, m.listErr
 	}
@@ -53,8 +67,7 @@ func (m *mockClient) ListUserGroup(_ context.Context, _ string) ([]mockUserGroup
 }
 
 func (m *mockClient) ListFirewallGroup(_ context.Context, _ string) ([]mockNetwork, error) {
-	m.listCalls++
-	m.listCallLog = append(m.listCallLog, "ListFirewallGroup")
+	m.record("ListFirewallGroup")
 	return []mockNetwork{
 		{ID: "fwg1", Name: "LAN Group"},
 		{ID: "fwg2", Name: "WAN Group"},
@@ -62,8 +75,7 @@ func (m *mockClient) ListFirewallGroup(_ context.Context, _ string) ([]mockNetwo
 }
 
 func (m *mockClient) ListAPGroup(_ context.Context, _ string) ([]mockNetwork, error) {
-	m.listCalls++
-	m.listCallLog = append(m.listCallLog, "ListAPGroup")
+	m.record("ListAPGroup")
 	return []mockNetwork{
 		{ID: "ap1", Name: "Default AP Group"},
 		{ID: "ap2", Name: "Office APs"},
@@ -71,8 +83,7 @@ func (m *mockClient) ListAPGroup(_ context.Context, _ string) ([]mockNetwork, er
 }
 
 func (m *mockClient) ListRADIUSProfile(_ context.Context, _ string) ([]mockNetwork, error) {
-	m.listCalls++
-	m.listCallLog = append(m.listCallLog, "ListRADIUSProfile")
+	m.record("ListRADIUSProfile")
 	return []mockNetwork{
 		{ID: "rad1", Name: "Corp RADIUS"},
 	}, nil
@@ -110,6 +121,7 @@ func newTestResolver(client any) *Resolver {
 		"portprofile":   "PortProfile",
 		"firewallrule":  "FirewallRule",
 		"firewallzone":  "FirewallZone",
+		"user":          "User",
 	}
 	return New(client, resources, nil)
 }
@@ -631,7 +643,7 @@ func TestBuildResourceIndex_WithRealMetadata(t *testing.T) {
 func TestOverrideTableResourcesExist(t *testing.T) {
 	index := BuildResourceIndex(generated.AllToolMetadata)
 
-	for field, resource := range Overrides {
+	for field, resource := range refs.Overrides {
 		_, exists := index[strings.ToLower(resource)]
 		assert.True(t, exists, "override %q -> %q references non-existent resource", field, resource)
 	}
@@ -640,7 +652,7 @@ func TestOverrideTableResourcesExist(t *testing.T) {
 func TestSkipFieldsNotResolved(t *testing.T) {
 	resolver := newTestResolver(nil)
 
-	for field := range SkipFields {
+	for field := range refs.SkipFields {
 		_, ok := resolver.ResourceForField(field)
 		assert.False(t, ok, "skip field %q should not resolve", field)
 	}
@@ -664,7 +676,7 @@ func TestAllIDFieldsResolvable(t *testing.T) {
 			if !strings.HasSuffix(field, "_id") && !strings.HasSuffix(field, "_ids") {
 				continue
 			}
-			if SkipFields[field] {
+			if refs.SkipFields[field] {
 				continue
 			}
 			_, ok := resolver.ResourceForField(field)
@@ -711,25 +723,6 @@ func collectJSONFields(typ reflect.Type) []string {
 	return fields
 }
 
-func TestSnakeToPascal(t *testing.T) {
-	tests := []struct {
-		input string
-		want  string
-	}{
-		{"network", "Network"},
-		{"user_group", "UserGroup"},
-		{"ap_group", "ApGroup"},
-		{"firewall_rule", "FirewallRule"},
-		{"wlan", "Wlan"},
-		{"dhcp_option", "DhcpOption"},
-	}
-	for _, tc := range tests {
-		t.Run(tc.input, func(t *testing.T) {
-			assert.Equal(t, tc.want, snakeToPascal(tc.input))
-		})
-	}
-}
-
 func TestNewLogger(t *testing.T) {
 	// Just verify it returns a non-nil logger for all levels
 	levels := []string{"disabled", "trace", "debug", "info", "warn", "error", "unknown"}
@@ -1000,45 +993,32 @@ func TestResolveJSON_NestedAndTopLevel(t *testing.T) {
 	assert.Equal(t, "LAN", child["networkconf_name"])
 }
 
-func TestResolveOrderedMap_PointerNestedObject(t *testing.T) {
+func TestResolveValue_NestedObject(t *testing.T) {
 	client := &mockClient{
 		networks: []mockNetwork{{ID: "net1", Name: "LAN"}},
 	}
 	resolver := newTestResolver(client)
 
-	// Manually construct an ordered map with a *orderedmap.OrderedMap child
-	om := orderedmap.New()
-	child := orderedmap.New()
-	child.Set("networkconf_id", "net1")
-	om.Set("name", "test")
-	om.Set("child", child) // pointer type
+	child := map[string]any{"networkconf_id": "net1"}
+	data := map[string]any{"name": "test", "child": child}
 
-	cache := newRequestCache()
-	resolved := resolver.resolveOrderedMap(context.Background(), "default", om, cache)
-	assert.Equal(t, 1, resolved)
+	resolver.ResolveValue(context.Background(), "default", data)
 
-	val, _ := child.Get("networkconf_name")
-	assert.Equal(t, "LAN", val)
+	assert.Equal(t, "LAN", child["networkconf_name"])
 }
 
-func TestResolveOrderedMap_PointerNestedInArray(t *testing.T) {
+func TestResolveValue_NestedInArray(t *testing.T) {
 	client := &mockClient{
 		networks: []mockNetwork{{ID: "net1", Name: "LAN"}},
 	}
 	resolver := newTestResolver(client)
 
-	// Manually construct an ordered map with []*orderedmap.OrderedMap array
-	om := orderedmap.New()
-	child := orderedmap.New()
-	child.Set("networkconf_id", "net1")
-	om.Set("items", []any{child}) // pointer in array
+	child := map[string]any{"networkconf_id": "net1"}
+	data := []map[string]any{{"items": []any{child}}, nil}
 
-	cache := newRequestCache()
-	resolved := resolver.resolveOrderedMap(context.Background(), "default", om, cache)
-	assert.Equal(t, 1, resolved)
+	resolver.ResolveValue(context.Background(), "default", data)
 
-	val, _ := child.Get("networkconf_name")
-	assert.Equal(t, "LAN", val)
+	assert.Equal(t, "LAN", child["networkconf_name"])
 }
 
 func TestResolveJSON_NilArrayElements(t *testing.T) {
@@ -1114,3 +1094,605 @@ func TestWrapHandler_ReturnsCorrectType(t *testing.T) {
 	// Verify it satisfies server.ToolHandlerFunc
 	var _ = handler
 }
+
+type mockUser struct {
+	ID       string `json:"_id"`
+	Name     string `json:"name,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	MAC      string `json:"mac,omitempty"`
+}
+
+type mockSelectorClient struct {
+	mockClient
+	users []mockUser
+}
+
+func (m *mockSelectorClient) ListUser(_ context.Context, _ string) ([]mockUser, error) {
+	m.record("ListUser")
+	return m.users, nil
+}
+
+func newSelectorClient() *mockSelectorClient {
+	return &mockSelectorClient{
+		mockClient: mockClient{
+			networks: []mockNetwork{
+				{ID: "net1", Name: "LAN"},
+				{ID: "net2", Name: "IoT"},
+				{ID: "net3", Name: "IoT"},
+			},
+		},
+		users: []mockUser{
+			{ID: "u1", Name: "Printer", MAC: "aa:bb:cc:dd:ee:01"},
+			{ID: "u2", Hostname: "laptop", MAC: "aa:bb:cc:dd:ee:02"},
+		},
+	}
+}
+
+func TestLookupID(t *testing.T) {
+	resolver := newTestResolver(newSelectorClient())
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		resource string
+		field    string
+		value    string
+		want     string
+		wantErr  string
+	}{
+		{name: "exact name", resource: "Network", field: "name", value: "LAN", want: "net1"},
+		{name: "case-insensitive name", resource: "Network", field: "name", value: "lan", want: "net1"},
+		{name: "hostname fallback", resource: "User", field: "name", value: "laptop", want: "u2"},
+		{name: "mac", resource: "User", field: "mac", value: "AA-BB-CC-DD-EE-01", want: "u1"},
+		{name: "bare mac", resource: "User", field: "mac", value: "aabbccddee02", want: "u2"},
+		{name: "not found", resource: "Network", field: "name", value: "Guest", wantErr: `no Network with name "Guest"`},
+		{name: "ambiguous", resource: "Network", field: "name", value: "IoT",
+			wantErr: `name "IoT" matches 2 Network objects, use id to select one: IoT (net2), IoT (net3)`},
+		{name: "list error", resource: "Missing", field: "name", value: "x", wantErr: "failed to look up Missing by name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resolver.LookupID(ctx, "default", tt.resource, tt.field, tt.value)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+}
+
+func TestNormalizeMAC(t *testing.T) {
+	assert.Equal(t, "aa:bb:cc:dd:ee:ff", normalizeMAC("AA:BB:CC:DD:EE:FF"))
+	assert.Equal(t, "aa:bb:cc:dd:ee:ff", normalizeMAC("aa-bb-cc-dd-ee-ff"))
+	assert.Equal(t, "aa:bb:cc:dd:ee:ff", normalizeMAC("aabb.ccdd.eeff"))
+	assert.Equal(t, "aa:bb:cc:dd:ee:ff", normalizeMAC(" aabbccddeeff "))
+	assert.Equal(t, "not-a-mac", normalizeMAC("Not-A-MAC"))
+}
+
+func TestWrapSelector_ByName(t *testing.T) {
+	client := newSelectorClient()
+	resolver := newTestResolver(client)
+
+	var gotArgs map[string]any
+	inner := WrapHandler(func(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		gotArgs = req.GetArguments()
+		return mcp.NewToolResultText(`{"_id": "u9", "network_id": "net1"}`), nil
+	}, resolver)
+	handler := WrapSelector(inner, resolver, "Network", []string{"name"})
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"name": "LAN"}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	assert.Equal(t, map[string]any{"id": "net1"}, gotArgs)
+	text := result.Content[0].(mcp.TextContent).Text
+	assert.Contains(t, text, `"network_name": "LAN"`)
+	assert.Equal(t, 1, client.listCalls, "lookup and resolution should share one list call")
+}
+
+func TestWrapSelector_ByMAC(t *testing.T) {
+	resolver := newTestResolver(newSelectorClient())
+
+	var gotArgs map[string]any
+	handler := WrapSelector(func(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		gotArgs = req.GetArguments()
+		return mcp.NewToolResultText(`{}`), nil
+	}, resolver, "User", []string{"name", "mac"})
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"mac": "AA:BB:CC:DD:EE:02", "site": "default"}
+	_, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"id": "u2", "site": "default"}, gotArgs)
+}
+
+func TestWrapSelector_IDTakesPrecedence(t *testing.T) {
+	client := newSelectorClient()
+	resolver := newTestResolver(client)
+
+	var gotArgs map[string]any
+	handler := WrapSelector(func(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		gotArgs = req.GetArguments()
+		return mcp.NewToolResultText(`{}`), nil
+	}, resolver, "Network", []string{"name"})
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"id": "net2", "name": "Renamed"}
+	_, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"id": "net2", "name": "Renamed"}, gotArgs)
+	assert.Equal(t, 0, client.listCalls)
+}
+
+func TestWrapSelector_Errors(t *testing.T) {
+	resolver := newTestResolver(newSelectorClient())
+	called := false
+	handler := WrapSelector(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		called = true
+		return mcp.NewToolResultText(`{}`), nil
+	}, resolver, "Network", []string{"name"})
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"name": "IoT"}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "IoT (net2), IoT (net3)")
+
+	req.Params.Arguments = map[string]any{}
+	result, err = handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, "one of id, name is required", result.Content[0].(mcp.TextContent).Text)
+	assert.False(t, called)
+}
+
+func TestResolveInput(t *testing.T) {
+	client := newSelectorClient()
+	client.userGroups = []mockUserGroup{{ID: "ug1", Name: "Default"}}
+	resolver := newTestResolver(client)
+	fields := map[string]bool{
+		"name":                     true,
+		"usergroup_id":             true,
+		"src_networkconf_id":       true,
+		"excluded_networkconf_ids": true,
+		"display_name":             true,
+	}
+
+	args := map[string]any{
+		"name":                       "rule",
+		"usergroup_name":             "default",
+		"src_networkconf_name":       "LAN",
+		"excluded_networkconf_names": []any{"LAN"},
+		"display_name":               "kept as is",
+	}
+	got, err := resolver.ResolveInput(context.Background(), "default", args, fields)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"name":                     "rule",
+		"usergroup_id":             "ug1",
+		"src_networkconf_id":       "net1",
+		"excluded_networkconf_ids": []any{"net1"},
+		"display_name":             "kept as is",
+	}, got)
+	assert.Contains(t, args, "usergroup_name", "input map should not be modified")
+	assert.Equal(t, 2, client.listCalls)
+}
+
+func TestResolveInput_NoNames(t *testing.T) {
+	client := newSelectorClient()
+	resolver := newTestResolver(client)
+	args := map[string]any{"network_name": "LAN", "usergroup_id": "ug1"}
+
+	// network_id is not a parameter of this tool, so network_name is left alone.
+	got, err := resolver.ResolveInput(context.Background(), "default", args, map[string]bool{"usergroup_id": true})
+	require.NoError(t, err)
+	assert.Equal(t, args, got)
+	assert.Equal(t, 0, client.listCalls)
+}
+
+func TestResolveInput_Errors(t *testing.T) {
+	resolver := newTestResolver(newSelectorClient())
+	fields := map[string]bool{"network_id": true, "networkconf_ids": true}
+
+	tests := []struct {
+		name    string
+		args    map[string]any
+		wantErr string
+	}{
+		{name: "unknown", args: map[string]any{"network_name": "Guest"}, wantErr: `network_name: no Network with name "Guest"`},
+		{name: "ambiguous", args: map[string]any{"networkconf_names": []any{"LAN", "IoT"}}, wantErr: `networkconf_names: name "IoT" matches 2 Network objects`},
+		{name: "not a string", args: map[string]any{"network_name": 5}, wantErr: "network_name must be a non-empty string"},
+		{name: "not an array", args: map[string]any{"networkconf_names": "LAN"}, wantErr: "networkconf_names must be an array of names"},
+		{name: "conflict", args: map[string]any{"network_id": "net9", "network_name": "LAN"}, wantErr: "network_id and network_name refer to different Network objects"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resolver.ResolveInput(context.Background(), "default", tt.args, fields)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	got, err := resolver.ResolveInput(context.Background(), "default",
+		map[string]any{"network_id": "net1", "network_name": "LAN"}, fields)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"network_id": "net1"}, got)
+}
+
+func TestWrapInput(t *testing.T) {
+	resolver := newTestResolver(newSelectorClient())
+	var gotArgs map[string]any
+	handler := WrapInput(func(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		gotArgs = req.GetArguments()
+		return mcp.NewToolResultText(`{}`), nil
+	}, resolver, map[string]bool{"network_id": true})
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"network_name": "LAN"}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, map[string]any{"network_id": "net1"}, gotArgs)
+
+	gotArgs = nil
+	req.Params.Arguments = map[string]any{"network_name": "Guest"}
+	result, err = handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Nil(t, gotArgs)
+}
+
+type mockMACDevice struct {
+	ID   string `json:"_id"`
+	Name string `json:"name,omitempty"`
+	MAC  string `json:"mac"`
+}
+
+type mockMACClient struct {
+	devices   []mockMACDevice
+	users     []mockUser
+	listCalls atomic.Int32
+}
+
+func (m *mockMACClient) ListDevice(_ context.Context, _ string) ([]mockMACDevice, error) {
+	m.listCalls.Add(1)
+	return m.devices, nil
+}
+
+func (m *mockMACClient) ListUser(_ context.Context, _ string) ([]mockUser, error) {
+	m.listCalls.Add(1)
+	return m.users, nil
+}
+
+func newMACClient() *mockMACClient {
+	return &mockMACClient{
+		devices: []mockMACDevice{
+			{ID: "d1", Name: "Core Switch", MAC: "f0:9f:c2:00:00:01"},
+			{ID: "d2", Name: "Lobby AP", MAC: "F0:9F:C2:00:00:02"},
+		},
+		users: []mockUser{
+			{ID: "u1", Name: "Printer", MAC: "aa:bb:cc:dd:ee:01"},
+			{ID: "u2", Hostname: "laptop", MAC: "aa:bb:cc:dd:ee:02"},
+			{ID: "u3", MAC: "aa:bb:cc:dd:ee:03"},
+		},
+	}
+}
+
+func TestIsMACField(t *testing.T) {
+	tests := map[string][2]bool{
+		"uplink_mac":      {true, false},
+		"last_uplink_mac": {true, false},
+		"ap_mac":          {true, false},
+		"chassis_id":      {true, false},
+		"mac_filter_list": {false, true},
+		"member_macs":     {false, true},
+		"mac":             {false, false},
+		"network_id":      {false, false},
+	}
+	resolver := newTestResolver(nil)
+	for key, want := range tests {
+		single, list := resolver.isMACField(key)
+		assert.Equal(t, want, [2]bool{single, list}, key)
+	}
+}
+
+func TestResolveJSON_MACFields(t *testing.T) {
+	client := newMACClient()
+	resolver := newTestResolver(client)
+
+	input := `[
+		{"mac": "f0:9f:c2:00:00:02", "uplink_mac": "F0-9F-C2-00-00-01", "last_uplink_mac": "f0:9f:c2:00:00:01",
+		 "lldp_table": [{"chassis_id": "f09fc2000001", "port_id": "Port 4"}, {"chassis_id": "switch.local"}]},
+		{"ap_mac": "f0:9f:c2:00:00:02", "mac_filter_list": ["aa:bb:cc:dd:ee:01", "AA:BB:CC:DD:EE:02", "aa:bb:cc:dd:ee:03", "00:00:00:00:00:00"]}
+	]`
+	result, err := resolver.ResolveJSON(context.Background(), "default", input)
+	require.NoError(t, err)
+
+	var items []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &items))
+	assert.NotContains(t, items[0], "mac_name")
+	assert.Equal(t, "Core Switch", items[0]["uplink_mac_name"])
+	assert.Equal(t, "Core Switch", items[0]["last_uplink_mac_name"])
+	lldp := items[0]["lldp_table"].([]any)
+	assert.Equal(t, "Core Switch", lldp[0].(map[string]any)["chassis_id_name"])
+	assert.NotContains(t, lldp[1], "chassis_id_name")
+	assert.Equal(t, "Lobby AP", items[1]["ap_mac_name"])
+	assert.Equal(t, []any{"Printer", "laptop"}, items[1]["mac_filter_list_names"])
+
+	// The name sits right after the MAC field.
+	assert.Regexp(t, `"uplink_mac": "F0-9F-C2-00-00-01",\s+"uplink_mac_name"`, result)
+	assert.Equal(t, int32(2), client.listCalls.Load(), "ListDevice and ListUser should each be called once")
+}
+
+func TestResolveJSON_MACDevicePrecedence(t *testing.T) {
+	client := newMACClient()
+	client.users = append(client.users, mockUser{ID: "u4", Name: "Wired client", MAC: "f0:9f:c2:00:00:01"})
+	resolver := newTestResolver(client)
+
+	result, err := resolver.ResolveJSON(context.Background(), "default", `{"uplink_mac": "f0:9f:c2:00:00:01"}`)
+	require.NoError(t, err)
+	assert.Contains(t, result, `"uplink_mac_name": "Core Switch"`)
+}
+
+func TestExplain(t *testing.T) {
+	resolver := newTestResolver(nil)
+
+	tests := []struct {
+		field     string
+		rule      string
+		resources []string
+		prefix    string
+		nameField string
+	}{
+		{field: "site_id", rule: RuleSkip},
+		{field: "uplink_mac", rule: RuleMAC, resources: []string{"Device", "User"}, nameField: "uplink_mac_name"},
+		{field: "src_networkconf_id", rule: RuleOverride, resources: []string{"Network"}, prefix: "src_", nameField: "src_networkconf_name"},
+		{field: "usergroup_id", rule: RuleConvention, resources: []string{"UserGroup"}, nameField: "usergroup_name"},
+		{field: "excluded_network_ids", rule: RuleConvention, resources: []string{"Network"}, prefix: "excluded_", nameField: "excluded_network_names"},
+		{field: "widget_id", rule: RuleNone},
+		{field: "name", rule: RuleNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			e := resolver.Explain(tt.field)
+			assert.Equal(t, tt.rule, e.Rule)
+			assert.Equal(t, tt.resources, e.Resources)
+			assert.Equal(t, tt.prefix, e.StrippedPrefix)
+			assert.Equal(t, tt.nameField, e.NameField)
+			assert.NotEmpty(t, e.Detail)
+		})
+	}
+}
+
+func TestExtend(t *testing.T) {
+	client := &mockSelectorClient{
+		mockClient: mockClient{networks: []mockNetwork{{ID: "net1", Name: "LAN"}}},
+		users:      []mockUser{{ID: "u1", MAC: "aa:bb:cc:dd:ee:01"}},
+	}
+	resolver := newTestResolver(client)
+	require.NoError(t, resolver.Extend(&Rules{
+		Overrides:     map[string]string{"guest_lan_id": "network"},
+		SkipFields:    []string{"usergroup_id"},
+		Prefixes:      []string{"backup_"},
+		MACFields:     map[string]bool{"peer": false},
+		DisplayFields: map[string][]string{"user": {"name", "mac"}},
+	}))
+
+	resource, ok := resolver.ResourceForField("guest_lan_id")
+	assert.True(t, ok)
+	assert.Equal(t, "Network", resource)
+	_, ok = resolver.ResourceForField("usergroup_id")
+	assert.False(t, ok)
+	resource, ok = resolver.ResourceForField("backup_network_id")
+	assert.True(t, ok)
+	assert.Equal(t, "Network", resource)
+
+	result, err := resolver.ResolveJSON(context.Background(), "default", `{"peer": "AA:BB:CC:DD:EE:01"}`)
+	require.NoError(t, err)
+	assert.Contains(t, result, `"peer_name": "aa:bb:cc:dd:ee:01"`)
+
+	// The package defaults are untouched.
+	assert.NotContains(t, refs.Overrides, "guest_lan_id")
+	assert.False(t, refs.SkipFields["usergroup_id"])
+	_, ok = newTestResolver(client).ResourceForField("usergroup_id")
+	assert.True(t, ok)
+}
+
+func TestExtend_Errors(t *testing.T) {
+	resolver := newTestResolver(nil)
+	assert.ErrorContains(t, resolver.Extend(&Rules{Overrides: map[string]string{"foo_id": "Widget"}}), `override foo_id: unknown resource "Widget"`)
+	assert.ErrorContains(t, resolver.Extend(&Rules{Prefixes: []string{""}}), "prefixes must not be empty")
+	assert.ErrorContains(t, resolver.Extend(&Rules{DisplayFields: map[string][]string{"Widget": {"name"}}}), `display_fields: unknown resource "Widget"`)
+	assert.ErrorContains(t, resolver.Extend(&Rules{DisplayFields: map[string][]string{"Device": {}}}), "at least one field is required")
+	assert.NoError(t, resolver.Extend(nil))
+}
+
+func TestLoadRules(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/rules.yaml"
+	require.NoError(t, os.WriteFile(path, []byte(`
+overrides:
+  guest_lan_id: Network
+skip_fields: [legacy_id]
+prefixes: [backup_]
+mac_fields:
+  peer_list: true
+display_fields:
+  Device: [name, model]
+`), 0o600))
+
+	rules, err := LoadRules(path)
+	require.NoError(t, err)
+	assert.Equal(t, &Rules{
+		Overrides:     map[string]string{"guest_lan_id": "Network"},
+		SkipFields:    []string{"legacy_id"},
+		Prefixes:      []string{"backup_"},
+		MACFields:     map[string]bool{"peer_list": true},
+		DisplayFields: map[string][]string{"Device": {"name", "model"}},
+	}, rules)
+
+	empty := dir + "/empty.yaml"
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	rules, err = LoadRules(empty)
+	require.NoError(t, err)
+	assert.Equal(t, &Rules{}, rules)
+
+	unknown := dir + "/unknown.yaml"
+	require.NoError(t, os.WriteFile(unknown, []byte("overide:\n  foo_id: Network\n"), 0o600))
+	_, err = LoadRules(unknown)
+	assert.ErrorContains(t, err, "failed to parse")
+
+	_, err = LoadRules(dir + "/missing.yaml")
+	assert.Error(t, err)
+}
+
+func TestExplainHandler(t *testing.T) {
+	handler := ExplainHandler(newTestResolver(nil))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"field": "dst_firewallgroup_ids"}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	var e Explanation
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &e))
+	assert.Equal(t, RuleOverride, e.Rule)
+	assert.Equal(t, []string{"FirewallGroup"}, e.Resources)
+	assert.Equal(t, "dst_firewallgroup_names", e.NameField)
+
+	req.Params.Arguments = map[string]any{}
+	result, err = handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+// barrierClient's list calls block until all of them have started, so
+// resolution only completes when the lists are fetched concurrently.
+type barrierClient struct {
+	started atomic.Int32
+	all     chan struct{}
+	want    int32
+}
+
+func (b *barrierClient) wait() error {
+	if b.started.Add(1) == b.want {
+		close(b.all)
+	}
+	select {
+	case <-b.all:
+		return nil
+	case <-time.After(2 * time.Second):
+		return errors.New("list calls were not concurrent")
+	}
+}
+
+func (b *barrierClient) ListNetwork(_ context.Context, _ string) ([]mockNetwork, error) {
+	return []mockNetwork{{ID: "net1", Name: "LAN"}}, b.wait()
+}
+
+func (b *barrierClient) ListUserGroup(_ context.Context, _ string) ([]mockUserGroup, error) {
+	return []mockUserGroup{{ID: "ug1", Name: "Default"}}, b.wait()
+}
+
+func (b *barrierClient) ListFirewallGroup(_ context.Context, _ string) ([]mockNetwork, error) {
+	return []mockNetwork{{ID: "fwg1", Name: "Cameras"}}, b.wait()
+}
+
+func TestResolveJSON_PrefetchesConcurrently(t *testing.T) {
+	client := &barrierClient{all: make(chan struct{}), want: 3}
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	resolver := New(client, newTestResolver(nil).resources, logger)
+
+	input := `[
+		{"src_networkconf_id": "net1"},
+		{"rules": [{"usergroup_id": "ug1", "dst_firewallgroup_ids": ["fwg1"]}]}
+	]`
+	result, err := resolver.ResolveJSON(context.Background(), "default", input)
+	require.NoError(t, err)
+
+	assert.Contains(t, result, `"src_networkconf_name": "LAN"`)
+	assert.Contains(t, result, `"usergroup_name": "Default"`)
+	assert.Contains(t, result, `"dst_firewallgroup_names": [`)
+	assert.Equal(t, int32(3), client.started.Load())
+	assert.Contains(t, logs.String(), "lists_fetched=3")
+	assert.Contains(t, logs.String(), "prefetch_duration=")
+}
+
+func TestResolveJSON_PrefetchSkipsEmptyAndCached(t *testing.T) {
+	client := &mockClient{networks: []mockNetwork{{ID: "net1", Name: "LAN"}}}
+	resolver := newTestResolver(client)
+	ctx := withRequestCache(context.Background())
+
+	_, err := resolver.ResolveJSON(ctx, "default", `{"network_id": "net1", "usergroup_id": "", "firewallgroup_ids": []}`)
+	require.NoError(t, err)
+	_, err = resolver.ResolveJSON(ctx, "default", `{"network_id": "net1"}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ListNetwork"}, client.listCallLog)
+}
+
+// benchmarkClients builds the JSON for n client-like objects referencing
+// eight networks and a user group, as a large site's list_user returns.
+func benchmarkClients(n int) (string, *mockClient) {
+	client := &mockClient{
+		userGroups: []mockUserGroup{{ID: "ug1", Name: "Default"}},
+	}
+	for i := range 8 {
+		client.networks = append(client.networks, mockNetwork{ID: fmt.Sprintf("net%d", i), Name: fmt.Sprintf("VLAN %d", i)})
+	}
+	items := make([]map[string]any, n)
+	for i := range items {
+		items[i] = map[string]any{
+			"_id":          fmt.Sprintf("id%05d", i),
+			"name":         fmt.Sprintf("client-%05d", i),
+			"mac":          fmt.Sprintf("aa:bb:cc:%02x:%02x:%02x", i>>16&0xff, i>>8&0xff, i&0xff),
+			"ip":           fmt.Sprintf("10.0.%d.%d", i/250, i%250),
+			"network_id":   fmt.Sprintf("net%d", i%8),
+			"usergroup_id": "ug1",
+			"is_wired":     i%3 == 0,
+			"rx_bytes":     i * 1024,
+			"last_seen":    1700000000 + i,
+			"stats":        map[string]any{"rssi": -40 - i%40},
+		}
+	}
+	raw, err := json.Marshal(items)
+	if err != nil {
+		panic(err)
+	}
+	return string(raw), client
+}
+
+func BenchmarkResolveJSON(b *testing.B) {
+	input, client := benchmarkClients(5000)
+	resolver := newTestResolver(client)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := resolver.ResolveJSON(context.Background(), "default", input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkResolveValue measures resolving already decoded output, as the
+// middleware does for structured handler results.
+func BenchmarkResolveValue(b *testing.B) {
+	input, client := benchmarkClients(5000)
+	resolver := newTestResolver(client)
+	b.ReportAllocs()
+	for b.Loop() {
+		b.StopTimer()
+		var items []map[string]any
+		if err := json.Unmarshal([]byte(input), &items); err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		resolver.ResolveValue(context.Background(), "default", items)
+	}
+}
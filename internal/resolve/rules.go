@@ -0,0 +1,89 @@
+package resolve
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Rules extends the built-in resolution tables. It is loaded from the file
+// named by UNIFI_RESOLVE_RULES so that fields added by new controller versions
+// can be resolved without a release.
+type Rules struct {
+	// Overrides maps field names (with or without a known prefix) to the
+	// resource they reference, e.g. "guest_networkconf_id: Network".
+	Overrides map[string]string `yaml:"overrides"`
+	// SkipFields are field names that are never resolved.
+	SkipFields []string `yaml:"skip_fields"`
+	// Prefixes are stripped from field names before resource lookup.
+	Prefixes []string `yaml:"prefixes"`
+	// MACFields maps fields holding MAC addresses to whether they hold a list.
+	MACFields map[string]bool `yaml:"mac_fields"`
+	// DisplayFields maps a resource to the item fields tried, in order, for
+	// its human-readable name.
+	DisplayFields map[string][]string `yaml:"display_fields"`
+}
+
+// LoadRules reads resolution rules from a YAML (or JSON) file.
+func LoadRules(path string) (*Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	var rules Rules
+	if err := dec.Decode(&rules); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &rules, nil
+}
+
+// Extend adds rules to the resolver's tables. Resource names are matched
+// case-insensitively against the known resources. It must be called before
+// the resolver is used.
+func (r *Resolver) Extend(rules *Rules) error {
+	if rules == nil {
+		return nil
+	}
+
+	for field, name := range rules.Overrides {
+		resource, ok := r.resources[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("override %s: unknown resource %q", field, name)
+		}
+		r.overrides[field] = resource
+	}
+	for _, field := range rules.SkipFields {
+		r.skipFields[field] = true
+	}
+	for _, prefix := range rules.Prefixes {
+		if prefix == "" {
+			return errors.New("prefixes must not be empty")
+		}
+		if !slices.Contains(r.prefixes, prefix) {
+			r.prefixes = append(r.prefixes, prefix)
+		}
+	}
+	for field, isList := range rules.MACFields {
+		r.macFields[field] = isList
+	}
+	for name, fields := range rules.DisplayFields {
+		resource, ok := r.resources[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("display_fields: unknown resource %q", name)
+		}
+		if len(fields) == 0 {
+			return fmt.Errorf("display_fields %s: at least one field is required", name)
+		}
+		r.displayFields[resource] = fields
+	}
+	r.matches.Clear()
+	return nil
+}
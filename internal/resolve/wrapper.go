@@ -2,7 +2,9 @@ package resolve
 
 import (
 	"context"
+	"strings"
 
+	"github.com/claytono/go-unifi-mcp/internal/payload"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
@@ -27,6 +29,18 @@ func WrapHandler(handler server.ToolHandlerFunc, resolver *Resolver) server.Tool
 			return result, nil
 		}
 
+		// Extract site from args
+		site, _ := args["site"].(string)
+		if site == "" {
+			site = "default"
+		}
+
+		// Structured output is resolved in place
+		if v, ok := payload.FromResult(result); ok {
+			resolver.ResolveValue(ctx, site, v.Data)
+			return result, nil
+		}
+
 		// Don't resolve error results
 		if result == nil || result.IsError || len(result.Content) == 0 {
 			return result, nil
@@ -38,12 +52,6 @@ func WrapHandler(handler server.ToolHandlerFunc, resolver *Resolver) server.Tool
 			return result, nil
 		}
 
-		// Extract site from args
-		site, _ := args["site"].(string)
-		if site == "" {
-			site = "default"
-		}
-
 		// Resolve ID references
 		resolved, resolveErr := resolver.ResolveJSON(ctx, site, textContent.Text)
 		if resolveErr != nil {
@@ -55,3 +63,76 @@ func WrapHandler(handler server.ToolHandlerFunc, resolver *Resolver) server.Tool
 		return mcp.NewToolResultText(resolved), nil
 	}
 }
+
+// WrapSelector decorates a get, update or delete handler so that the target
+// object can be selected by one of selectors (e.g. "name" or "mac") instead
+// of its ID. When "id" is absent, the first selector present is resolved to an
+// ID through the resource's list and replaces the selector argument. The list
+// is cached in the context, so response resolution further in reuses it.
+func WrapSelector(handler server.ToolHandlerFunc, resolver *Resolver, resource string, selectors []string) server.ToolHandlerFunc {
+	if resolver == nil || len(selectors) == 0 {
+		return handler
+	}
+
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := req.GetArguments()
+		if id, ok := args["id"].(string); ok && id != "" {
+			return handler(ctx, req)
+		}
+
+		for _, selector := range selectors {
+			value, ok := args[selector].(string)
+			if !ok || value == "" {
+				continue
+			}
+
+			site, _ := args["site"].(string)
+			if site == "" {
+				site = "default"
+			}
+
+			ctx = withRequestCache(ctx)
+			id, err := resolver.LookupID(ctx, site, resource, selector, value)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			newArgs := make(map[string]any, len(args))
+			for k, v := range args {
+				if k != selector {
+					newArgs[k] = v
+				}
+			}
+			newArgs["id"] = id
+			req.Params.Arguments = newArgs
+			return handler(ctx, req)
+		}
+
+		return mcp.NewToolResultError("one of id, " + strings.Join(selectors, ", ") + " is required"), nil
+	}
+}
+
+// WrapInput decorates a create, update or upsert handler so that ID reference
+// fields can be given by name (see ResolveInput). fields lists the tool's
+// parameters. Unknown or ambiguous names fail the call.
+func WrapInput(handler server.ToolHandlerFunc, resolver *Resolver, fields map[string]bool) server.ToolHandlerFunc {
+	if resolver == nil {
+		return handler
+	}
+
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := req.GetArguments()
+		site, _ := args["site"].(string)
+		if site == "" {
+			site = "default"
+		}
+
+		ctx = withRequestCache(ctx)
+		resolved, err := resolver.ResolveInput(ctx, site, args, fields)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		req.Params.Arguments = resolved
+		return handler(ctx, req)
+	}
+}
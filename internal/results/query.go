@@ -0,0 +1,89 @@
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/budget"
+	"github.com/claytono/go-unifi-mcp/internal/expr"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/claytono/go-unifi-mcp/internal/query"
+	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// listOnlyArgs are the list tool arguments that do not apply to a stored
+// result.
+var listOnlyArgs = []string{"site", "resolve", "humanize", "view", ArgStore}
+
+// QueryTool returns the query_result tool. It takes the query arguments of
+// the generated list tools, so that their descriptions stay in step.
+func QueryTool() mcp.Tool {
+	properties := map[string]any{
+		"result_id": map[string]any{
+			"type":        "string",
+			"description": "ID of a result stored by a list call with store_result",
+		},
+	}
+	for _, meta := range generated.AllToolMetadata {
+		if meta.Category != "list" {
+			continue
+		}
+		props, _ := meta.InputSchema["properties"].(map[string]any)
+		for name, prop := range props {
+			properties[name] = prop
+		}
+		break
+	}
+	for _, name := range listOnlyArgs {
+		delete(properties, name)
+	}
+
+	schema, _ := json.Marshal(map[string]any{
+		"type":       "object",
+		"properties": properties,
+		"required":   []string{"result_id"},
+	})
+	return mcp.NewToolWithRawSchema("query_result",
+		"Queries a result stored by a list call with store_result: true, with the same filter, search, sort, fields, paging, aggregation, expr and format arguments as the list tools, without calling the controller again.",
+		json.RawMessage(schema),
+	)
+}
+
+// QueryHandler returns the handler for the query_result tool, with the
+// output middleware of the list tools: expr, a token budget of maxTokens
+// by default, annotations and rendering in format by default.
+func (s *Store) QueryHandler(format payload.Format, maxTokens int) server.ToolHandlerFunc {
+	handler := expr.WrapHandler(s.query)
+	handler = budget.WrapHandler(handler, maxTokens, format)
+	return payload.RenderFormat(annotate.WrapHandler(handler), format)
+}
+
+// query applies the query arguments to a stored result.
+func (s *Store) query(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, _ := req.GetArguments()["result_id"].(string)
+	if id == "" {
+		return mcp.NewToolResultError("result_id is required"), nil
+	}
+	e, ok := s.get(sessionID(ctx), id)
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf(
+			"unknown result_id %q: results are kept per session, up to %d results and %d bytes, least recently used evicted first; call the list tool with store_result again",
+			id, s.maxResults, s.maxBytes)), nil
+	}
+
+	opts, err := query.ParseOptions(req.GetArguments())
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	var data any = e.items
+	if opts.HasQuery() {
+		if data, err = generated.ApplyQuery(ctx, e.items, opts); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+	return payload.NewResult(ctx, e.value.WithData(data)), nil
+}
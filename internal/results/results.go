@@ -0,0 +1,216 @@
+// Package results keeps list results on the server so that large ones can be
+// explored without fetching them from the controller again. A list call with
+// "store_result": true stores its items and returns a short result_id with a
+// preview; the query_result tool then applies the list query arguments to the
+// stored items.
+//
+// Results are kept per client session. Each session holds a limited number
+// of results and bytes, evicting the least recently used results first.
+package results
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ArgStore is the list tool argument asking for the result to be stored.
+const ArgStore = "store_result"
+
+// PreviewItems is how many stored items a list call returns as a preview.
+const PreviewItems = 3
+
+// Store holds stored results by session. It is safe for concurrent use.
+type Store struct {
+	mu         sync.Mutex
+	maxResults int
+	maxBytes   int
+	sessions   map[string]*session
+	newID      func() string
+}
+
+// session holds the results of one session, most recently used first.
+type session struct {
+	lru   *list.List // of *entry
+	byID  map[string]*list.Element
+	bytes int
+}
+
+// entry is a stored result.
+type entry struct {
+	id    string
+	tool  string
+	value *payload.Value // the result, for its key order
+	items []map[string]any
+	size  int // bytes of the compact JSON encoding of items
+}
+
+// NewStore creates a Store keeping up to maxResults results and maxBytes
+// bytes of compact JSON per session.
+func NewStore(maxResults, maxBytes int) *Store {
+	return &Store{
+		maxResults: maxResults,
+		maxBytes:   maxBytes,
+		sessions:   make(map[string]*session),
+		newID:      newID,
+	}
+}
+
+// newID returns a random result ID such as "r_1f2e3d4c5b6a".
+func newID() string {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return "r_" + hex.EncodeToString(b)
+}
+
+// sessionID identifies the client session of ctx. Calls outside a session,
+// such as over stdio before initialization, share the empty ID.
+func sessionID(ctx context.Context) string {
+	if s := server.ClientSessionFromContext(ctx); s != nil {
+		return s.SessionID()
+	}
+	return ""
+}
+
+// put stores the items of a list result for the session, evicting the least
+// recently used results beyond the limits, and returns the new result ID.
+func (s *Store) put(sessionID, tool string, v *payload.Value, items []map[string]any) (string, error) {
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return "", fmt.Errorf("failed to measure result: %w", err)
+	}
+	size := len(raw)
+	if size > s.maxBytes {
+		return "", fmt.Errorf("result of %d bytes exceeds the %d bytes kept per session", size, s.maxBytes)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess := s.sessions[sessionID]
+	if sess == nil {
+		sess = &session{lru: list.New(), byID: make(map[string]*list.Element)}
+		s.sessions[sessionID] = sess
+	}
+	for sess.lru.Len() >= s.maxResults || sess.bytes+size > s.maxBytes {
+		oldest := sess.lru.Back().Value.(*entry)
+		sess.lru.Remove(sess.lru.Back())
+		delete(sess.byID, oldest.id)
+		sess.bytes -= oldest.size
+	}
+
+	e := &entry{id: s.newID(), tool: tool, value: v, items: items, size: size}
+	sess.byID[e.id] = sess.lru.PushFront(e)
+	sess.bytes += size
+	return e.id, nil
+}
+
+// get returns a stored result of the session and marks it recently used.
+func (s *Store) get(sessionID, id string) (*entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess := s.sessions[sessionID]
+	if sess == nil {
+		return nil, false
+	}
+	elem, ok := sess.byID[id]
+	if !ok {
+		return nil, false
+	}
+	sess.lru.MoveToFront(elem)
+	return elem.Value.(*entry), true
+}
+
+// DropSession discards the results of a session, e.g. once it has ended.
+func (s *Store) DropSession(sessionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, sessionID)
+}
+
+// WrapHandler decorates a list tool handler to store its result when called
+// with "store_result": true, returning the result ID, item count, field
+// names and a preview in place of the items. It must run inside
+// annotate.WrapHandler, and before any truncation of the items. With a nil
+// Store, stored results are disabled and such calls fail.
+func (s *Store) WrapHandler(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arg, ok := req.GetArguments()[ArgStore]
+		if !ok || arg == false {
+			return handler(ctx, req)
+		}
+		if arg != true {
+			return mcp.NewToolResultError(ArgStore + " must be a boolean"), nil
+		}
+		if s == nil {
+			return mcp.NewToolResultError("stored results are disabled on this server; page through the list with limit and offset instead"), nil
+		}
+
+		result, err := handler(ctx, req)
+		if err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		v, ok := payload.FromResult(result)
+		if !ok {
+			return result, nil
+		}
+		items, ok := objects(v.Data)
+		if !ok {
+			return mcp.NewToolResultError(ArgStore + " requires a list of objects; store the items without facets, and with no expr or one whose result is an array of objects"), nil
+		}
+		v = v.WithData(items)
+
+		id, err := s.put(sessionID(ctx), req.Params.Name, v, items)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to store result: %v", err)), nil
+		}
+		return payload.NewResult(ctx, summary(id, v, items)), nil
+	}
+}
+
+// objects returns data as a list of objects: the items of a list tool, or
+// the result of an expr building an array of objects.
+func objects(data any) ([]map[string]any, bool) {
+	switch data := data.(type) {
+	case []map[string]any:
+		return data, true
+	case []any:
+		items := make([]map[string]any, len(data))
+		for i, elem := range data {
+			item, ok := elem.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			items[i] = item
+		}
+		return items, true
+	}
+	return nil, false
+}
+
+// summary describes a stored result.
+func summary(id string, v *payload.Value, items []map[string]any) *payload.Value {
+	fields := make(map[string]bool)
+	for _, item := range items {
+		for key := range item {
+			fields[key] = true
+		}
+	}
+	values := map[string]any{
+		"result_id": id,
+		"count":     len(items),
+		"fields":    slices.Sorted(maps.Keys(fields)),
+		"preview":   v.WithData(items[:min(PreviewItems, len(items))]),
+		"hint":      fmt.Sprintf("Query the %d items with query_result {\"result_id\": %q, ...}, using filter, search, sort, fields, limit, offset, group_by, aggregate or facets.", len(items), id),
+	}
+	return payload.Ordered([]string{"result_id", "count", "fields", "preview", "hint"}, values)
+}
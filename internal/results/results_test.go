@@ -0,0 +1,323 @@
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/expr"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStore returns a Store with sequential result IDs r_1, r_2, ...
+func newTestStore(maxResults, maxBytes int) *Store {
+	s := NewStore(maxResults, maxBytes)
+	n := 0
+	s.newID = func() string {
+		n++
+		return fmt.Sprintf("r_%d", n)
+	}
+	return s
+}
+
+func testClients() []map[string]any {
+	clients := make([]map[string]any, 6)
+	for i := range clients {
+		clients[i] = map[string]any{
+			"name":     fmt.Sprintf("client-%d", i),
+			"is_guest": i%3 == 0,
+			"rx_bytes": float64(100 * i),
+		}
+	}
+	return clients
+}
+
+func TestNewID(t *testing.T) {
+	id := newID()
+	assert.Regexp(t, `^r_[0-9a-f]{12}$`, id)
+	assert.NotEqual(t, id, newID())
+}
+
+func TestStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	s := newTestStore(2, 1<<20)
+	items := testClients()
+	v := payload.Ordered(nil, nil)
+
+	first, err := s.put("", "list_user", v, items)
+	require.NoError(t, err)
+	second, err := s.put("", "list_user", v, items)
+	require.NoError(t, err)
+
+	// Using the first result makes the second the least recently used
+	_, ok := s.get("", first)
+	require.True(t, ok)
+	third, err := s.put("", "list_user", v, items)
+	require.NoError(t, err)
+
+	_, ok = s.get("", second)
+	assert.False(t, ok)
+	for _, id := range []string{first, third} {
+		e, ok := s.get("", id)
+		require.True(t, ok, id)
+		assert.Equal(t, "list_user", e.tool)
+	}
+}
+
+func TestStore_MaxBytes(t *testing.T) {
+	items := testClients()
+	raw, err := json.Marshal(items)
+	require.NoError(t, err)
+	size := len(raw)
+
+	// Room for two results by count, but one by size
+	s := newTestStore(2, size+size/2)
+	first, err := s.put("", "list_user", nil, items)
+	require.NoError(t, err)
+	second, err := s.put("", "list_user", nil, items)
+	require.NoError(t, err)
+	_, ok := s.get("", first)
+	assert.False(t, ok)
+	_, ok = s.get("", second)
+	assert.True(t, ok)
+
+	s = newTestStore(2, size-1)
+	_, err = s.put("", "list_user", nil, items)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("result of %d bytes exceeds the %d bytes kept per session", size, size-1))
+}
+
+func TestStore_Sessions(t *testing.T) {
+	s := newTestStore(1, 1<<20)
+	a, err := s.put("a", "list_user", nil, testClients())
+	require.NoError(t, err)
+	b, err := s.put("b", "list_user", nil, testClients())
+	require.NoError(t, err)
+
+	// Each session has its own limits and cannot see other sessions' results
+	_, ok := s.get("a", a)
+	assert.True(t, ok)
+	_, ok = s.get("b", a)
+	assert.False(t, ok)
+	_, ok = s.get("b", b)
+	assert.True(t, ok)
+
+	s.DropSession("a")
+	_, ok = s.get("a", a)
+	assert.False(t, ok)
+	_, ok = s.get("b", b)
+	assert.True(t, ok)
+}
+
+// listHandler returns the items as a list tool would, counting its calls.
+func listHandler(items []map[string]any, calls *int) server.ToolHandlerFunc {
+	return func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		*calls++
+		v, err := payload.FromGo(items)
+		if err != nil {
+			return nil, err
+		}
+		return payload.NewResult(ctx, v), nil
+	}
+}
+
+func call(t *testing.T, handler server.ToolHandlerFunc, name string, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Name = name
+	req.Params.Arguments = args
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	return result
+}
+
+func text(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+	require.Len(t, result.Content, 1)
+	tc, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok)
+	return tc.Text
+}
+
+func decode(t *testing.T, result *mcp.CallToolResult) any {
+	t.Helper()
+	require.False(t, result.IsError, text(t, result))
+	var out any
+	require.NoError(t, json.Unmarshal([]byte(text(t, result)), &out))
+	return out
+}
+
+func TestWrapHandler_StoresAndQueries(t *testing.T) {
+	s := newTestStore(4, 1<<20)
+	calls := 0
+	list := payload.Render(annotate.WrapHandler(s.WrapHandler(listHandler(testClients(), &calls))))
+
+	// Without store_result the items are returned as usual
+	out := decode(t, call(t, list, "list_user", map[string]any{"store_result": false}))
+	assert.Len(t, out, 6)
+
+	result := call(t, list, "list_user", map[string]any{"store_result": true})
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, map[string]any{
+		"result_id": "r_1",
+		"count":     float64(6),
+		"fields":    []any{"is_guest", "name", "rx_bytes"},
+		"preview": []any{
+			map[string]any{"name": "client-0", "is_guest": true, "rx_bytes": float64(0)},
+			map[string]any{"name": "client-1", "is_guest": false, "rx_bytes": float64(100)},
+			map[string]any{"name": "client-2", "is_guest": false, "rx_bytes": float64(200)},
+		},
+		"hint": `Query the 6 items with query_result {"result_id": "r_1", ...}, using filter, search, sort, fields, limit, offset, group_by, aggregate or facets.`,
+	}, decode(t, result))
+	assert.True(t, strings.HasPrefix(text(t, result), "{\n  \"result_id\": \"r_1\",\n  \"count\": 6,"), "keys are rendered in order")
+
+	query := s.QueryHandler(payload.FormatJSON, 0)
+
+	// The whole result, without calling the controller again
+	assert.Len(t, decode(t, call(t, query, "query_result", map[string]any{"result_id": "r_1"})), 6)
+
+	out = decode(t, call(t, query, "query_result", map[string]any{
+		"result_id": "r_1",
+		"filter":    map[string]any{"is_guest": false},
+		"sort":      []any{"-rx_bytes"},
+		"fields":    []any{"name"},
+		"limit":     float64(2),
+	}))
+	assert.Equal(t, map[string]any{
+		"total":       float64(4),
+		"returned":    float64(2),
+		"next_offset": float64(2),
+		"data":        []any{map[string]any{"name": "client-5"}, map[string]any{"name": "client-4"}},
+	}, out)
+
+	out = decode(t, call(t, query, "query_result", map[string]any{
+		"result_id": "r_1",
+		"group_by":  []any{"is_guest"},
+		"aggregate": map[string]any{"sum": "rx_bytes"},
+	}))
+	assert.Equal(t, []any{
+		map[string]any{"is_guest": false, "count": float64(4), "sum_rx_bytes": float64(1200)},
+		map[string]any{"is_guest": true, "count": float64(2), "sum_rx_bytes": float64(300)},
+	}, out)
+
+	out = decode(t, call(t, query, "query_result", map[string]any{"result_id": "r_1", "expr": "map(.name) | length"}))
+	assert.Equal(t, float64(6), out)
+
+	// Queries leave the stored items untouched
+	assert.Equal(t, testClients(), s.sessions[""].byID["r_1"].Value.(*entry).items)
+	assert.Equal(t, 2, calls)
+}
+
+func TestQueryHandler_Errors(t *testing.T) {
+	s := newTestStore(4, 1<<20)
+	query := s.QueryHandler(payload.FormatJSON, 0)
+
+	result := call(t, query, "query_result", map[string]any{})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "result_id is required", text(t, result))
+
+	result = call(t, query, "query_result", map[string]any{"result_id": "r_9"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, text(t, result), `unknown result_id "r_9"`)
+	assert.Contains(t, text(t, result), "call the list tool with store_result again")
+
+	_, err := s.put("", "list_user", payload.Ordered(nil, nil), testClients())
+	require.NoError(t, err)
+	result = call(t, query, "query_result", map[string]any{"result_id": "r_1", "filter": map[string]any{"name": map[string]any{"bogus": 1}}})
+	assert.True(t, result.IsError)
+	assert.Contains(t, text(t, result), "bogus")
+
+	result = call(t, query, "query_result", map[string]any{"result_id": "r_1", "offset": float64(-2)})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "invalid offset: must be a non-negative integer, got -2", text(t, result))
+}
+
+func TestQueryHandler_Budget(t *testing.T) {
+	s := newTestStore(4, 1<<20)
+	_, err := s.put("", "list_user", payload.Ordered(nil, nil), testClients())
+	require.NoError(t, err)
+
+	out := decode(t, call(t, s.QueryHandler(payload.FormatJSON, 45), "query_result", map[string]any{"result_id": "r_1"}))
+	obj, ok := out.(map[string]any)
+	require.True(t, ok)
+	assert.Contains(t, obj, "truncated")
+	assert.Len(t, obj["data"], 2)
+}
+
+func TestWrapHandler_StoresExprResult(t *testing.T) {
+	s := newTestStore(4, 1<<20)
+	calls := 0
+	list := payload.Render(annotate.WrapHandler(s.WrapHandler(expr.WrapHandler(listHandler(testClients(), &calls)))))
+
+	// expr results are decoded JSON arrays rather than lists of objects
+	out := decode(t, call(t, list, "list_user", map[string]any{"store_result": true, "expr": "[.[] | select(.is_guest) | {name}]"}))
+	assert.Equal(t, float64(2), out.(map[string]any)["count"])
+	assert.Equal(t, []any{"name"}, out.(map[string]any)["fields"])
+
+	query := s.QueryHandler(payload.FormatJSON, 0)
+	out = decode(t, call(t, query, "query_result", map[string]any{"result_id": "r_1", "sort": []any{"-name"}}))
+	assert.Equal(t, []any{map[string]any{"name": "client-3"}, map[string]any{"name": "client-0"}}, out)
+
+	// Arrays of anything but objects cannot be stored
+	result := call(t, list, "list_user", map[string]any{"store_result": true, "expr": "[.[] | .name]"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, text(t, result), "store_result requires a list of objects")
+}
+
+func TestWrapHandler_Errors(t *testing.T) {
+	calls := 0
+	list := listHandler(testClients(), &calls)
+
+	result := call(t, annotate.WrapHandler((*Store)(nil).WrapHandler(list)), "list_user", map[string]any{"store_result": true})
+	assert.True(t, result.IsError)
+	assert.Contains(t, text(t, result), "stored results are disabled")
+
+	// Without store_result, a nil Store passes calls through
+	result = call(t, payload.Render((*Store)(nil).WrapHandler(list)), "list_user", nil)
+	assert.False(t, result.IsError)
+
+	s := newTestStore(4, 1<<20)
+	result = call(t, s.WrapHandler(list), "list_user", map[string]any{"store_result": "yes"})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "store_result must be a boolean", text(t, result))
+
+	facets := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return payload.NewResult(ctx, payload.Ordered(nil, map[string]any{"is_guest": []any{}})), nil
+	}
+	result = call(t, payload.Render(s.WrapHandler(facets)), "list_user", map[string]any{"store_result": true})
+	assert.True(t, result.IsError)
+	assert.Contains(t, text(t, result), "store_result requires a list of objects")
+
+	failing := func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("controller unreachable"), nil
+	}
+	result = call(t, s.WrapHandler(failing), "list_user", map[string]any{"store_result": true})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "controller unreachable", text(t, result))
+	assert.Empty(t, s.sessions)
+}
+
+func TestQueryTool(t *testing.T) {
+	tool := QueryTool()
+	assert.Equal(t, "query_result", tool.Name)
+
+	var schema struct {
+		Properties map[string]any `json:"properties"`
+		Required   []string       `json:"required"`
+	}
+	require.NoError(t, json.Unmarshal(tool.RawInputSchema, &schema))
+	assert.Equal(t, []string{"result_id"}, schema.Required)
+	for _, name := range []string{"result_id", "filter", "search", "sort", "fields", "limit", "offset", "group_by", "aggregate", "facets", "expr", "max_tokens", "format"} {
+		assert.Contains(t, schema.Properties, name)
+	}
+	for _, name := range listOnlyArgs {
+		assert.NotContains(t, schema.Properties, name)
+	}
+}
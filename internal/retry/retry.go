@@ -0,0 +1,175 @@
+// Package retry retries transient controller failures. Reads are retried with
+// exponential backoff and jitter, expired username/password sessions are
+// renewed via Login. Mutations are not retried, since a request that timed out
+// may still have been applied.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/stale"
+	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	"github.com/filipowm/go-unifi/unifi"
+)
+
+// Annotation keys recorded on tool results that needed retries.
+const (
+	KeyRetries         = "retries"
+	KeyRetryErrors     = "retry_errors"
+	KeyReauthenticated = "reauthenticated"
+)
+
+// Policy configures retry behavior.
+type Policy struct {
+	MaxRetries int           // retries after the first attempt, 0 disables retries
+	BaseDelay  time.Duration // delay before the first retry, doubled for each further retry
+	MaxDelay   time.Duration // upper bound for a single delay
+}
+
+// DefaultPolicy is the policy used when none is configured.
+var DefaultPolicy = Policy{
+	MaxRetries: 2,
+	BaseDelay:  250 * time.Millisecond,
+	MaxDelay:   2 * time.Second,
+}
+
+// Retrier retries client calls according to a Policy.
+type Retrier struct {
+	policy Policy
+	login  func() error
+	sleep  func(context.Context, time.Duration) error
+
+	mu       sync.Mutex
+	loginGen uint64 // incremented after every successful login
+}
+
+// New creates a Retrier. login is called to renew the session when the
+// controller answers 401; pass nil for API key auth, where re-login is useless.
+func New(policy Policy, login func() error) *Retrier {
+	return &Retrier{
+		policy: policy,
+		login:  login,
+		sleep:  sleepContext,
+	}
+}
+
+// Interceptor returns a client interceptor applying the retry policy.
+func (r *Retrier) Interceptor() generated.Interceptor {
+	return func(ctx context.Context, call generated.Call, next func(context.Context) error) error {
+		retryable := call.Category == "list" || call.Category == "get"
+		annotations := annotate.FromContext(ctx)
+
+		var failures []string
+		retries := 0
+		reauthenticated := false
+		for {
+			gen := r.currentLoginGen()
+			err := next(ctx)
+			if err == nil {
+				if len(failures) > 0 {
+					annotations.Set(KeyRetries, len(failures))
+					annotations.Set(KeyRetryErrors, failures)
+				}
+				if reauthenticated {
+					annotations.Set(KeyReauthenticated, true)
+				}
+				return nil
+			}
+			if ctx.Err() != nil {
+				return err
+			}
+
+			switch {
+			case IsUnauthorized(err) && r.login != nil && !reauthenticated:
+				// A 401 means the request was rejected before it was processed,
+				// so replaying it after a fresh login is safe for every category.
+				if loginErr := r.relogin(gen); loginErr != nil {
+					return fmt.Errorf("%w (re-login failed: %v)", err, loginErr)
+				}
+				reauthenticated = true
+			case retryable && IsTransient(err) && retries < r.policy.MaxRetries:
+				if sleepErr := r.sleep(ctx, r.backoff(retries)); sleepErr != nil {
+					return err
+				}
+				retries++
+			case retries > 0:
+				return fmt.Errorf("%w (gave up after %d retries)", err, retries)
+			default:
+				return err
+			}
+			failures = append(failures, err.Error())
+		}
+	}
+}
+
+func (r *Retrier) currentLoginGen() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.loginGen
+}
+
+// relogin renews the session unless another call already did so since gen
+// was observed, so a burst of 401s results in a single login.
+func (r *Retrier) relogin(gen uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.loginGen != gen {
+		return nil
+	}
+	if err := r.login(); err != nil {
+		return err
+	}
+	r.loginGen++
+	return nil
+}
+
+// backoff returns the delay before retry number n (0-based): exponential
+// growth capped at MaxDelay, with the upper half randomized.
+func (r *Retrier) backoff(n int) time.Duration {
+	delay := r.policy.BaseDelay << n
+	if delay > r.policy.MaxDelay || delay <= 0 {
+		delay = r.policy.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsUnauthorized reports whether err is a 401 from the controller.
+func IsUnauthorized(err error) bool {
+	var serverErr *unifi.ServerError
+	return errors.As(err, &serverErr) && serverErr.StatusCode == http.StatusUnauthorized
+}
+
+// IsTransient reports whether err is worth retrying: connectivity failures,
+// rate limiting and server-side errors.
+func IsTransient(err error) bool {
+	if stale.IsConnectivityError(err) {
+		return true
+	}
+	var serverErr *unifi.ServerError
+	if errors.As(err, &serverErr) {
+		return serverErr.StatusCode == http.StatusTooManyRequests || serverErr.StatusCode >= 500
+	}
+	return false
+}
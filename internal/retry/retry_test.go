@@ -0,0 +1,267 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errUnreachable  = &url.Error{Op: "Get", URL: "https://unifi/api", Err: syscall.ECONNREFUSED}
+	errUnauthorized = &unifi.ServerError{StatusCode: 401, Message: "api.err.LoginRequired"}
+)
+
+// scriptedClient returns the queued errors in order, then succeeds.
+type scriptedClient struct {
+	unifi.Client
+	mu      sync.Mutex
+	errs    []error
+	calls   int
+	creates int
+}
+
+func (c *scriptedClient) next() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func (c *scriptedClient) ListNetwork(_ context.Context, _ string) ([]unifi.Network, error) {
+	if err := c.next(); err != nil {
+		return nil, err
+	}
+	return []unifi.Network{{ID: "net1", Name: "IoT"}}, nil
+}
+
+func (c *scriptedClient) CreateNetwork(_ context.Context, _ string, d *unifi.Network) (*unifi.Network, error) {
+	c.creates++
+	if err := c.next(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func newTestClient(policy Policy, login func() error, errs ...error) (*scriptedClient, *generated.InterceptedClient, *[]time.Duration) {
+	base := &scriptedClient{errs: errs}
+	r := New(policy, login)
+	var sleeps []time.Duration
+	r.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return ctx.Err()
+	}
+	return base, generated.NewInterceptedClient(base, r.Interceptor()), &sleeps
+}
+
+func TestInterceptor_RetriesTransientErrors(t *testing.T) {
+	base, client, sleeps := newTestClient(DefaultPolicy, nil, errUnreachable, &unifi.ServerError{StatusCode: 503})
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	networks, err := client.ListNetwork(ctx, "default")
+	require.NoError(t, err)
+	assert.Len(t, networks, 1)
+	assert.Equal(t, 3, base.calls)
+	assert.Len(t, *sleeps, 2)
+
+	retries, _ := annotations.Get(KeyRetries)
+	assert.Equal(t, 2, retries)
+	failures, _ := annotations.Get(KeyRetryErrors)
+	assert.Len(t, failures, 2)
+}
+
+func TestInterceptor_NoAnnotationsOnFirstSuccess(t *testing.T) {
+	_, client, _ := newTestClient(DefaultPolicy, nil)
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	_, err := client.ListNetwork(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, 0, annotations.Len())
+}
+
+func TestInterceptor_GivesUp(t *testing.T) {
+	base, client, _ := newTestClient(DefaultPolicy, nil, errUnreachable, errUnreachable, errUnreachable)
+
+	_, err := client.ListNetwork(context.Background(), "default")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, syscall.ECONNREFUSED)
+	assert.Contains(t, err.Error(), "gave up after 2 retries")
+	assert.Equal(t, 3, base.calls)
+}
+
+func TestInterceptor_NonTransientNotRetried(t *testing.T) {
+	base, client, sleeps := newTestClient(DefaultPolicy, nil, &unifi.ServerError{StatusCode: 400})
+
+	_, err := client.ListNetwork(context.Background(), "default")
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "gave up")
+	assert.Equal(t, 1, base.calls)
+	assert.Empty(t, *sleeps)
+}
+
+func TestInterceptor_Disabled(t *testing.T) {
+	base, client, _ := newTestClient(Policy{}, nil, errUnreachable)
+
+	_, err := client.ListNetwork(context.Background(), "default")
+	require.Error(t, err)
+	assert.Equal(t, 1, base.calls)
+}
+
+func TestInterceptor_CreateNotRetried(t *testing.T) {
+	base, client, sleeps := newTestClient(DefaultPolicy, nil, errUnreachable)
+
+	_, err := client.CreateNetwork(context.Background(), "default", &unifi.Network{Name: "IoT"})
+	require.Error(t, err)
+	assert.Equal(t, 1, base.creates)
+	assert.Empty(t, *sleeps)
+}
+
+func TestInterceptor_Relogin(t *testing.T) {
+	logins := 0
+	login := func() error { logins++; return nil }
+	base, client, sleeps := newTestClient(DefaultPolicy, login, errUnauthorized)
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	_, err := client.ListNetwork(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, 1, logins)
+	assert.Equal(t, 2, base.calls)
+	assert.Empty(t, *sleeps)
+
+	reauth, _ := annotations.Get(KeyReauthenticated)
+	assert.Equal(t, true, reauth)
+}
+
+func TestInterceptor_ReloginAppliesToMutations(t *testing.T) {
+	logins := 0
+	login := func() error { logins++; return nil }
+	base, client, _ := newTestClient(DefaultPolicy, login, errUnauthorized)
+
+	_, err := client.CreateNetwork(context.Background(), "default", &unifi.Network{Name: "IoT"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, logins)
+	assert.Equal(t, 2, base.creates)
+}
+
+func TestInterceptor_ReloginOnlyOnce(t *testing.T) {
+	logins := 0
+	login := func() error { logins++; return nil }
+	_, client, _ := newTestClient(DefaultPolicy, login, errUnauthorized, errUnauthorized)
+
+	_, err := client.ListNetwork(context.Background(), "default")
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, 1, logins)
+}
+
+func TestInterceptor_ReloginFailure(t *testing.T) {
+	login := func() error { return errors.New("bad credentials") }
+	_, client, _ := newTestClient(DefaultPolicy, login, errUnauthorized)
+
+	_, err := client.ListNetwork(context.Background(), "default")
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+	assert.Contains(t, err.Error(), "re-login failed: bad credentials")
+}
+
+func TestInterceptor_NoLoginFunc(t *testing.T) {
+	base, client, _ := newTestClient(DefaultPolicy, nil, errUnauthorized)
+
+	_, err := client.ListNetwork(context.Background(), "default")
+	require.Error(t, err)
+	assert.Equal(t, 1, base.calls)
+}
+
+func TestRelogin_SkippedWhenAlreadyRenewed(t *testing.T) {
+	logins := 0
+	r := New(DefaultPolicy, func() error { logins++; return nil })
+
+	gen := r.currentLoginGen()
+	require.NoError(t, r.relogin(gen))
+	// A second call that observed the same generation reuses the new session.
+	require.NoError(t, r.relogin(gen))
+	assert.Equal(t, 1, logins)
+	assert.Equal(t, uint64(1), r.currentLoginGen())
+}
+
+func TestInterceptor_ContextCanceled(t *testing.T) {
+	base, client, sleeps := newTestClient(DefaultPolicy, nil, errUnreachable, errUnreachable)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.ListNetwork(ctx, "default")
+	require.Error(t, err)
+	assert.Equal(t, 1, base.calls)
+	assert.Empty(t, *sleeps)
+}
+
+func TestBackoff(t *testing.T) {
+	r := New(Policy{MaxRetries: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}, nil)
+
+	for n, want := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		max := want * time.Millisecond
+		for range 20 {
+			d := r.backoff(n)
+			assert.GreaterOrEqual(t, d, max/2, "retry %d", n)
+			assert.LessOrEqual(t, d, max, "retry %d", n)
+		}
+	}
+
+	assert.Equal(t, time.Duration(0), New(Policy{}, nil).backoff(3))
+}
+
+func TestSleepContext(t *testing.T) {
+	require.NoError(t, sleepContext(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, sleepContext(ctx, time.Hour), context.Canceled)
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"url error", errUnreachable, true},
+		{"wrapped url error", fmt.Errorf("request: %w", errUnreachable), true},
+		{"too many requests", &unifi.ServerError{StatusCode: 429}, true},
+		{"internal server error", &unifi.ServerError{StatusCode: 500}, true},
+		{"service unavailable", &unifi.ServerError{StatusCode: 503}, true},
+		{"bad request", &unifi.ServerError{StatusCode: 400}, false},
+		{"unauthorized", errUnauthorized, false},
+		{"not found", unifi.ErrNotFound, false},
+		{"canceled", context.Canceled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsTransient(tt.err))
+		})
+	}
+}
+
+func TestIsUnauthorized(t *testing.T) {
+	assert.True(t, IsUnauthorized(errUnauthorized))
+	assert.True(t, IsUnauthorized(fmt.Errorf("list: %w", errUnauthorized)))
+	assert.False(t, IsUnauthorized(&unifi.ServerError{StatusCode: 403}))
+	assert.False(t, IsUnauthorized(errUnreachable))
+	assert.False(t, IsUnauthorized(nil))
+}
@@ -3,7 +3,10 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net/url"
 	"testing"
+	"time"
 
 	servermocks "github.com/claytono/go-unifi-mcp/internal/server/mocks"
 	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
@@ -22,7 +25,7 @@ func TestServerToolCount(t *testing.T) {
 	s, err := New(Options{Client: client, Mode: ModeEager})
 	assert.NoError(t, err)
 	assert.NotNil(t, s)
-	assert.Len(t, s.ListTools(), 242)
+	assert.Len(t, s.ListTools(), 274)
 }
 
 func TestLazyModeEndToEnd(t *testing.T) {
@@ -166,3 +169,283 @@ func TestEagerModeEndToEnd(t *testing.T) {
 
 	client.AssertExpectations(t)
 }
+
+func TestStaleFallbackEndToEnd(t *testing.T) {
+	ctx := context.Background()
+	client := servermocks.NewClient(t)
+	unreachable := &url.Error{Op: "Get", URL: "https://unifi/api/s/default/rest/networkconf", Err: errors.New("connection refused")}
+	client.On("ListNetwork", mock.Anything, "default").Return([]unifi.Network{{ID: "net1", Name: "IoT"}}, nil).Once()
+	client.On("ListNetwork", mock.Anything, "default").Return(nil, unreachable).Once()
+	client.On("GetNetwork", mock.Anything, "default", "net1").Return(nil, unreachable).Once()
+
+	s, err := New(Options{Client: client, Mode: ModeEager, StaleMaxAge: time.Hour})
+	require.NoError(t, err)
+
+	mcpClient, err := clientpkg.NewInProcessClient(s)
+	require.NoError(t, err)
+	defer func() {
+		err = mcpClient.Close()
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, mcpClient.Start(ctx))
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "integration-test", Version: "1.0.0"}
+	_, err = mcpClient.Initialize(ctx, initRequest)
+	require.NoError(t, err)
+
+	listRequest := mcp.CallToolRequest{}
+	listRequest.Params.Name = "list_network"
+	listRequest.Params.Arguments = map[string]any{"resolve": false}
+
+	// First call succeeds and is returned as-is.
+	fresh, err := mcpClient.CallTool(ctx, listRequest)
+	require.NoError(t, err)
+	require.False(t, fresh.IsError)
+	assert.NotContains(t, fresh.Content[0].(mcp.TextContent).Text, `"stale"`)
+
+	// Second call fails to reach the controller and is served from the snapshot.
+	staleResult, err := mcpClient.CallTool(ctx, listRequest)
+	require.NoError(t, err)
+	require.False(t, staleResult.IsError)
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal([]byte(staleResult.Content[0].(mcp.TextContent).Text), &envelope))
+	assert.Equal(t, true, envelope["stale"])
+	assert.Contains(t, envelope["controller_error"], "connection refused")
+	require.Len(t, envelope["data"], 1)
+
+	// Mutations never fall back to stale data.
+	updateRequest := mcp.CallToolRequest{}
+	updateRequest.Params.Name = "update_network"
+	updateRequest.Params.Arguments = map[string]any{"id": "net1", "name": "IoT-2"}
+	updateResult, err := mcpClient.CallTool(ctx, updateRequest)
+	require.NoError(t, err)
+	assert.True(t, updateResult.IsError)
+
+	client.AssertExpectations(t)
+}
+
+func TestReloginEndToEnd(t *testing.T) {
+	ctx := context.Background()
+	client := servermocks.NewClient(t)
+	expired := &unifi.ServerError{StatusCode: 401, Message: "api.err.LoginRequired"}
+	client.On("ListNetwork", mock.Anything, "default").Return(nil, expired).Once()
+	client.On("Login").Return(nil).Once()
+	client.On("ListNetwork", mock.Anything, "default").Return([]unifi.Network{{ID: "net1", Name: "IoT"}}, nil).Once()
+
+	s, err := New(Options{Client: client, Mode: ModeEager, Relogin: true})
+	require.NoError(t, err)
+
+	mcpClient, err := clientpkg.NewInProcessClient(s)
+	require.NoError(t, err)
+	defer func() {
+		err = mcpClient.Close()
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, mcpClient.Start(ctx))
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "integration-test", Version: "1.0.0"}
+	_, err = mcpClient.Initialize(ctx, initRequest)
+	require.NoError(t, err)
+
+	listRequest := mcp.CallToolRequest{}
+	listRequest.Params.Name = "list_network"
+	listRequest.Params.Arguments = map[string]any{"resolve": false}
+
+	result, err := mcpClient.CallTool(ctx, listRequest)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &envelope))
+	assert.Equal(t, true, envelope["reauthenticated"])
+	require.Len(t, envelope["data"], 1)
+
+	client.AssertExpectations(t)
+}
+
+func TestIdempotencyKeyEndToEnd(t *testing.T) {
+	ctx := context.Background()
+	client := servermocks.NewClient(t)
+	client.On("CreatePortForward", mock.Anything, "default", mock.Anything).
+		Return(&unifi.PortForward{ID: "pf1", Name: "ssh"}, nil).Once()
+
+	s, err := New(Options{Client: client, Mode: ModeLazy, IdempotencyWindow: time.Hour})
+	require.NoError(t, err)
+
+	mcpClient, err := clientpkg.NewInProcessClient(s)
+	require.NoError(t, err)
+	defer func() {
+		err = mcpClient.Close()
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, mcpClient.Start(ctx))
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "integration-test", Version: "1.0.0"}
+	_, err = mcpClient.Initialize(ctx, initRequest)
+	require.NoError(t, err)
+
+	createRequest := mcp.CallToolRequest{}
+	createRequest.Params.Name = "execute"
+	createRequest.Params.Arguments = map[string]any{
+		"tool": "create_port_forward",
+		"arguments": map[string]any{
+			"name":            "ssh",
+			"idempotency_key": "retry-me",
+			"resolve":         false,
+		},
+	}
+
+	first, err := mcpClient.CallTool(ctx, createRequest)
+	require.NoError(t, err)
+	require.False(t, first.IsError)
+
+	// The agent retries after a timeout; the port forward is not created twice.
+	second, err := mcpClient.CallTool(ctx, createRequest)
+	require.NoError(t, err)
+	require.False(t, second.IsError)
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal([]byte(second.Content[0].(mcp.TextContent).Text), &envelope))
+	assert.Equal(t, true, envelope["idempotent_replay"])
+	assert.Equal(t, "pf1", envelope["data"].(map[string]any)["_id"])
+
+	client.AssertExpectations(t)
+}
+
+func TestUpsertEndToEnd(t *testing.T) {
+	ctx := context.Background()
+	client := servermocks.NewClient(t)
+	existing := []unifi.DNSRecord{{ID: "rec1", Key: "nas.lan", Value: "10.0.0.4", RecordType: "A"}}
+	client.On("ListDNSRecord", mock.Anything, "default").Return(existing, nil).Once()
+	client.On("GetDNSRecord", mock.Anything, "default", "rec1").Return(&existing[0], nil).Once()
+	client.On("UpdateDNSRecord", mock.Anything, "default", mock.MatchedBy(func(d *unifi.DNSRecord) bool {
+		return d.ID == "rec1" && d.Value == "10.0.0.5"
+	})).Return(&unifi.DNSRecord{ID: "rec1", Key: "nas.lan", Value: "10.0.0.5", RecordType: "A"}, nil).Once()
+
+	s, err := New(Options{Client: client, Mode: ModeEager})
+	require.NoError(t, err)
+
+	mcpClient, err := clientpkg.NewInProcessClient(s)
+	require.NoError(t, err)
+	defer func() {
+		err = mcpClient.Close()
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, mcpClient.Start(ctx))
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "integration-test", Version: "1.0.0"}
+	_, err = mcpClient.Initialize(ctx, initRequest)
+	require.NoError(t, err)
+
+	upsertRequest := mcp.CallToolRequest{}
+	upsertRequest.Params.Name = "upsert_dns_record"
+	upsertRequest.Params.Arguments = map[string]any{
+		"key":      "nas.lan",
+		"value":    "10.0.0.5",
+		"match_on": []any{"key"},
+		"resolve":  false,
+	}
+
+	result, err := mcpClient.CallTool(ctx, upsertRequest)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &envelope))
+	assert.Equal(t, "updated", envelope["action"])
+	assert.Equal(t, "10.0.0.5", envelope["data"].(map[string]any)["value"])
+
+	client.AssertExpectations(t)
+}
+
+func TestSelectByNameEndToEnd(t *testing.T) {
+	ctx := context.Background()
+	client := servermocks.NewClient(t)
+	devices := []unifi.Device{
+		{ID: "dev1", Name: "Office Switch", MAC: "aa:bb:cc:dd:ee:01"},
+		{ID: "dev2", Name: "Lobby AP", MAC: "aa:bb:cc:dd:ee:02"},
+	}
+	client.On("ListDevice", mock.Anything, "default").Return(devices, nil).Twice()
+	client.On("GetDevice", mock.Anything, "default", "dev2").Return(&devices[1], nil).Twice()
+
+	s, err := New(Options{Client: client, Mode: ModeEager})
+	require.NoError(t, err)
+
+	mcpClient, err := clientpkg.NewInProcessClient(s)
+	require.NoError(t, err)
+	defer func() {
+		err = mcpClient.Close()
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, mcpClient.Start(ctx))
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "integration-test", Version: "1.0.0"}
+	_, err = mcpClient.Initialize(ctx, initRequest)
+	require.NoError(t, err)
+
+	for _, args := range []map[string]any{
+		{"name": "lobby ap"},
+		{"mac": "AA-BB-CC-DD-EE-02"},
+	} {
+		getRequest := mcp.CallToolRequest{}
+		getRequest.Params.Name = "get_device"
+		getRequest.Params.Arguments = args
+
+		result, err := mcpClient.CallTool(ctx, getRequest)
+		require.NoError(t, err)
+		require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"_id": "dev2"`)
+	}
+
+	client.AssertExpectations(t)
+}
+
+func TestNameReferencesEndToEnd(t *testing.T) {
+	ctx := context.Background()
+	client := servermocks.NewClient(t)
+	client.On("ListUserGroup", mock.Anything, "default").
+		Return([]unifi.UserGroup{{ID: "ug1", Name: "Default"}, {ID: "ug2", Name: "Limited"}}, nil).Once()
+	client.On("CreateUser", mock.Anything, "default", mock.MatchedBy(func(u *unifi.User) bool {
+		return u.MAC == "aa:bb:cc:dd:ee:01" && u.UserGroupID == "ug2"
+	})).Return(&unifi.User{ID: "u1", MAC: "aa:bb:cc:dd:ee:01", UserGroupID: "ug2"}, nil).Once()
+
+	s, err := New(Options{Client: client, Mode: ModeEager})
+	require.NoError(t, err)
+
+	mcpClient, err := clientpkg.NewInProcessClient(s)
+	require.NoError(t, err)
+	defer func() {
+		err = mcpClient.Close()
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, mcpClient.Start(ctx))
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "integration-test", Version: "1.0.0"}
+	_, err = mcpClient.Initialize(ctx, initRequest)
+	require.NoError(t, err)
+
+	createRequest := mcp.CallToolRequest{}
+	createRequest.Params.Name = "create_user"
+	createRequest.Params.Arguments = map[string]any{
+		"mac":            "aa:bb:cc:dd:ee:01",
+		"usergroup_name": "Limited",
+	}
+
+	result, err := mcpClient.CallTool(ctx, createRequest)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	text := result.Content[0].(mcp.TextContent).Text
+	assert.Contains(t, text, `"usergroup_id": "ug2"`)
+	assert.Contains(t, text, `"usergroup_name": "Limited"`)
+
+	client.AssertExpectations(t)
+}
@@ -1,12 +1,21 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/claytono/go-unifi-mcp/internal/config"
+	"github.com/claytono/go-unifi-mcp/internal/humanize"
+	"github.com/claytono/go-unifi-mcp/internal/idempotency"
 	"github.com/claytono/go-unifi-mcp/internal/meta"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/claytono/go-unifi-mcp/internal/ratelimit"
 	"github.com/claytono/go-unifi-mcp/internal/resolve"
+	"github.com/claytono/go-unifi-mcp/internal/results"
+	"github.com/claytono/go-unifi-mcp/internal/retry"
+	"github.com/claytono/go-unifi-mcp/internal/stale"
 	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
 	"github.com/claytono/go-unifi-mcp/internal/tools/registry"
 	"github.com/filipowm/go-unifi/unifi"
@@ -24,9 +33,10 @@ const ServerName = "go-unifi-mcp"
 type Mode string
 
 const (
-	// ModeLazy registers only 3 meta-tools (~200 tokens context).
+	// ModeLazy registers only 3 meta-tools (~200 tokens context), plus
+	// query_result when stored results are enabled.
 	ModeLazy Mode = "lazy"
-	// ModeEager registers all 242 direct tools (~55K tokens context).
+	// ModeEager registers all 274 direct tools (~70K tokens context).
 	ModeEager Mode = "eager"
 )
 
@@ -35,11 +45,58 @@ type Options struct {
 	Client   unifi.Client
 	Mode     Mode   // defaults to ModeLazy if empty
 	LogLevel string // log level string for resolve debug logging
+
+	// StaleMaxAge enables serving last-known-good list/get results for up to
+	// this long when the controller is unreachable. Zero disables it.
+	StaleMaxAge time.Duration
+
+	// MaxRetries is how many times transient read failures are retried.
+	MaxRetries int
+	// Relogin renews the session via Login when the controller answers 401.
+	// Only useful for username/password auth.
+	Relogin bool
+
+	// RateLimit caps controller requests per second, with bursts of up to
+	// RateBurst requests. MaxConcurrency caps requests in flight. Zero
+	// disables the respective limit.
+	RateLimit      float64
+	RateBurst      int
+	MaxConcurrency int
+
+	// IdempotencyWindow is how long create results are remembered by
+	// idempotency key. Zero disables idempotency keys.
+	IdempotencyWindow time.Duration
+
+	// ResolveRules is the path of a YAML file extending the ID resolution
+	// rules. Empty uses the built-in rules only.
+	ResolveRules string
+
+	// OutputFormat is the default output format of list and get tools, one
+	// of payload.Formats. Empty is JSON.
+	OutputFormat string
+
+	// MaxTokens is the default approximate token budget of list results.
+	// Zero disables truncation.
+	MaxTokens int
+
+	// StoredResults is how many list results stored with store_result are
+	// kept per session, up to StoredResultsMaxBytes of JSON. Zero disables
+	// stored results and the query_result tool; a zero size uses
+	// config.DefaultStoredResultsMaxMB.
+	StoredResults         int
+	StoredResultsMaxBytes int
+
+	// Humanize adds readable siblings of timestamps, durations, sizes and
+	// state codes to results of calls without a humanize argument.
+	// TimeZone is the IANA name of the zone humanized timestamps are
+	// rendered in. Empty is UTC.
+	Humanize bool
+	TimeZone string
 }
 
 // New creates a new MCP server with UniFi tools registered.
 // In lazy mode (default), only 3 meta-tools are registered for reduced context.
-// In eager mode, all 242 direct tools are registered.
+// In eager mode, all 274 direct tools are registered.
 func New(opts Options) (*server.MCPServer, error) {
 	if opts.Client == nil {
 		return nil, fmt.Errorf("client is required")
@@ -54,25 +111,104 @@ func New(opts Options) (*server.MCPServer, error) {
 		mode = ModeLazy
 	}
 
+	logger := resolve.NewLogger(opts.LogLevel)
+
+	// Route controller calls through client interceptors. Retries run inside
+	// the stale fallback, and every attempt passes through the rate limiter.
+	client := opts.Client
+	var interceptors []generated.Interceptor
+	if opts.StaleMaxAge > 0 {
+		interceptors = append(interceptors, stale.NewStore(opts.StaleMaxAge).Interceptor())
+	}
+	if opts.MaxRetries > 0 || opts.Relogin {
+		policy := retry.DefaultPolicy
+		policy.MaxRetries = opts.MaxRetries
+		var login func() error
+		if opts.Relogin {
+			login = opts.Client.Login
+		}
+		interceptors = append(interceptors, retry.New(policy, login).Interceptor())
+	}
+	if opts.RateLimit > 0 || opts.MaxConcurrency > 0 {
+		limiter := ratelimit.New(ratelimit.Options{
+			Rate:           opts.RateLimit,
+			Burst:          opts.RateBurst,
+			MaxConcurrency: opts.MaxConcurrency,
+		}, logger)
+		interceptors = append(interceptors, limiter.Interceptor())
+	}
+	if len(interceptors) > 0 {
+		client = generated.NewInterceptedClient(client, interceptors...)
+	}
+
 	// Build resolver for ID reference resolution
 	resourceIndex := resolve.BuildResourceIndex(generated.AllToolMetadata)
-	logger := resolve.NewLogger(opts.LogLevel)
-	resolver := resolve.New(opts.Client, resourceIndex, logger)
+	resolver := resolve.New(client, resourceIndex, logger)
+	if opts.ResolveRules != "" {
+		rules, err := resolve.LoadRules(opts.ResolveRules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load resolve rules: %w", err)
+		}
+		if err := resolver.Extend(rules); err != nil {
+			return nil, fmt.Errorf("invalid resolve rules in %s: %w", opts.ResolveRules, err)
+		}
+	}
+	format, err := payload.ParseFormat(opts.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(opts.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone: %w", err)
+	}
+	mw := &registry.Middleware{
+		Resolver:  resolver,
+		Format:    format,
+		MaxTokens: opts.MaxTokens,
+		Humanizer: humanize.New(loc, opts.Humanize),
+	}
+	if opts.IdempotencyWindow > 0 {
+		mw.Idempotency = idempotency.NewStore(opts.IdempotencyWindow)
+	}
+
+	// Stored results are dropped with the session they belong to.
+	hooks := &server.Hooks{}
+	if opts.StoredResults > 0 {
+		maxBytes := opts.StoredResultsMaxBytes
+		if maxBytes <= 0 {
+			maxBytes = config.DefaultStoredResultsMaxMB << 20
+		}
+		mw.Results = results.NewStore(opts.StoredResults, maxBytes)
+		hooks.AddOnUnregisterSession(func(_ context.Context, session server.ClientSession) {
+			mw.Results.DropSession(session.SessionID())
+		})
+	}
 
 	s := server.NewMCPServer(
 		ServerName,
 		Version,
 		server.WithToolCapabilities(true),
+		server.WithHooks(hooks),
 	)
 
 	if mode == ModeEager {
 		// Register all direct tools from metadata
-		if err := registry.RegisterAllTools(s, opts.Client, resolver); err != nil {
+		if err := registry.RegisterAllTools(s, client, mw); err != nil {
 			return nil, fmt.Errorf("failed to register tools: %w", err)
 		}
 	} else {
 		// Register 3 meta-tools for lazy mode
-		meta.RegisterMetaTools(s, opts.Client, resolver)
+		meta.RegisterMetaTools(s, client, mw)
+	}
+
+	// Stored results are queried directly in both modes.
+	if mw.Results != nil {
+		s.AddTool(results.QueryTool(), mw.Results.QueryHandler(format, opts.MaxTokens))
+	}
+
+	// Resolution debugging aid, only offered when debug logging is on.
+	if opts.LogLevel == "debug" || opts.LogLevel == "trace" {
+		s.AddTool(resolve.ExplainTool(), resolve.ExplainHandler(resolver))
 	}
 
 	return s, nil
@@ -2,6 +2,7 @@ package server
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/claytono/go-unifi-mcp/internal/config"
@@ -27,6 +28,68 @@ func TestNew_CreatesServer(t *testing.T) {
 	assert.Len(t, s.ListTools(), 3)
 }
 
+func TestNew_ResolveExplainInDebug(t *testing.T) {
+	client := servermocks.NewClient(t)
+
+	s, err := New(Options{Client: client, Mode: ModeLazy, LogLevel: "debug"})
+	require.NoError(t, err)
+	assert.Len(t, s.ListTools(), 4)
+	assert.NotNil(t, s.GetTool("resolve_explain"))
+}
+
+func TestNew_StoredResults(t *testing.T) {
+	client := servermocks.NewClient(t)
+
+	s, err := New(Options{Client: client, Mode: ModeLazy, StoredResults: 5})
+	require.NoError(t, err)
+	assert.Len(t, s.ListTools(), 4)
+	assert.NotNil(t, s.GetTool("query_result"))
+
+	s, err = New(Options{Client: client, Mode: ModeEager, StoredResults: 5})
+	require.NoError(t, err)
+	assert.NotNil(t, s.GetTool("query_result"))
+}
+
+func TestNew_ResolveRules(t *testing.T) {
+	client := servermocks.NewClient(t)
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.yaml")
+	require.NoError(t, os.WriteFile(valid, []byte("overrides:\n  guest_networkconf_id: network\n"), 0o600))
+	_, err := New(Options{Client: client, ResolveRules: valid})
+	require.NoError(t, err)
+
+	invalid := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalid, []byte("overrides:\n  foo_id: NoSuchResource\n"), 0o600))
+	_, err = New(Options{Client: client, ResolveRules: invalid})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown resource "NoSuchResource"`)
+
+	_, err = New(Options{Client: client, ResolveRules: filepath.Join(dir, "missing.yaml")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load resolve rules")
+}
+
+func TestNew_TimeZone(t *testing.T) {
+	client := servermocks.NewClient(t)
+
+	_, err := New(Options{Client: client, Humanize: true, TimeZone: "Europe/Berlin"})
+	require.NoError(t, err)
+
+	_, err = New(Options{Client: client, TimeZone: "Mars/Olympus_Mons"})
+	assert.ErrorContains(t, err, "invalid time zone")
+}
+
+func TestNew_OutputFormat(t *testing.T) {
+	client := servermocks.NewClient(t)
+
+	_, err := New(Options{Client: client, OutputFormat: "yaml"})
+	require.NoError(t, err)
+
+	_, err = New(Options{Client: client, OutputFormat: "xml"})
+	assert.ErrorContains(t, err, `invalid format "xml"`)
+}
+
 func TestNewClient_APIKey(t *testing.T) {
 	cfg := &config.Config{
 		Host:      "https://192.168.1.1",
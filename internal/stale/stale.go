@@ -0,0 +1,162 @@
+// Package stale serves last-known-good list/get results while the UniFi
+// controller is unreachable. Successful read calls are snapshotted by a client
+// interceptor; when a later read fails with a connectivity error, the snapshot
+// is returned instead and the tool result is marked with "stale": true, the
+// snapshot time and the original error. Mutations never use this path.
+package stale
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Annotation keys recorded on stale responses.
+const (
+	KeyStale        = "stale"
+	KeySnapshotTime = "snapshot_time"
+	KeyError        = "controller_error"
+)
+
+// snapshot is a serialized copy of a successful read result.
+type snapshot struct {
+	data []byte
+	at   time.Time
+}
+
+// Store holds the last successful result of every list/get call.
+type Store struct {
+	mu      sync.RWMutex
+	maxAge  time.Duration
+	entries map[string]snapshot
+	now     func() time.Time
+}
+
+// NewStore creates a Store that serves snapshots up to maxAge old.
+func NewStore(maxAge time.Duration) *Store {
+	return &Store{
+		maxAge:  maxAge,
+		entries: make(map[string]snapshot),
+		now:     time.Now,
+	}
+}
+
+type allowKey struct{}
+
+// WrapHandler marks calls made by a read-only tool handler as eligible for
+// stale fallback. Only list and get tools should be wrapped.
+func WrapHandler(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handler(context.WithValue(ctx, allowKey{}, true), req)
+	}
+}
+
+func allowed(ctx context.Context) bool {
+	allow, _ := ctx.Value(allowKey{}).(bool)
+	return allow
+}
+
+// Interceptor returns a client interceptor that records successful list/get
+// results and substitutes them when the controller is unreachable.
+func (s *Store) Interceptor() generated.Interceptor {
+	return func(ctx context.Context, call generated.Call, next func(context.Context) error) error {
+		if call.Category != "list" && call.Category != "get" {
+			return next(ctx)
+		}
+
+		key := call.Site + "/" + call.Method + "/" + call.ID
+		err := next(ctx)
+		if err == nil {
+			s.save(key, call.Result)
+			return nil
+		}
+
+		if !allowed(ctx) || !IsConnectivityError(err) {
+			return err
+		}
+		snap, ok := s.load(key)
+		if !ok {
+			return err
+		}
+		if jsonErr := json.Unmarshal(snap.data, call.Result); jsonErr != nil {
+			return err
+		}
+		markStale(annotate.FromContext(ctx), snap.at, err)
+		return nil
+	}
+}
+
+func (s *Store) save(key string, result any) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = snapshot{data: data, at: s.now()}
+}
+
+func (s *Store) load(key string) (snapshot, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snap, ok := s.entries[key]
+	if !ok || s.now().Sub(snap.at) > s.maxAge {
+		return snapshot{}, false
+	}
+	return snap, true
+}
+
+// markStale records staleness on the call's annotations. When several
+// snapshots are served during one tool call, the oldest snapshot time wins.
+func markStale(a *annotate.Annotations, at time.Time, err error) {
+	if prev, ok := a.Get(KeySnapshotTime); ok {
+		if prevAt, ok := prev.(time.Time); ok && prevAt.Before(at) {
+			return
+		}
+	}
+	a.Set(KeyStale, true)
+	a.Set(KeySnapshotTime, at.UTC())
+	a.Set(KeyError, err.Error())
+}
+
+// IsConnectivityError reports whether err indicates that the controller could
+// not be reached, as opposed to the controller rejecting the request.
+func IsConnectivityError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	// UniFi OS proxies answer with gateway errors while the Network app restarts.
+	var serverErr *unifi.ServerError
+	if errors.As(err, &serverErr) {
+		switch serverErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,248 @@
+package stale
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnreachable = &url.Error{Op: "Get", URL: "https://unifi/api", Err: syscall.ECONNREFUSED}
+
+// flakyClient serves networks until err is set, then fails every call.
+type flakyClient struct {
+	unifi.Client
+	networks []unifi.Network
+	err      error
+	updates  int
+}
+
+func (c *flakyClient) ListNetwork(_ context.Context, _ string) ([]unifi.Network, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.networks, nil
+}
+
+func (c *flakyClient) GetNetwork(_ context.Context, _, id string) (*unifi.Network, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	for _, n := range c.networks {
+		if n.ID == id {
+			return &n, nil
+		}
+	}
+	return nil, unifi.ErrNotFound
+}
+
+func (c *flakyClient) UpdateNetwork(_ context.Context, _ string, d *unifi.Network) (*unifi.Network, error) {
+	c.updates++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return d, nil
+}
+
+func newTestClient(maxAge time.Duration) (*flakyClient, *generated.InterceptedClient, *Store) {
+	base := &flakyClient{networks: []unifi.Network{{ID: "net1", Name: "IoT", VLAN: 30}}}
+	store := NewStore(maxAge)
+	return base, generated.NewInterceptedClient(base, store.Interceptor()), store
+}
+
+// readContext returns a context as seen by a wrapped read tool.
+func readContext(t *testing.T) (context.Context, *annotate.Annotations) {
+	t.Helper()
+	return annotate.NewContext(context.WithValue(context.Background(), allowKey{}, true))
+}
+
+func TestInterceptor_ServesSnapshotWhenUnreachable(t *testing.T) {
+	base, client, _ := newTestClient(time.Hour)
+	ctx, annotations := readContext(t)
+
+	networks, err := client.ListNetwork(ctx, "default")
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Equal(t, 0, annotations.Len())
+
+	base.err = errUnreachable
+	networks, err = client.ListNetwork(ctx, "default")
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Equal(t, "IoT", networks[0].Name)
+	assert.Equal(t, 30, networks[0].VLAN)
+
+	staleVal, ok := annotations.Get(KeyStale)
+	require.True(t, ok)
+	assert.Equal(t, true, staleVal)
+	_, ok = annotations.Get(KeySnapshotTime)
+	assert.True(t, ok)
+	errVal, _ := annotations.Get(KeyError)
+	assert.Contains(t, errVal, "connection refused")
+}
+
+func TestInterceptor_GetSnapshotKeyedByID(t *testing.T) {
+	base, client, _ := newTestClient(time.Hour)
+	ctx, _ := readContext(t)
+
+	_, err := client.GetNetwork(ctx, "default", "net1")
+	require.NoError(t, err)
+
+	base.err = errUnreachable
+	network, err := client.GetNetwork(ctx, "default", "net1")
+	require.NoError(t, err)
+	assert.Equal(t, "IoT", network.Name)
+
+	// A different ID has no snapshot.
+	_, err = client.GetNetwork(ctx, "default", "net2")
+	assert.ErrorIs(t, err, syscall.ECONNREFUSED)
+
+	// Snapshots are per site.
+	_, err = client.GetNetwork(ctx, "other", "net1")
+	assert.Error(t, err)
+}
+
+func TestInterceptor_NoSnapshot(t *testing.T) {
+	base, client, _ := newTestClient(time.Hour)
+	base.err = errUnreachable
+	ctx, annotations := readContext(t)
+
+	_, err := client.ListNetwork(ctx, "default")
+	assert.ErrorIs(t, err, syscall.ECONNREFUSED)
+	assert.Equal(t, 0, annotations.Len())
+}
+
+func TestInterceptor_NonConnectivityErrorNotMasked(t *testing.T) {
+	base, client, _ := newTestClient(time.Hour)
+	ctx, annotations := readContext(t)
+
+	_, err := client.ListNetwork(ctx, "default")
+	require.NoError(t, err)
+
+	base.err = &unifi.ServerError{StatusCode: 400, Message: "api.err.Invalid"}
+	_, err = client.ListNetwork(ctx, "default")
+	assert.Error(t, err)
+	assert.Equal(t, 0, annotations.Len())
+}
+
+func TestInterceptor_ExpiredSnapshot(t *testing.T) {
+	base, client, store := newTestClient(time.Minute)
+	ctx, _ := readContext(t)
+
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	_, err := client.ListNetwork(ctx, "default")
+	require.NoError(t, err)
+
+	store.now = func() time.Time { return now.Add(2 * time.Minute) }
+	base.err = errUnreachable
+	_, err = client.ListNetwork(ctx, "default")
+	assert.Error(t, err)
+}
+
+func TestInterceptor_RequiresReadTool(t *testing.T) {
+	base, client, _ := newTestClient(time.Hour)
+
+	// Snapshots are still recorded outside read tools...
+	_, err := client.GetNetwork(context.Background(), "default", "net1")
+	require.NoError(t, err)
+
+	// ...but never served to mutation tools, such as the read-modify-write
+	// fetch performed by update handlers.
+	base.err = errUnreachable
+	ctx, annotations := annotate.NewContext(context.Background())
+	_, err = client.GetNetwork(ctx, "default", "net1")
+	assert.Error(t, err)
+	assert.Equal(t, 0, annotations.Len())
+}
+
+func TestInterceptor_MutationsPassThrough(t *testing.T) {
+	base, client, _ := newTestClient(time.Hour)
+	ctx, annotations := readContext(t)
+
+	base.err = errUnreachable
+	_, err := client.UpdateNetwork(ctx, "default", &unifi.Network{ID: "net1"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, base.updates)
+	assert.Equal(t, 0, annotations.Len())
+}
+
+func TestMarkStale_OldestSnapshotWins(t *testing.T) {
+	_, annotations := annotate.NewContext(context.Background())
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	markStale(annotations, newer, errors.New("first"))
+	markStale(annotations, older, errors.New("second"))
+	markStale(annotations, newer, errors.New("third"))
+
+	at, _ := annotations.Get(KeySnapshotTime)
+	assert.Equal(t, older, at)
+	errVal, _ := annotations.Get(KeyError)
+	assert.Equal(t, "second", errVal)
+}
+
+func TestWrapHandler_EndToEnd(t *testing.T) {
+	base, client, _ := newTestClient(time.Hour)
+	handler := annotate.WrapHandler(WrapHandler(generated.GenericList(client, "Network")))
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{}
+
+	_, err := handler(context.Background(), req)
+	require.NoError(t, err)
+
+	base.err = errUnreachable
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &envelope))
+	assert.Equal(t, true, envelope[KeyStale])
+	assert.NotEmpty(t, envelope[KeySnapshotTime])
+	assert.Contains(t, envelope[KeyError], "connection refused")
+	data, ok := envelope[annotate.DataKey].([]any)
+	require.True(t, ok)
+	assert.Len(t, data, 1)
+}
+
+func TestIsConnectivityError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"url error", errUnreachable, true},
+		{"wrapped url error", fmt.Errorf("unable to perform request: %w", errUnreachable), true},
+		{"deadline exceeded", context.DeadlineExceeded, true},
+		{"canceled", context.Canceled, false},
+		{"canceled inside url error", &url.Error{Op: "Get", URL: "x", Err: context.Canceled}, false},
+		{"unexpected EOF", io.ErrUnexpectedEOF, true},
+		{"bad gateway", &unifi.ServerError{StatusCode: 502}, true},
+		{"service unavailable", &unifi.ServerError{StatusCode: 503}, true},
+		{"gateway timeout", &unifi.ServerError{StatusCode: 504}, true},
+		{"bad request", &unifi.ServerError{StatusCode: 400}, false},
+		{"not found", unifi.ErrNotFound, false},
+		{"plain error", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsConnectivityError(tt.err))
+		})
+	}
+}
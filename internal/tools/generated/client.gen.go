@@ -0,0 +1,2788 @@
+// Code generated by mcpgen. DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/filipowm/go-unifi/unifi"
+)
+
+// Compile-time check that InterceptedClient still satisfies unifi.Client.
+var _ unifi.Client = (*InterceptedClient)(nil)
+
+// ListAPGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListAPGroup(ctx context.Context, site string) ([]unifi.APGroup, error) {
+	var result []unifi.APGroup
+	call := Call{Method: "ListAPGroup", Resource: "APGroup", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListAPGroup(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetAPGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetAPGroup(ctx context.Context, site string, id string) (*unifi.APGroup, error) {
+	var result *unifi.APGroup
+	call := Call{Method: "GetAPGroup", Resource: "APGroup", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetAPGroup(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateAPGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateAPGroup(ctx context.Context, site string, d *unifi.APGroup) (*unifi.APGroup, error) {
+	var result *unifi.APGroup
+	call := Call{Method: "CreateAPGroup", Resource: "APGroup", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateAPGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateAPGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateAPGroup(ctx context.Context, site string, d *unifi.APGroup) (*unifi.APGroup, error) {
+	var result *unifi.APGroup
+	call := Call{Method: "UpdateAPGroup", Resource: "APGroup", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateAPGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteAPGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteAPGroup(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteAPGroup", Resource: "APGroup", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteAPGroup(ctx, site, id)
+	})
+}
+
+// ListAccount calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListAccount(ctx context.Context, site string) ([]unifi.Account, error) {
+	var result []unifi.Account
+	call := Call{Method: "ListAccount", Resource: "Account", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListAccount(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetAccount calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetAccount(ctx context.Context, site string, id string) (*unifi.Account, error) {
+	var result *unifi.Account
+	call := Call{Method: "GetAccount", Resource: "Account", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetAccount(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateAccount calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateAccount(ctx context.Context, site string, d *unifi.Account) (*unifi.Account, error) {
+	var result *unifi.Account
+	call := Call{Method: "CreateAccount", Resource: "Account", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateAccount(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateAccount calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateAccount(ctx context.Context, site string, d *unifi.Account) (*unifi.Account, error) {
+	var result *unifi.Account
+	call := Call{Method: "UpdateAccount", Resource: "Account", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateAccount(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteAccount calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteAccount(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteAccount", Resource: "Account", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteAccount(ctx, site, id)
+	})
+}
+
+// ListBroadcastGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListBroadcastGroup(ctx context.Context, site string) ([]unifi.BroadcastGroup, error) {
+	var result []unifi.BroadcastGroup
+	call := Call{Method: "ListBroadcastGroup", Resource: "BroadcastGroup", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListBroadcastGroup(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetBroadcastGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetBroadcastGroup(ctx context.Context, site string, id string) (*unifi.BroadcastGroup, error) {
+	var result *unifi.BroadcastGroup
+	call := Call{Method: "GetBroadcastGroup", Resource: "BroadcastGroup", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetBroadcastGroup(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateBroadcastGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateBroadcastGroup(ctx context.Context, site string, d *unifi.BroadcastGroup) (*unifi.BroadcastGroup, error) {
+	var result *unifi.BroadcastGroup
+	call := Call{Method: "CreateBroadcastGroup", Resource: "BroadcastGroup", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateBroadcastGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateBroadcastGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateBroadcastGroup(ctx context.Context, site string, d *unifi.BroadcastGroup) (*unifi.BroadcastGroup, error) {
+	var result *unifi.BroadcastGroup
+	call := Call{Method: "UpdateBroadcastGroup", Resource: "BroadcastGroup", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateBroadcastGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteBroadcastGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteBroadcastGroup(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteBroadcastGroup", Resource: "BroadcastGroup", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteBroadcastGroup(ctx, site, id)
+	})
+}
+
+// ListChannelPlan calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListChannelPlan(ctx context.Context, site string) ([]unifi.ChannelPlan, error) {
+	var result []unifi.ChannelPlan
+	call := Call{Method: "ListChannelPlan", Resource: "ChannelPlan", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListChannelPlan(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetChannelPlan calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetChannelPlan(ctx context.Context, site string, id string) (*unifi.ChannelPlan, error) {
+	var result *unifi.ChannelPlan
+	call := Call{Method: "GetChannelPlan", Resource: "ChannelPlan", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetChannelPlan(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateChannelPlan calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateChannelPlan(ctx context.Context, site string, d *unifi.ChannelPlan) (*unifi.ChannelPlan, error) {
+	var result *unifi.ChannelPlan
+	call := Call{Method: "CreateChannelPlan", Resource: "ChannelPlan", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateChannelPlan(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateChannelPlan calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateChannelPlan(ctx context.Context, site string, d *unifi.ChannelPlan) (*unifi.ChannelPlan, error) {
+	var result *unifi.ChannelPlan
+	call := Call{Method: "UpdateChannelPlan", Resource: "ChannelPlan", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateChannelPlan(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteChannelPlan calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteChannelPlan(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteChannelPlan", Resource: "ChannelPlan", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteChannelPlan(ctx, site, id)
+	})
+}
+
+// ListDHCPOption calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListDHCPOption(ctx context.Context, site string) ([]unifi.DHCPOption, error) {
+	var result []unifi.DHCPOption
+	call := Call{Method: "ListDHCPOption", Resource: "DHCPOption", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListDHCPOption(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetDHCPOption calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetDHCPOption(ctx context.Context, site string, id string) (*unifi.DHCPOption, error) {
+	var result *unifi.DHCPOption
+	call := Call{Method: "GetDHCPOption", Resource: "DHCPOption", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetDHCPOption(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateDHCPOption calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateDHCPOption(ctx context.Context, site string, d *unifi.DHCPOption) (*unifi.DHCPOption, error) {
+	var result *unifi.DHCPOption
+	call := Call{Method: "CreateDHCPOption", Resource: "DHCPOption", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateDHCPOption(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateDHCPOption calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateDHCPOption(ctx context.Context, site string, d *unifi.DHCPOption) (*unifi.DHCPOption, error) {
+	var result *unifi.DHCPOption
+	call := Call{Method: "UpdateDHCPOption", Resource: "DHCPOption", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateDHCPOption(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteDHCPOption calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteDHCPOption(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteDHCPOption", Resource: "DHCPOption", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteDHCPOption(ctx, site, id)
+	})
+}
+
+// ListDNSRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListDNSRecord(ctx context.Context, site string) ([]unifi.DNSRecord, error) {
+	var result []unifi.DNSRecord
+	call := Call{Method: "ListDNSRecord", Resource: "DNSRecord", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListDNSRecord(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetDNSRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetDNSRecord(ctx context.Context, site string, id string) (*unifi.DNSRecord, error) {
+	var result *unifi.DNSRecord
+	call := Call{Method: "GetDNSRecord", Resource: "DNSRecord", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetDNSRecord(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateDNSRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateDNSRecord(ctx context.Context, site string, d *unifi.DNSRecord) (*unifi.DNSRecord, error) {
+	var result *unifi.DNSRecord
+	call := Call{Method: "CreateDNSRecord", Resource: "DNSRecord", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateDNSRecord(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateDNSRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateDNSRecord(ctx context.Context, site string, d *unifi.DNSRecord) (*unifi.DNSRecord, error) {
+	var result *unifi.DNSRecord
+	call := Call{Method: "UpdateDNSRecord", Resource: "DNSRecord", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateDNSRecord(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteDNSRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteDNSRecord(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteDNSRecord", Resource: "DNSRecord", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteDNSRecord(ctx, site, id)
+	})
+}
+
+// ListDashboard calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListDashboard(ctx context.Context, site string) ([]unifi.Dashboard, error) {
+	var result []unifi.Dashboard
+	call := Call{Method: "ListDashboard", Resource: "Dashboard", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListDashboard(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetDashboard calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetDashboard(ctx context.Context, site string, id string) (*unifi.Dashboard, error) {
+	var result *unifi.Dashboard
+	call := Call{Method: "GetDashboard", Resource: "Dashboard", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetDashboard(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateDashboard calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateDashboard(ctx context.Context, site string, d *unifi.Dashboard) (*unifi.Dashboard, error) {
+	var result *unifi.Dashboard
+	call := Call{Method: "CreateDashboard", Resource: "Dashboard", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateDashboard(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateDashboard calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateDashboard(ctx context.Context, site string, d *unifi.Dashboard) (*unifi.Dashboard, error) {
+	var result *unifi.Dashboard
+	call := Call{Method: "UpdateDashboard", Resource: "Dashboard", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateDashboard(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteDashboard calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteDashboard(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteDashboard", Resource: "Dashboard", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteDashboard(ctx, site, id)
+	})
+}
+
+// ListDevice calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListDevice(ctx context.Context, site string) ([]unifi.Device, error) {
+	var result []unifi.Device
+	call := Call{Method: "ListDevice", Resource: "Device", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListDevice(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetDevice calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetDevice(ctx context.Context, site string, id string) (*unifi.Device, error) {
+	var result *unifi.Device
+	call := Call{Method: "GetDevice", Resource: "Device", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetDevice(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// ListDynamicDNS calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListDynamicDNS(ctx context.Context, site string) ([]unifi.DynamicDNS, error) {
+	var result []unifi.DynamicDNS
+	call := Call{Method: "ListDynamicDNS", Resource: "DynamicDNS", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListDynamicDNS(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetDynamicDNS calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetDynamicDNS(ctx context.Context, site string, id string) (*unifi.DynamicDNS, error) {
+	var result *unifi.DynamicDNS
+	call := Call{Method: "GetDynamicDNS", Resource: "DynamicDNS", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetDynamicDNS(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateDynamicDNS calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateDynamicDNS(ctx context.Context, site string, d *unifi.DynamicDNS) (*unifi.DynamicDNS, error) {
+	var result *unifi.DynamicDNS
+	call := Call{Method: "CreateDynamicDNS", Resource: "DynamicDNS", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateDynamicDNS(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateDynamicDNS calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateDynamicDNS(ctx context.Context, site string, d *unifi.DynamicDNS) (*unifi.DynamicDNS, error) {
+	var result *unifi.DynamicDNS
+	call := Call{Method: "UpdateDynamicDNS", Resource: "DynamicDNS", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateDynamicDNS(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteDynamicDNS calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteDynamicDNS(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteDynamicDNS", Resource: "DynamicDNS", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteDynamicDNS(ctx, site, id)
+	})
+}
+
+// ListFirewallGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListFirewallGroup(ctx context.Context, site string) ([]unifi.FirewallGroup, error) {
+	var result []unifi.FirewallGroup
+	call := Call{Method: "ListFirewallGroup", Resource: "FirewallGroup", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListFirewallGroup(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetFirewallGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetFirewallGroup(ctx context.Context, site string, id string) (*unifi.FirewallGroup, error) {
+	var result *unifi.FirewallGroup
+	call := Call{Method: "GetFirewallGroup", Resource: "FirewallGroup", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetFirewallGroup(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateFirewallGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateFirewallGroup(ctx context.Context, site string, d *unifi.FirewallGroup) (*unifi.FirewallGroup, error) {
+	var result *unifi.FirewallGroup
+	call := Call{Method: "CreateFirewallGroup", Resource: "FirewallGroup", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateFirewallGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateFirewallGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateFirewallGroup(ctx context.Context, site string, d *unifi.FirewallGroup) (*unifi.FirewallGroup, error) {
+	var result *unifi.FirewallGroup
+	call := Call{Method: "UpdateFirewallGroup", Resource: "FirewallGroup", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateFirewallGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteFirewallGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteFirewallGroup(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteFirewallGroup", Resource: "FirewallGroup", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteFirewallGroup(ctx, site, id)
+	})
+}
+
+// ListFirewallRule calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListFirewallRule(ctx context.Context, site string) ([]unifi.FirewallRule, error) {
+	var result []unifi.FirewallRule
+	call := Call{Method: "ListFirewallRule", Resource: "FirewallRule", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListFirewallRule(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetFirewallRule calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetFirewallRule(ctx context.Context, site string, id string) (*unifi.FirewallRule, error) {
+	var result *unifi.FirewallRule
+	call := Call{Method: "GetFirewallRule", Resource: "FirewallRule", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetFirewallRule(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateFirewallRule calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateFirewallRule(ctx context.Context, site string, d *unifi.FirewallRule) (*unifi.FirewallRule, error) {
+	var result *unifi.FirewallRule
+	call := Call{Method: "CreateFirewallRule", Resource: "FirewallRule", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateFirewallRule(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateFirewallRule calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateFirewallRule(ctx context.Context, site string, d *unifi.FirewallRule) (*unifi.FirewallRule, error) {
+	var result *unifi.FirewallRule
+	call := Call{Method: "UpdateFirewallRule", Resource: "FirewallRule", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateFirewallRule(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteFirewallRule calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteFirewallRule(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteFirewallRule", Resource: "FirewallRule", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteFirewallRule(ctx, site, id)
+	})
+}
+
+// ListFirewallZone calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListFirewallZone(ctx context.Context, site string) ([]unifi.FirewallZone, error) {
+	var result []unifi.FirewallZone
+	call := Call{Method: "ListFirewallZone", Resource: "FirewallZone", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListFirewallZone(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetFirewallZone calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetFirewallZone(ctx context.Context, site string, id string) (*unifi.FirewallZone, error) {
+	var result *unifi.FirewallZone
+	call := Call{Method: "GetFirewallZone", Resource: "FirewallZone", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetFirewallZone(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateFirewallZone calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateFirewallZone(ctx context.Context, site string, d *unifi.FirewallZone) (*unifi.FirewallZone, error) {
+	var result *unifi.FirewallZone
+	call := Call{Method: "CreateFirewallZone", Resource: "FirewallZone", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateFirewallZone(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateFirewallZone calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateFirewallZone(ctx context.Context, site string, d *unifi.FirewallZone) (*unifi.FirewallZone, error) {
+	var result *unifi.FirewallZone
+	call := Call{Method: "UpdateFirewallZone", Resource: "FirewallZone", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateFirewallZone(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteFirewallZone calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteFirewallZone(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteFirewallZone", Resource: "FirewallZone", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteFirewallZone(ctx, site, id)
+	})
+}
+
+// ListFirewallZonePolicy calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListFirewallZonePolicy(ctx context.Context, site string) ([]unifi.FirewallZonePolicy, error) {
+	var result []unifi.FirewallZonePolicy
+	call := Call{Method: "ListFirewallZonePolicy", Resource: "FirewallZonePolicy", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListFirewallZonePolicy(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetFirewallZonePolicy calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetFirewallZonePolicy(ctx context.Context, site string, id string) (*unifi.FirewallZonePolicy, error) {
+	var result *unifi.FirewallZonePolicy
+	call := Call{Method: "GetFirewallZonePolicy", Resource: "FirewallZonePolicy", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetFirewallZonePolicy(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateFirewallZonePolicy calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateFirewallZonePolicy(ctx context.Context, site string, d *unifi.FirewallZonePolicy) (*unifi.FirewallZonePolicy, error) {
+	var result *unifi.FirewallZonePolicy
+	call := Call{Method: "CreateFirewallZonePolicy", Resource: "FirewallZonePolicy", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateFirewallZonePolicy(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateFirewallZonePolicy calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateFirewallZonePolicy(ctx context.Context, site string, d *unifi.FirewallZonePolicy) (*unifi.FirewallZonePolicy, error) {
+	var result *unifi.FirewallZonePolicy
+	call := Call{Method: "UpdateFirewallZonePolicy", Resource: "FirewallZonePolicy", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateFirewallZonePolicy(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteFirewallZonePolicy calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteFirewallZonePolicy(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteFirewallZonePolicy", Resource: "FirewallZonePolicy", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteFirewallZonePolicy(ctx, site, id)
+	})
+}
+
+// ListHeatMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListHeatMap(ctx context.Context, site string) ([]unifi.HeatMap, error) {
+	var result []unifi.HeatMap
+	call := Call{Method: "ListHeatMap", Resource: "HeatMap", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListHeatMap(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetHeatMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetHeatMap(ctx context.Context, site string, id string) (*unifi.HeatMap, error) {
+	var result *unifi.HeatMap
+	call := Call{Method: "GetHeatMap", Resource: "HeatMap", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetHeatMap(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateHeatMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateHeatMap(ctx context.Context, site string, d *unifi.HeatMap) (*unifi.HeatMap, error) {
+	var result *unifi.HeatMap
+	call := Call{Method: "CreateHeatMap", Resource: "HeatMap", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateHeatMap(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateHeatMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateHeatMap(ctx context.Context, site string, d *unifi.HeatMap) (*unifi.HeatMap, error) {
+	var result *unifi.HeatMap
+	call := Call{Method: "UpdateHeatMap", Resource: "HeatMap", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateHeatMap(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteHeatMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteHeatMap(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteHeatMap", Resource: "HeatMap", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteHeatMap(ctx, site, id)
+	})
+}
+
+// ListHeatMapPoint calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListHeatMapPoint(ctx context.Context, site string) ([]unifi.HeatMapPoint, error) {
+	var result []unifi.HeatMapPoint
+	call := Call{Method: "ListHeatMapPoint", Resource: "HeatMapPoint", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListHeatMapPoint(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetHeatMapPoint calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetHeatMapPoint(ctx context.Context, site string, id string) (*unifi.HeatMapPoint, error) {
+	var result *unifi.HeatMapPoint
+	call := Call{Method: "GetHeatMapPoint", Resource: "HeatMapPoint", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetHeatMapPoint(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateHeatMapPoint calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateHeatMapPoint(ctx context.Context, site string, d *unifi.HeatMapPoint) (*unifi.HeatMapPoint, error) {
+	var result *unifi.HeatMapPoint
+	call := Call{Method: "CreateHeatMapPoint", Resource: "HeatMapPoint", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateHeatMapPoint(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateHeatMapPoint calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateHeatMapPoint(ctx context.Context, site string, d *unifi.HeatMapPoint) (*unifi.HeatMapPoint, error) {
+	var result *unifi.HeatMapPoint
+	call := Call{Method: "UpdateHeatMapPoint", Resource: "HeatMapPoint", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateHeatMapPoint(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteHeatMapPoint calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteHeatMapPoint(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteHeatMapPoint", Resource: "HeatMapPoint", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteHeatMapPoint(ctx, site, id)
+	})
+}
+
+// ListHotspot2Conf calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListHotspot2Conf(ctx context.Context, site string) ([]unifi.Hotspot2Conf, error) {
+	var result []unifi.Hotspot2Conf
+	call := Call{Method: "ListHotspot2Conf", Resource: "Hotspot2Conf", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListHotspot2Conf(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetHotspot2Conf calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetHotspot2Conf(ctx context.Context, site string, id string) (*unifi.Hotspot2Conf, error) {
+	var result *unifi.Hotspot2Conf
+	call := Call{Method: "GetHotspot2Conf", Resource: "Hotspot2Conf", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetHotspot2Conf(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateHotspot2Conf calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateHotspot2Conf(ctx context.Context, site string, d *unifi.Hotspot2Conf) (*unifi.Hotspot2Conf, error) {
+	var result *unifi.Hotspot2Conf
+	call := Call{Method: "CreateHotspot2Conf", Resource: "Hotspot2Conf", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateHotspot2Conf(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateHotspot2Conf calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateHotspot2Conf(ctx context.Context, site string, d *unifi.Hotspot2Conf) (*unifi.Hotspot2Conf, error) {
+	var result *unifi.Hotspot2Conf
+	call := Call{Method: "UpdateHotspot2Conf", Resource: "Hotspot2Conf", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateHotspot2Conf(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteHotspot2Conf calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteHotspot2Conf(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteHotspot2Conf", Resource: "Hotspot2Conf", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteHotspot2Conf(ctx, site, id)
+	})
+}
+
+// ListHotspotOp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListHotspotOp(ctx context.Context, site string) ([]unifi.HotspotOp, error) {
+	var result []unifi.HotspotOp
+	call := Call{Method: "ListHotspotOp", Resource: "HotspotOp", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListHotspotOp(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetHotspotOp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetHotspotOp(ctx context.Context, site string, id string) (*unifi.HotspotOp, error) {
+	var result *unifi.HotspotOp
+	call := Call{Method: "GetHotspotOp", Resource: "HotspotOp", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetHotspotOp(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateHotspotOp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateHotspotOp(ctx context.Context, site string, d *unifi.HotspotOp) (*unifi.HotspotOp, error) {
+	var result *unifi.HotspotOp
+	call := Call{Method: "CreateHotspotOp", Resource: "HotspotOp", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateHotspotOp(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateHotspotOp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateHotspotOp(ctx context.Context, site string, d *unifi.HotspotOp) (*unifi.HotspotOp, error) {
+	var result *unifi.HotspotOp
+	call := Call{Method: "UpdateHotspotOp", Resource: "HotspotOp", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateHotspotOp(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteHotspotOp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteHotspotOp(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteHotspotOp", Resource: "HotspotOp", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteHotspotOp(ctx, site, id)
+	})
+}
+
+// ListHotspotPackage calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListHotspotPackage(ctx context.Context, site string) ([]unifi.HotspotPackage, error) {
+	var result []unifi.HotspotPackage
+	call := Call{Method: "ListHotspotPackage", Resource: "HotspotPackage", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListHotspotPackage(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetHotspotPackage calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetHotspotPackage(ctx context.Context, site string, id string) (*unifi.HotspotPackage, error) {
+	var result *unifi.HotspotPackage
+	call := Call{Method: "GetHotspotPackage", Resource: "HotspotPackage", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetHotspotPackage(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateHotspotPackage calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateHotspotPackage(ctx context.Context, site string, d *unifi.HotspotPackage) (*unifi.HotspotPackage, error) {
+	var result *unifi.HotspotPackage
+	call := Call{Method: "CreateHotspotPackage", Resource: "HotspotPackage", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateHotspotPackage(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateHotspotPackage calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateHotspotPackage(ctx context.Context, site string, d *unifi.HotspotPackage) (*unifi.HotspotPackage, error) {
+	var result *unifi.HotspotPackage
+	call := Call{Method: "UpdateHotspotPackage", Resource: "HotspotPackage", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateHotspotPackage(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteHotspotPackage calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteHotspotPackage(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteHotspotPackage", Resource: "HotspotPackage", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteHotspotPackage(ctx, site, id)
+	})
+}
+
+// ListMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListMap(ctx context.Context, site string) ([]unifi.Map, error) {
+	var result []unifi.Map
+	call := Call{Method: "ListMap", Resource: "Map", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListMap(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetMap(ctx context.Context, site string, id string) (*unifi.Map, error) {
+	var result *unifi.Map
+	call := Call{Method: "GetMap", Resource: "Map", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetMap(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateMap(ctx context.Context, site string, d *unifi.Map) (*unifi.Map, error) {
+	var result *unifi.Map
+	call := Call{Method: "CreateMap", Resource: "Map", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateMap(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateMap(ctx context.Context, site string, d *unifi.Map) (*unifi.Map, error) {
+	var result *unifi.Map
+	call := Call{Method: "UpdateMap", Resource: "Map", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateMap(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteMap calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteMap(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteMap", Resource: "Map", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteMap(ctx, site, id)
+	})
+}
+
+// ListMediaFile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListMediaFile(ctx context.Context, site string) ([]unifi.MediaFile, error) {
+	var result []unifi.MediaFile
+	call := Call{Method: "ListMediaFile", Resource: "MediaFile", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListMediaFile(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetMediaFile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetMediaFile(ctx context.Context, site string, id string) (*unifi.MediaFile, error) {
+	var result *unifi.MediaFile
+	call := Call{Method: "GetMediaFile", Resource: "MediaFile", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetMediaFile(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateMediaFile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateMediaFile(ctx context.Context, site string, d *unifi.MediaFile) (*unifi.MediaFile, error) {
+	var result *unifi.MediaFile
+	call := Call{Method: "CreateMediaFile", Resource: "MediaFile", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateMediaFile(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateMediaFile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateMediaFile(ctx context.Context, site string, d *unifi.MediaFile) (*unifi.MediaFile, error) {
+	var result *unifi.MediaFile
+	call := Call{Method: "UpdateMediaFile", Resource: "MediaFile", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateMediaFile(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteMediaFile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteMediaFile(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteMediaFile", Resource: "MediaFile", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteMediaFile(ctx, site, id)
+	})
+}
+
+// ListNetwork calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListNetwork(ctx context.Context, site string) ([]unifi.Network, error) {
+	var result []unifi.Network
+	call := Call{Method: "ListNetwork", Resource: "Network", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListNetwork(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetNetwork calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetNetwork(ctx context.Context, site string, id string) (*unifi.Network, error) {
+	var result *unifi.Network
+	call := Call{Method: "GetNetwork", Resource: "Network", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetNetwork(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateNetwork calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateNetwork(ctx context.Context, site string, d *unifi.Network) (*unifi.Network, error) {
+	var result *unifi.Network
+	call := Call{Method: "CreateNetwork", Resource: "Network", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateNetwork(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateNetwork calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateNetwork(ctx context.Context, site string, d *unifi.Network) (*unifi.Network, error) {
+	var result *unifi.Network
+	call := Call{Method: "UpdateNetwork", Resource: "Network", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateNetwork(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteNetwork calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteNetwork(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteNetwork", Resource: "Network", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteNetwork(ctx, site, id)
+	})
+}
+
+// ListPortForward calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListPortForward(ctx context.Context, site string) ([]unifi.PortForward, error) {
+	var result []unifi.PortForward
+	call := Call{Method: "ListPortForward", Resource: "PortForward", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListPortForward(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetPortForward calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetPortForward(ctx context.Context, site string, id string) (*unifi.PortForward, error) {
+	var result *unifi.PortForward
+	call := Call{Method: "GetPortForward", Resource: "PortForward", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetPortForward(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreatePortForward calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreatePortForward(ctx context.Context, site string, d *unifi.PortForward) (*unifi.PortForward, error) {
+	var result *unifi.PortForward
+	call := Call{Method: "CreatePortForward", Resource: "PortForward", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreatePortForward(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdatePortForward calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdatePortForward(ctx context.Context, site string, d *unifi.PortForward) (*unifi.PortForward, error) {
+	var result *unifi.PortForward
+	call := Call{Method: "UpdatePortForward", Resource: "PortForward", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdatePortForward(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeletePortForward calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeletePortForward(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeletePortForward", Resource: "PortForward", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeletePortForward(ctx, site, id)
+	})
+}
+
+// ListPortProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListPortProfile(ctx context.Context, site string) ([]unifi.PortProfile, error) {
+	var result []unifi.PortProfile
+	call := Call{Method: "ListPortProfile", Resource: "PortProfile", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListPortProfile(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetPortProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetPortProfile(ctx context.Context, site string, id string) (*unifi.PortProfile, error) {
+	var result *unifi.PortProfile
+	call := Call{Method: "GetPortProfile", Resource: "PortProfile", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetPortProfile(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreatePortProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreatePortProfile(ctx context.Context, site string, d *unifi.PortProfile) (*unifi.PortProfile, error) {
+	var result *unifi.PortProfile
+	call := Call{Method: "CreatePortProfile", Resource: "PortProfile", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreatePortProfile(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdatePortProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdatePortProfile(ctx context.Context, site string, d *unifi.PortProfile) (*unifi.PortProfile, error) {
+	var result *unifi.PortProfile
+	call := Call{Method: "UpdatePortProfile", Resource: "PortProfile", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdatePortProfile(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeletePortProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeletePortProfile(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeletePortProfile", Resource: "PortProfile", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeletePortProfile(ctx, site, id)
+	})
+}
+
+// ListRADIUSProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListRADIUSProfile(ctx context.Context, site string) ([]unifi.RADIUSProfile, error) {
+	var result []unifi.RADIUSProfile
+	call := Call{Method: "ListRADIUSProfile", Resource: "RADIUSProfile", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListRADIUSProfile(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetRADIUSProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetRADIUSProfile(ctx context.Context, site string, id string) (*unifi.RADIUSProfile, error) {
+	var result *unifi.RADIUSProfile
+	call := Call{Method: "GetRADIUSProfile", Resource: "RADIUSProfile", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetRADIUSProfile(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateRADIUSProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateRADIUSProfile(ctx context.Context, site string, d *unifi.RADIUSProfile) (*unifi.RADIUSProfile, error) {
+	var result *unifi.RADIUSProfile
+	call := Call{Method: "CreateRADIUSProfile", Resource: "RADIUSProfile", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateRADIUSProfile(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateRADIUSProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateRADIUSProfile(ctx context.Context, site string, d *unifi.RADIUSProfile) (*unifi.RADIUSProfile, error) {
+	var result *unifi.RADIUSProfile
+	call := Call{Method: "UpdateRADIUSProfile", Resource: "RADIUSProfile", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateRADIUSProfile(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteRADIUSProfile calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteRADIUSProfile(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteRADIUSProfile", Resource: "RADIUSProfile", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteRADIUSProfile(ctx, site, id)
+	})
+}
+
+// ListRouting calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListRouting(ctx context.Context, site string) ([]unifi.Routing, error) {
+	var result []unifi.Routing
+	call := Call{Method: "ListRouting", Resource: "Routing", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListRouting(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetRouting calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetRouting(ctx context.Context, site string, id string) (*unifi.Routing, error) {
+	var result *unifi.Routing
+	call := Call{Method: "GetRouting", Resource: "Routing", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetRouting(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateRouting calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateRouting(ctx context.Context, site string, d *unifi.Routing) (*unifi.Routing, error) {
+	var result *unifi.Routing
+	call := Call{Method: "CreateRouting", Resource: "Routing", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateRouting(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateRouting calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateRouting(ctx context.Context, site string, d *unifi.Routing) (*unifi.Routing, error) {
+	var result *unifi.Routing
+	call := Call{Method: "UpdateRouting", Resource: "Routing", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateRouting(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteRouting calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteRouting(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteRouting", Resource: "Routing", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteRouting(ctx, site, id)
+	})
+}
+
+// ListScheduleTask calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListScheduleTask(ctx context.Context, site string) ([]unifi.ScheduleTask, error) {
+	var result []unifi.ScheduleTask
+	call := Call{Method: "ListScheduleTask", Resource: "ScheduleTask", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListScheduleTask(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetScheduleTask calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetScheduleTask(ctx context.Context, site string, id string) (*unifi.ScheduleTask, error) {
+	var result *unifi.ScheduleTask
+	call := Call{Method: "GetScheduleTask", Resource: "ScheduleTask", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetScheduleTask(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateScheduleTask calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateScheduleTask(ctx context.Context, site string, d *unifi.ScheduleTask) (*unifi.ScheduleTask, error) {
+	var result *unifi.ScheduleTask
+	call := Call{Method: "CreateScheduleTask", Resource: "ScheduleTask", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateScheduleTask(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateScheduleTask calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateScheduleTask(ctx context.Context, site string, d *unifi.ScheduleTask) (*unifi.ScheduleTask, error) {
+	var result *unifi.ScheduleTask
+	call := Call{Method: "UpdateScheduleTask", Resource: "ScheduleTask", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateScheduleTask(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteScheduleTask calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteScheduleTask(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteScheduleTask", Resource: "ScheduleTask", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteScheduleTask(ctx, site, id)
+	})
+}
+
+// GetSettingAutoSpeedtest calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingAutoSpeedtest(ctx context.Context, site string) (*unifi.SettingAutoSpeedtest, error) {
+	var result *unifi.SettingAutoSpeedtest
+	call := Call{Method: "GetSettingAutoSpeedtest", Resource: "SettingAutoSpeedtest", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingAutoSpeedtest(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingAutoSpeedtest calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingAutoSpeedtest(ctx context.Context, site string, d *unifi.SettingAutoSpeedtest) (*unifi.SettingAutoSpeedtest, error) {
+	var result *unifi.SettingAutoSpeedtest
+	call := Call{Method: "UpdateSettingAutoSpeedtest", Resource: "SettingAutoSpeedtest", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingAutoSpeedtest(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingBaresip calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingBaresip(ctx context.Context, site string) (*unifi.SettingBaresip, error) {
+	var result *unifi.SettingBaresip
+	call := Call{Method: "GetSettingBaresip", Resource: "SettingBaresip", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingBaresip(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingBaresip calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingBaresip(ctx context.Context, site string, d *unifi.SettingBaresip) (*unifi.SettingBaresip, error) {
+	var result *unifi.SettingBaresip
+	call := Call{Method: "UpdateSettingBaresip", Resource: "SettingBaresip", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingBaresip(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingBroadcast calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingBroadcast(ctx context.Context, site string) (*unifi.SettingBroadcast, error) {
+	var result *unifi.SettingBroadcast
+	call := Call{Method: "GetSettingBroadcast", Resource: "SettingBroadcast", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingBroadcast(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingBroadcast calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingBroadcast(ctx context.Context, site string, d *unifi.SettingBroadcast) (*unifi.SettingBroadcast, error) {
+	var result *unifi.SettingBroadcast
+	call := Call{Method: "UpdateSettingBroadcast", Resource: "SettingBroadcast", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingBroadcast(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingConnectivity calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingConnectivity(ctx context.Context, site string) (*unifi.SettingConnectivity, error) {
+	var result *unifi.SettingConnectivity
+	call := Call{Method: "GetSettingConnectivity", Resource: "SettingConnectivity", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingConnectivity(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingConnectivity calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingConnectivity(ctx context.Context, site string, d *unifi.SettingConnectivity) (*unifi.SettingConnectivity, error) {
+	var result *unifi.SettingConnectivity
+	call := Call{Method: "UpdateSettingConnectivity", Resource: "SettingConnectivity", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingConnectivity(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingCountry calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingCountry(ctx context.Context, site string) (*unifi.SettingCountry, error) {
+	var result *unifi.SettingCountry
+	call := Call{Method: "GetSettingCountry", Resource: "SettingCountry", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingCountry(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingCountry calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingCountry(ctx context.Context, site string, d *unifi.SettingCountry) (*unifi.SettingCountry, error) {
+	var result *unifi.SettingCountry
+	call := Call{Method: "UpdateSettingCountry", Resource: "SettingCountry", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingCountry(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingDashboard calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingDashboard(ctx context.Context, site string) (*unifi.SettingDashboard, error) {
+	var result *unifi.SettingDashboard
+	call := Call{Method: "GetSettingDashboard", Resource: "SettingDashboard", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingDashboard(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingDashboard calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingDashboard(ctx context.Context, site string, d *unifi.SettingDashboard) (*unifi.SettingDashboard, error) {
+	var result *unifi.SettingDashboard
+	call := Call{Method: "UpdateSettingDashboard", Resource: "SettingDashboard", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingDashboard(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingDoh calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingDoh(ctx context.Context, site string) (*unifi.SettingDoh, error) {
+	var result *unifi.SettingDoh
+	call := Call{Method: "GetSettingDoh", Resource: "SettingDoh", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingDoh(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingDoh calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingDoh(ctx context.Context, site string, d *unifi.SettingDoh) (*unifi.SettingDoh, error) {
+	var result *unifi.SettingDoh
+	call := Call{Method: "UpdateSettingDoh", Resource: "SettingDoh", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingDoh(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingDpi calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingDpi(ctx context.Context, site string) (*unifi.SettingDpi, error) {
+	var result *unifi.SettingDpi
+	call := Call{Method: "GetSettingDpi", Resource: "SettingDpi", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingDpi(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingDpi calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingDpi(ctx context.Context, site string, d *unifi.SettingDpi) (*unifi.SettingDpi, error) {
+	var result *unifi.SettingDpi
+	call := Call{Method: "UpdateSettingDpi", Resource: "SettingDpi", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingDpi(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingElementAdopt calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingElementAdopt(ctx context.Context, site string) (*unifi.SettingElementAdopt, error) {
+	var result *unifi.SettingElementAdopt
+	call := Call{Method: "GetSettingElementAdopt", Resource: "SettingElementAdopt", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingElementAdopt(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingElementAdopt calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingElementAdopt(ctx context.Context, site string, d *unifi.SettingElementAdopt) (*unifi.SettingElementAdopt, error) {
+	var result *unifi.SettingElementAdopt
+	call := Call{Method: "UpdateSettingElementAdopt", Resource: "SettingElementAdopt", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingElementAdopt(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingEtherLighting calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingEtherLighting(ctx context.Context, site string) (*unifi.SettingEtherLighting, error) {
+	var result *unifi.SettingEtherLighting
+	call := Call{Method: "GetSettingEtherLighting", Resource: "SettingEtherLighting", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingEtherLighting(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingEtherLighting calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingEtherLighting(ctx context.Context, site string, d *unifi.SettingEtherLighting) (*unifi.SettingEtherLighting, error) {
+	var result *unifi.SettingEtherLighting
+	call := Call{Method: "UpdateSettingEtherLighting", Resource: "SettingEtherLighting", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingEtherLighting(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingEvaluationScore calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingEvaluationScore(ctx context.Context, site string) (*unifi.SettingEvaluationScore, error) {
+	var result *unifi.SettingEvaluationScore
+	call := Call{Method: "GetSettingEvaluationScore", Resource: "SettingEvaluationScore", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingEvaluationScore(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingEvaluationScore calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingEvaluationScore(ctx context.Context, site string, d *unifi.SettingEvaluationScore) (*unifi.SettingEvaluationScore, error) {
+	var result *unifi.SettingEvaluationScore
+	call := Call{Method: "UpdateSettingEvaluationScore", Resource: "SettingEvaluationScore", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingEvaluationScore(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingGlobalAp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingGlobalAp(ctx context.Context, site string) (*unifi.SettingGlobalAp, error) {
+	var result *unifi.SettingGlobalAp
+	call := Call{Method: "GetSettingGlobalAp", Resource: "SettingGlobalAp", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingGlobalAp(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingGlobalAp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingGlobalAp(ctx context.Context, site string, d *unifi.SettingGlobalAp) (*unifi.SettingGlobalAp, error) {
+	var result *unifi.SettingGlobalAp
+	call := Call{Method: "UpdateSettingGlobalAp", Resource: "SettingGlobalAp", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingGlobalAp(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingGlobalNat calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingGlobalNat(ctx context.Context, site string) (*unifi.SettingGlobalNat, error) {
+	var result *unifi.SettingGlobalNat
+	call := Call{Method: "GetSettingGlobalNat", Resource: "SettingGlobalNat", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingGlobalNat(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingGlobalNat calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingGlobalNat(ctx context.Context, site string, d *unifi.SettingGlobalNat) (*unifi.SettingGlobalNat, error) {
+	var result *unifi.SettingGlobalNat
+	call := Call{Method: "UpdateSettingGlobalNat", Resource: "SettingGlobalNat", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingGlobalNat(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingGlobalSwitch calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingGlobalSwitch(ctx context.Context, site string) (*unifi.SettingGlobalSwitch, error) {
+	var result *unifi.SettingGlobalSwitch
+	call := Call{Method: "GetSettingGlobalSwitch", Resource: "SettingGlobalSwitch", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingGlobalSwitch(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingGlobalSwitch calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingGlobalSwitch(ctx context.Context, site string, d *unifi.SettingGlobalSwitch) (*unifi.SettingGlobalSwitch, error) {
+	var result *unifi.SettingGlobalSwitch
+	call := Call{Method: "UpdateSettingGlobalSwitch", Resource: "SettingGlobalSwitch", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingGlobalSwitch(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingGuestAccess calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingGuestAccess(ctx context.Context, site string) (*unifi.SettingGuestAccess, error) {
+	var result *unifi.SettingGuestAccess
+	call := Call{Method: "GetSettingGuestAccess", Resource: "SettingGuestAccess", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingGuestAccess(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingGuestAccess calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingGuestAccess(ctx context.Context, site string, d *unifi.SettingGuestAccess) (*unifi.SettingGuestAccess, error) {
+	var result *unifi.SettingGuestAccess
+	call := Call{Method: "UpdateSettingGuestAccess", Resource: "SettingGuestAccess", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingGuestAccess(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingIps calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingIps(ctx context.Context, site string) (*unifi.SettingIps, error) {
+	var result *unifi.SettingIps
+	call := Call{Method: "GetSettingIps", Resource: "SettingIps", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingIps(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingIps calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingIps(ctx context.Context, site string, d *unifi.SettingIps) (*unifi.SettingIps, error) {
+	var result *unifi.SettingIps
+	call := Call{Method: "UpdateSettingIps", Resource: "SettingIps", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingIps(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingLcm calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingLcm(ctx context.Context, site string) (*unifi.SettingLcm, error) {
+	var result *unifi.SettingLcm
+	call := Call{Method: "GetSettingLcm", Resource: "SettingLcm", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingLcm(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingLcm calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingLcm(ctx context.Context, site string, d *unifi.SettingLcm) (*unifi.SettingLcm, error) {
+	var result *unifi.SettingLcm
+	call := Call{Method: "UpdateSettingLcm", Resource: "SettingLcm", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingLcm(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingLocale calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingLocale(ctx context.Context, site string) (*unifi.SettingLocale, error) {
+	var result *unifi.SettingLocale
+	call := Call{Method: "GetSettingLocale", Resource: "SettingLocale", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingLocale(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingLocale calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingLocale(ctx context.Context, site string, d *unifi.SettingLocale) (*unifi.SettingLocale, error) {
+	var result *unifi.SettingLocale
+	call := Call{Method: "UpdateSettingLocale", Resource: "SettingLocale", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingLocale(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingMagicSiteToSiteVpn calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingMagicSiteToSiteVpn(ctx context.Context, site string) (*unifi.SettingMagicSiteToSiteVpn, error) {
+	var result *unifi.SettingMagicSiteToSiteVpn
+	call := Call{Method: "GetSettingMagicSiteToSiteVpn", Resource: "SettingMagicSiteToSiteVpn", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingMagicSiteToSiteVpn(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingMagicSiteToSiteVpn calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingMagicSiteToSiteVpn(ctx context.Context, site string, d *unifi.SettingMagicSiteToSiteVpn) (*unifi.SettingMagicSiteToSiteVpn, error) {
+	var result *unifi.SettingMagicSiteToSiteVpn
+	call := Call{Method: "UpdateSettingMagicSiteToSiteVpn", Resource: "SettingMagicSiteToSiteVpn", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingMagicSiteToSiteVpn(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingMgmt calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingMgmt(ctx context.Context, site string) (*unifi.SettingMgmt, error) {
+	var result *unifi.SettingMgmt
+	call := Call{Method: "GetSettingMgmt", Resource: "SettingMgmt", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingMgmt(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingMgmt calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingMgmt(ctx context.Context, site string, d *unifi.SettingMgmt) (*unifi.SettingMgmt, error) {
+	var result *unifi.SettingMgmt
+	call := Call{Method: "UpdateSettingMgmt", Resource: "SettingMgmt", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingMgmt(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingNetflow calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingNetflow(ctx context.Context, site string) (*unifi.SettingNetflow, error) {
+	var result *unifi.SettingNetflow
+	call := Call{Method: "GetSettingNetflow", Resource: "SettingNetflow", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingNetflow(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingNetflow calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingNetflow(ctx context.Context, site string, d *unifi.SettingNetflow) (*unifi.SettingNetflow, error) {
+	var result *unifi.SettingNetflow
+	call := Call{Method: "UpdateSettingNetflow", Resource: "SettingNetflow", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingNetflow(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingNetworkOptimization calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingNetworkOptimization(ctx context.Context, site string) (*unifi.SettingNetworkOptimization, error) {
+	var result *unifi.SettingNetworkOptimization
+	call := Call{Method: "GetSettingNetworkOptimization", Resource: "SettingNetworkOptimization", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingNetworkOptimization(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingNetworkOptimization calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingNetworkOptimization(ctx context.Context, site string, d *unifi.SettingNetworkOptimization) (*unifi.SettingNetworkOptimization, error) {
+	var result *unifi.SettingNetworkOptimization
+	call := Call{Method: "UpdateSettingNetworkOptimization", Resource: "SettingNetworkOptimization", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingNetworkOptimization(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingNtp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingNtp(ctx context.Context, site string) (*unifi.SettingNtp, error) {
+	var result *unifi.SettingNtp
+	call := Call{Method: "GetSettingNtp", Resource: "SettingNtp", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingNtp(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingNtp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingNtp(ctx context.Context, site string, d *unifi.SettingNtp) (*unifi.SettingNtp, error) {
+	var result *unifi.SettingNtp
+	call := Call{Method: "UpdateSettingNtp", Resource: "SettingNtp", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingNtp(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingPorta calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingPorta(ctx context.Context, site string) (*unifi.SettingPorta, error) {
+	var result *unifi.SettingPorta
+	call := Call{Method: "GetSettingPorta", Resource: "SettingPorta", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingPorta(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingPorta calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingPorta(ctx context.Context, site string, d *unifi.SettingPorta) (*unifi.SettingPorta, error) {
+	var result *unifi.SettingPorta
+	call := Call{Method: "UpdateSettingPorta", Resource: "SettingPorta", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingPorta(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingRadioAi calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingRadioAi(ctx context.Context, site string) (*unifi.SettingRadioAi, error) {
+	var result *unifi.SettingRadioAi
+	call := Call{Method: "GetSettingRadioAi", Resource: "SettingRadioAi", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingRadioAi(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingRadioAi calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingRadioAi(ctx context.Context, site string, d *unifi.SettingRadioAi) (*unifi.SettingRadioAi, error) {
+	var result *unifi.SettingRadioAi
+	call := Call{Method: "UpdateSettingRadioAi", Resource: "SettingRadioAi", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingRadioAi(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingRadius calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingRadius(ctx context.Context, site string) (*unifi.SettingRadius, error) {
+	var result *unifi.SettingRadius
+	call := Call{Method: "GetSettingRadius", Resource: "SettingRadius", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingRadius(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingRadius calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingRadius(ctx context.Context, site string, d *unifi.SettingRadius) (*unifi.SettingRadius, error) {
+	var result *unifi.SettingRadius
+	call := Call{Method: "UpdateSettingRadius", Resource: "SettingRadius", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingRadius(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingRsyslogd calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingRsyslogd(ctx context.Context, site string) (*unifi.SettingRsyslogd, error) {
+	var result *unifi.SettingRsyslogd
+	call := Call{Method: "GetSettingRsyslogd", Resource: "SettingRsyslogd", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingRsyslogd(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingRsyslogd calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingRsyslogd(ctx context.Context, site string, d *unifi.SettingRsyslogd) (*unifi.SettingRsyslogd, error) {
+	var result *unifi.SettingRsyslogd
+	call := Call{Method: "UpdateSettingRsyslogd", Resource: "SettingRsyslogd", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingRsyslogd(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSnmp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSnmp(ctx context.Context, site string) (*unifi.SettingSnmp, error) {
+	var result *unifi.SettingSnmp
+	call := Call{Method: "GetSettingSnmp", Resource: "SettingSnmp", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSnmp(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSnmp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSnmp(ctx context.Context, site string, d *unifi.SettingSnmp) (*unifi.SettingSnmp, error) {
+	var result *unifi.SettingSnmp
+	call := Call{Method: "UpdateSettingSnmp", Resource: "SettingSnmp", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSnmp(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSslInspection calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSslInspection(ctx context.Context, site string) (*unifi.SettingSslInspection, error) {
+	var result *unifi.SettingSslInspection
+	call := Call{Method: "GetSettingSslInspection", Resource: "SettingSslInspection", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSslInspection(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSslInspection calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSslInspection(ctx context.Context, site string, d *unifi.SettingSslInspection) (*unifi.SettingSslInspection, error) {
+	var result *unifi.SettingSslInspection
+	call := Call{Method: "UpdateSettingSslInspection", Resource: "SettingSslInspection", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSslInspection(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSuperCloudaccess calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSuperCloudaccess(ctx context.Context, site string) (*unifi.SettingSuperCloudaccess, error) {
+	var result *unifi.SettingSuperCloudaccess
+	call := Call{Method: "GetSettingSuperCloudaccess", Resource: "SettingSuperCloudaccess", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSuperCloudaccess(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSuperCloudaccess calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSuperCloudaccess(ctx context.Context, site string, d *unifi.SettingSuperCloudaccess) (*unifi.SettingSuperCloudaccess, error) {
+	var result *unifi.SettingSuperCloudaccess
+	call := Call{Method: "UpdateSettingSuperCloudaccess", Resource: "SettingSuperCloudaccess", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSuperCloudaccess(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSuperEvents calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSuperEvents(ctx context.Context, site string) (*unifi.SettingSuperEvents, error) {
+	var result *unifi.SettingSuperEvents
+	call := Call{Method: "GetSettingSuperEvents", Resource: "SettingSuperEvents", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSuperEvents(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSuperEvents calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSuperEvents(ctx context.Context, site string, d *unifi.SettingSuperEvents) (*unifi.SettingSuperEvents, error) {
+	var result *unifi.SettingSuperEvents
+	call := Call{Method: "UpdateSettingSuperEvents", Resource: "SettingSuperEvents", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSuperEvents(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSuperFwupdate calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSuperFwupdate(ctx context.Context, site string) (*unifi.SettingSuperFwupdate, error) {
+	var result *unifi.SettingSuperFwupdate
+	call := Call{Method: "GetSettingSuperFwupdate", Resource: "SettingSuperFwupdate", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSuperFwupdate(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSuperFwupdate calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSuperFwupdate(ctx context.Context, site string, d *unifi.SettingSuperFwupdate) (*unifi.SettingSuperFwupdate, error) {
+	var result *unifi.SettingSuperFwupdate
+	call := Call{Method: "UpdateSettingSuperFwupdate", Resource: "SettingSuperFwupdate", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSuperFwupdate(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSuperIdentity calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSuperIdentity(ctx context.Context, site string) (*unifi.SettingSuperIdentity, error) {
+	var result *unifi.SettingSuperIdentity
+	call := Call{Method: "GetSettingSuperIdentity", Resource: "SettingSuperIdentity", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSuperIdentity(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSuperIdentity calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSuperIdentity(ctx context.Context, site string, d *unifi.SettingSuperIdentity) (*unifi.SettingSuperIdentity, error) {
+	var result *unifi.SettingSuperIdentity
+	call := Call{Method: "UpdateSettingSuperIdentity", Resource: "SettingSuperIdentity", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSuperIdentity(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSuperMail calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSuperMail(ctx context.Context, site string) (*unifi.SettingSuperMail, error) {
+	var result *unifi.SettingSuperMail
+	call := Call{Method: "GetSettingSuperMail", Resource: "SettingSuperMail", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSuperMail(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSuperMail calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSuperMail(ctx context.Context, site string, d *unifi.SettingSuperMail) (*unifi.SettingSuperMail, error) {
+	var result *unifi.SettingSuperMail
+	call := Call{Method: "UpdateSettingSuperMail", Resource: "SettingSuperMail", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSuperMail(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSuperMgmt calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSuperMgmt(ctx context.Context, site string) (*unifi.SettingSuperMgmt, error) {
+	var result *unifi.SettingSuperMgmt
+	call := Call{Method: "GetSettingSuperMgmt", Resource: "SettingSuperMgmt", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSuperMgmt(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSuperMgmt calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSuperMgmt(ctx context.Context, site string, d *unifi.SettingSuperMgmt) (*unifi.SettingSuperMgmt, error) {
+	var result *unifi.SettingSuperMgmt
+	call := Call{Method: "UpdateSettingSuperMgmt", Resource: "SettingSuperMgmt", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSuperMgmt(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSuperSdn calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSuperSdn(ctx context.Context, site string) (*unifi.SettingSuperSdn, error) {
+	var result *unifi.SettingSuperSdn
+	call := Call{Method: "GetSettingSuperSdn", Resource: "SettingSuperSdn", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSuperSdn(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSuperSdn calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSuperSdn(ctx context.Context, site string, d *unifi.SettingSuperSdn) (*unifi.SettingSuperSdn, error) {
+	var result *unifi.SettingSuperSdn
+	call := Call{Method: "UpdateSettingSuperSdn", Resource: "SettingSuperSdn", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSuperSdn(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingSuperSmtp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingSuperSmtp(ctx context.Context, site string) (*unifi.SettingSuperSmtp, error) {
+	var result *unifi.SettingSuperSmtp
+	call := Call{Method: "GetSettingSuperSmtp", Resource: "SettingSuperSmtp", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingSuperSmtp(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingSuperSmtp calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingSuperSmtp(ctx context.Context, site string, d *unifi.SettingSuperSmtp) (*unifi.SettingSuperSmtp, error) {
+	var result *unifi.SettingSuperSmtp
+	call := Call{Method: "UpdateSettingSuperSmtp", Resource: "SettingSuperSmtp", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingSuperSmtp(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingTeleport calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingTeleport(ctx context.Context, site string) (*unifi.SettingTeleport, error) {
+	var result *unifi.SettingTeleport
+	call := Call{Method: "GetSettingTeleport", Resource: "SettingTeleport", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingTeleport(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingTeleport calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingTeleport(ctx context.Context, site string, d *unifi.SettingTeleport) (*unifi.SettingTeleport, error) {
+	var result *unifi.SettingTeleport
+	call := Call{Method: "UpdateSettingTeleport", Resource: "SettingTeleport", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingTeleport(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingUsg calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingUsg(ctx context.Context, site string) (*unifi.SettingUsg, error) {
+	var result *unifi.SettingUsg
+	call := Call{Method: "GetSettingUsg", Resource: "SettingUsg", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingUsg(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingUsg calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingUsg(ctx context.Context, site string, d *unifi.SettingUsg) (*unifi.SettingUsg, error) {
+	var result *unifi.SettingUsg
+	call := Call{Method: "UpdateSettingUsg", Resource: "SettingUsg", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingUsg(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// GetSettingUsw calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSettingUsw(ctx context.Context, site string) (*unifi.SettingUsw, error) {
+	var result *unifi.SettingUsw
+	call := Call{Method: "GetSettingUsw", Resource: "SettingUsw", Category: "get", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSettingUsw(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSettingUsw calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSettingUsw(ctx context.Context, site string, d *unifi.SettingUsw) (*unifi.SettingUsw, error) {
+	var result *unifi.SettingUsw
+	call := Call{Method: "UpdateSettingUsw", Resource: "SettingUsw", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSettingUsw(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// ListSpatialRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListSpatialRecord(ctx context.Context, site string) ([]unifi.SpatialRecord, error) {
+	var result []unifi.SpatialRecord
+	call := Call{Method: "ListSpatialRecord", Resource: "SpatialRecord", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListSpatialRecord(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetSpatialRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetSpatialRecord(ctx context.Context, site string, id string) (*unifi.SpatialRecord, error) {
+	var result *unifi.SpatialRecord
+	call := Call{Method: "GetSpatialRecord", Resource: "SpatialRecord", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetSpatialRecord(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateSpatialRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateSpatialRecord(ctx context.Context, site string, d *unifi.SpatialRecord) (*unifi.SpatialRecord, error) {
+	var result *unifi.SpatialRecord
+	call := Call{Method: "CreateSpatialRecord", Resource: "SpatialRecord", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateSpatialRecord(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateSpatialRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateSpatialRecord(ctx context.Context, site string, d *unifi.SpatialRecord) (*unifi.SpatialRecord, error) {
+	var result *unifi.SpatialRecord
+	call := Call{Method: "UpdateSpatialRecord", Resource: "SpatialRecord", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateSpatialRecord(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteSpatialRecord calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteSpatialRecord(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteSpatialRecord", Resource: "SpatialRecord", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteSpatialRecord(ctx, site, id)
+	})
+}
+
+// ListTag calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListTag(ctx context.Context, site string) ([]unifi.Tag, error) {
+	var result []unifi.Tag
+	call := Call{Method: "ListTag", Resource: "Tag", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListTag(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetTag calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetTag(ctx context.Context, site string, id string) (*unifi.Tag, error) {
+	var result *unifi.Tag
+	call := Call{Method: "GetTag", Resource: "Tag", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetTag(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateTag calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateTag(ctx context.Context, site string, d *unifi.Tag) (*unifi.Tag, error) {
+	var result *unifi.Tag
+	call := Call{Method: "CreateTag", Resource: "Tag", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateTag(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateTag calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateTag(ctx context.Context, site string, d *unifi.Tag) (*unifi.Tag, error) {
+	var result *unifi.Tag
+	call := Call{Method: "UpdateTag", Resource: "Tag", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateTag(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteTag calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteTag(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteTag", Resource: "Tag", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteTag(ctx, site, id)
+	})
+}
+
+// ListUser calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListUser(ctx context.Context, site string) ([]unifi.User, error) {
+	var result []unifi.User
+	call := Call{Method: "ListUser", Resource: "User", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListUser(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetUser calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetUser(ctx context.Context, site string, id string) (*unifi.User, error) {
+	var result *unifi.User
+	call := Call{Method: "GetUser", Resource: "User", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetUser(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateUser calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateUser(ctx context.Context, site string, d *unifi.User) (*unifi.User, error) {
+	var result *unifi.User
+	call := Call{Method: "CreateUser", Resource: "User", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateUser(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateUser calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateUser(ctx context.Context, site string, d *unifi.User) (*unifi.User, error) {
+	var result *unifi.User
+	call := Call{Method: "UpdateUser", Resource: "User", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateUser(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteUser calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteUser(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteUser", Resource: "User", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteUser(ctx, site, id)
+	})
+}
+
+// ListUserGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListUserGroup(ctx context.Context, site string) ([]unifi.UserGroup, error) {
+	var result []unifi.UserGroup
+	call := Call{Method: "ListUserGroup", Resource: "UserGroup", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListUserGroup(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetUserGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetUserGroup(ctx context.Context, site string, id string) (*unifi.UserGroup, error) {
+	var result *unifi.UserGroup
+	call := Call{Method: "GetUserGroup", Resource: "UserGroup", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetUserGroup(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateUserGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateUserGroup(ctx context.Context, site string, d *unifi.UserGroup) (*unifi.UserGroup, error) {
+	var result *unifi.UserGroup
+	call := Call{Method: "CreateUserGroup", Resource: "UserGroup", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateUserGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateUserGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateUserGroup(ctx context.Context, site string, d *unifi.UserGroup) (*unifi.UserGroup, error) {
+	var result *unifi.UserGroup
+	call := Call{Method: "UpdateUserGroup", Resource: "UserGroup", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateUserGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteUserGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteUserGroup(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteUserGroup", Resource: "UserGroup", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteUserGroup(ctx, site, id)
+	})
+}
+
+// ListVirtualDevice calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListVirtualDevice(ctx context.Context, site string) ([]unifi.VirtualDevice, error) {
+	var result []unifi.VirtualDevice
+	call := Call{Method: "ListVirtualDevice", Resource: "VirtualDevice", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListVirtualDevice(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetVirtualDevice calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetVirtualDevice(ctx context.Context, site string, id string) (*unifi.VirtualDevice, error) {
+	var result *unifi.VirtualDevice
+	call := Call{Method: "GetVirtualDevice", Resource: "VirtualDevice", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetVirtualDevice(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateVirtualDevice calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateVirtualDevice(ctx context.Context, site string, d *unifi.VirtualDevice) (*unifi.VirtualDevice, error) {
+	var result *unifi.VirtualDevice
+	call := Call{Method: "CreateVirtualDevice", Resource: "VirtualDevice", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateVirtualDevice(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateVirtualDevice calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateVirtualDevice(ctx context.Context, site string, d *unifi.VirtualDevice) (*unifi.VirtualDevice, error) {
+	var result *unifi.VirtualDevice
+	call := Call{Method: "UpdateVirtualDevice", Resource: "VirtualDevice", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateVirtualDevice(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteVirtualDevice calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteVirtualDevice(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteVirtualDevice", Resource: "VirtualDevice", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteVirtualDevice(ctx, site, id)
+	})
+}
+
+// ListWLAN calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListWLAN(ctx context.Context, site string) ([]unifi.WLAN, error) {
+	var result []unifi.WLAN
+	call := Call{Method: "ListWLAN", Resource: "WLAN", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListWLAN(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetWLAN calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetWLAN(ctx context.Context, site string, id string) (*unifi.WLAN, error) {
+	var result *unifi.WLAN
+	call := Call{Method: "GetWLAN", Resource: "WLAN", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetWLAN(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateWLAN calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateWLAN(ctx context.Context, site string, d *unifi.WLAN) (*unifi.WLAN, error) {
+	var result *unifi.WLAN
+	call := Call{Method: "CreateWLAN", Resource: "WLAN", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateWLAN(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateWLAN calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateWLAN(ctx context.Context, site string, d *unifi.WLAN) (*unifi.WLAN, error) {
+	var result *unifi.WLAN
+	call := Call{Method: "UpdateWLAN", Resource: "WLAN", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateWLAN(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteWLAN calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteWLAN(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteWLAN", Resource: "WLAN", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteWLAN(ctx, site, id)
+	})
+}
+
+// ListWLANGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) ListWLANGroup(ctx context.Context, site string) ([]unifi.WLANGroup, error) {
+	var result []unifi.WLANGroup
+	call := Call{Method: "ListWLANGroup", Resource: "WLANGroup", Category: "list", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.ListWLANGroup(ctx, site)
+		return err
+	})
+	return result, err
+}
+
+// GetWLANGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) GetWLANGroup(ctx context.Context, site string, id string) (*unifi.WLANGroup, error) {
+	var result *unifi.WLANGroup
+	call := Call{Method: "GetWLANGroup", Resource: "WLANGroup", Category: "get", Site: site, ID: id, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.GetWLANGroup(ctx, site, id)
+		return err
+	})
+	return result, err
+}
+
+// CreateWLANGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) CreateWLANGroup(ctx context.Context, site string, d *unifi.WLANGroup) (*unifi.WLANGroup, error) {
+	var result *unifi.WLANGroup
+	call := Call{Method: "CreateWLANGroup", Resource: "WLANGroup", Category: "create", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.CreateWLANGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// UpdateWLANGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) UpdateWLANGroup(ctx context.Context, site string, d *unifi.WLANGroup) (*unifi.WLANGroup, error) {
+	var result *unifi.WLANGroup
+	call := Call{Method: "UpdateWLANGroup", Resource: "WLANGroup", Category: "update", Site: site, Result: &result}
+	err := c.intercept(ctx, call, func(ctx context.Context) error {
+		var err error
+		result, err = c.Client.UpdateWLANGroup(ctx, site, d)
+		return err
+	})
+	return result, err
+}
+
+// DeleteWLANGroup calls the wrapped client through the interceptor chain.
+func (c *InterceptedClient) DeleteWLANGroup(ctx context.Context, site string, id string) error {
+	call := Call{Method: "DeleteWLANGroup", Resource: "WLANGroup", Category: "delete", Site: site, ID: id}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.DeleteWLANGroup(ctx, site, id)
+	})
+}
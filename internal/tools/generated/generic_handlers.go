@@ -4,146 +4,299 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
 	"github.com/claytono/go-unifi-mcp/internal/query"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// resourceOps are the client calls the handlers make for one resource. The
+// typed handlers (see typed_handlers.go) bind them to direct method calls;
+// the Generic handlers bind them through reflection. A nil op means the
+// client has no such method.
+type resourceOps struct {
+	resource string
+	newType  func() any
+	list     func(ctx context.Context, site string) (any, error)
+	get      func(ctx context.Context, site, id string) (any, error) // id is ignored for settings
+	create   func(ctx context.Context, site string, input any) (any, error)
+	update   func(ctx context.Context, site string, input any) (any, error)
+	delete   func(ctx context.Context, site, id string) error
+}
+
+// reflectOps binds the <Op><Resource> methods of client through reflection.
+// Get methods of settings take no ID.
+func reflectOps(client any, resourceName string, newTypeFunc func() any, isSetting bool) resourceOps {
+	clientVal := reflect.ValueOf(client)
+	call := func(name string, args ...any) []reflect.Value {
+		in := make([]reflect.Value, len(args))
+		for i, arg := range args {
+			in[i] = reflect.ValueOf(arg)
+		}
+		return clientVal.MethodByName(name + resourceName).Call(in)
+	}
+	has := func(name string) bool {
+		return clientVal.MethodByName(name + resourceName).IsValid()
+	}
+
+	ops := resourceOps{resource: resourceName, newType: newTypeFunc}
+	if has("List") {
+		ops.list = func(ctx context.Context, site string) (any, error) {
+			results := call("List", ctx, site)
+			return results[0].Interface(), extractError(results[1])
+		}
+	}
+	if has("Get") {
+		ops.get = func(ctx context.Context, site, id string) (any, error) {
+			var results []reflect.Value
+			if isSetting {
+				results = call("Get", ctx, site)
+			} else {
+				results = call("Get", ctx, site, id)
+			}
+			if err := extractError(results[1]); err != nil {
+				return nil, err
+			}
+			if isNilValue(results[0]) {
+				return nil, nil
+			}
+			return results[0].Interface(), nil
+		}
+	}
+	if has("Create") {
+		ops.create = func(ctx context.Context, site string, input any) (any, error) {
+			results := call("Create", ctx, site, input)
+			return results[0].Interface(), extractError(results[1])
+		}
+	}
+	if has("Update") {
+		ops.update = func(ctx context.Context, site string, input any) (any, error) {
+			results := call("Update", ctx, site, input)
+			return results[0].Interface(), extractError(results[1])
+		}
+	}
+	if has("Delete") {
+		ops.delete = func(ctx context.Context, site, id string) error {
+			return extractError(call("Delete", ctx, site, id)[0])
+		}
+	}
+	return ops
+}
+
 // GenericList creates a handler that calls client.List<Resource>(ctx, site) via reflection.
 // The client parameter accepts any type (typically unifi.Client) and uses reflection
-// to call the appropriate method.
+// to call the appropriate method. Generated handlers use TypedList instead.
 func GenericList(client any, resourceName string) server.ToolHandlerFunc {
-	methodName := "List" + resourceName
+	return listHandler(reflectOps(client, resourceName, nil, false))
+}
 
+func listHandler(ops resourceOps) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		site := extractSite(req)
 
-		clientVal := reflect.ValueOf(client)
-		method := clientVal.MethodByName(methodName)
-		if !method.IsValid() {
-			return mcp.NewToolResultError(fmt.Sprintf("method %s not found", methodName)), nil
+		if ops.list == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("method List%s not found", ops.resource)), nil
 		}
-
-		results := method.Call([]reflect.Value{
-			reflect.ValueOf(ctx),
-			reflect.ValueOf(site),
-		})
-
-		if err := extractError(results[1]); err != nil {
+		queryOpts, err := query.ParseOptions(req.GetArguments())
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if err := applyView(ops.resource, req.GetArguments(), &queryOpts); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		// Reject an invalid query before calling the controller.
+		if err := queryOpts.Validate(); err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
 
-		queryOpts := query.ParseOptions(req.GetArguments())
-
-		if !queryOpts.HasQuery() {
-			// Fast path: no double-marshal when no query params
-			data, err := json.MarshalIndent(results[0].Interface(), "", "  ")
-			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
-			}
-			return mcp.NewToolResultText(string(data)), nil
+		items, err := ops.list(ctx, site)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
-		// Query path: marshal → filter → re-marshal
-		raw, err := json.Marshal(results[0].Interface())
+		value, err := payload.FromGo(items)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
 		}
-		var items []map[string]any
-		if err := json.Unmarshal(raw, &items); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to process response for filtering: %v", err)), nil
-		}
-		items = query.Apply(items, queryOpts)
-		data, err := json.MarshalIndent(items, "", "  ")
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal filtered response: %v", err)), nil
+
+		// Query params filter the decoded items in place of a second marshal
+		if queryOpts.HasQuery() {
+			if items, ok := value.Data.([]map[string]any); ok {
+				data, err := ApplyQuery(ctx, items, queryOpts)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				value.Data = data
+			}
 		}
-		return mcp.NewToolResultText(string(data)), nil
+		return payload.NewResult(ctx, value), nil
+	}
+}
+
+// ApplyQuery applies list query options to items. Facets replace the items
+// by an object of value counts; paginated results report their page as
+// annotations.
+func ApplyQuery(ctx context.Context, items []map[string]any, opts query.Options) (any, error) {
+	if len(opts.Facets) > 0 {
+		return query.ApplyFacets(items, opts)
+	}
+	result, page, err := query.ApplyPage(items, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Paginated() {
+		a := annotate.FromContext(ctx)
+		a.Set(KeyTotal, page.Total)
+		a.Set(KeyReturned, page.Returned)
+		a.Set(KeyNextOffset, page.NextOffset)
 	}
+	return result, nil
 }
 
+// Annotations reporting the page returned by a list call with limit or
+// offset.
+const (
+	KeyTotal      = "total"       // items matching the filter and search
+	KeyReturned   = "returned"    // items in this page
+	KeyNextOffset = "next_offset" // offset of the next page, null on the last
+)
+
 // GenericGet creates a handler that calls client.Get<Resource>(ctx, site, id) via reflection.
 // For settings resources (isSetting=true), it calls client.Get<Resource>(ctx, site) without ID.
 func GenericGet(client any, resourceName string, isSetting bool) server.ToolHandlerFunc {
-	methodName := "Get" + resourceName
+	return getHandler(reflectOps(client, resourceName, nil, isSetting), isSetting)
+}
 
+func getHandler(ops resourceOps, isSetting bool) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		site := extractSite(req)
 
-		clientVal := reflect.ValueOf(client)
-		method := clientVal.MethodByName(methodName)
-		if !method.IsValid() {
-			return mcp.NewToolResultError(fmt.Sprintf("method %s not found", methodName)), nil
-		}
-
-		var results []reflect.Value
-		if isSetting {
-			// Settings don't have IDs
-			results = method.Call([]reflect.Value{
-				reflect.ValueOf(ctx),
-				reflect.ValueOf(site),
-			})
-		} else {
-			id, ok := req.GetArguments()["id"].(string)
+		if ops.get == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("method Get%s not found", ops.resource)), nil
+		}
+
+		var id string
+		if !isSetting {
+			var ok bool
+			id, ok = req.GetArguments()["id"].(string)
 			if !ok || id == "" {
 				return mcp.NewToolResultError("required parameter 'id' is missing or invalid"), nil
 			}
-			results = method.Call([]reflect.Value{
-				reflect.ValueOf(ctx),
-				reflect.ValueOf(site),
-				reflect.ValueOf(id),
-			})
 		}
 
-		if err := extractError(results[1]); err != nil {
+		opts, err := query.ParseOptions(req.GetArguments())
+		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
+		fields := opts.Fields
+		if len(fields) == 0 {
+			if fields, err = viewFields(ops.resource, req.GetArguments()); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+		// Reject invalid fields before calling the controller. Without the
+		// resource type, unknown fields are only found in the result.
+		if len(fields) > 0 {
+			var valid []string
+			if ops.newType != nil {
+				valid = validFields(ops, nil)
+			}
+			if err := query.ValidateItemFields(fields, valid); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
 
-		data, err := json.MarshalIndent(results[0].Interface(), "", "  ")
+		result, err := ops.get(ctx, site, id)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		value, err := payload.FromGo(result)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
 		}
-		return mcp.NewToolResultText(string(data)), nil
+
+		if len(fields) > 0 {
+			if item, ok := value.Data.(map[string]any); ok {
+				projected, err := query.ProjectItem(item, fields, validFields(ops, item))
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				value.Data = projected
+			}
+		}
+		return payload.NewResult(ctx, value), nil
+	}
+}
+
+// validFields returns the top-level fields a get result can hold: those of
+// the resource type, which omits empty fields when encoded, and those of the
+// result itself.
+func validFields(ops resourceOps, item map[string]any) []string {
+	var keys map[string]struct{}
+	if ops.newType != nil {
+		keys = allowedFieldKeys(ops.newType())
+	} else {
+		keys = make(map[string]struct{}, len(item))
+	}
+	for key := range item {
+		keys[key] = struct{}{}
 	}
+	return slices.Collect(maps.Keys(keys))
 }
 
 // GenericCreate creates a handler that calls client.Create<Resource>(ctx, site, &input) via reflection.
 func GenericCreate(client any, resourceName string, newTypeFunc func() any) server.ToolHandlerFunc {
-	methodName := "Create" + resourceName
+	return createHandler(reflectOps(client, resourceName, newTypeFunc, false))
+}
 
+func createHandler(ops resourceOps) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		site := extractSite(req)
 
 		// Create new instance of the resource type
-		input := newTypeFunc()
+		input := ops.newType()
 
 		args := req.GetArguments()
-		allowedKeys := allowedFieldKeys(input)
-		allowedKeys["site"] = struct{}{}
-		allowedKeys["resolve"] = struct{}{}
+		allowedKeys := allowControlArgs(allowedFieldKeys(input), "idempotency_key", "match_existing")
 
 		if unexpected := unexpectedKeys(args, allowedKeys); len(unexpected) > 0 {
 			return mcp.NewToolResultError("unexpected parameters: " + strings.Join(unexpected, ", ")), nil
 		}
 
-		dataMap := make(map[string]any)
-		for key, value := range args {
-			if key == "site" || key == "resolve" {
-				continue
-			}
-			if _, ok := allowedKeys[key]; ok {
-				dataMap[key] = value
-			}
-		}
+		dataMap := fieldArgs(args)
 
 		if len(dataMap) == 0 {
 			return mcp.NewToolResultError("no fields provided"), nil
 		}
 
+		// Return an existing object with the same natural key instead of
+		// creating a duplicate.
+		if _, ok := args["match_existing"]; ok {
+			match, err := matchValues(args["match_existing"], dataMap, "match_existing")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			existing, err := findMatching(ctx, ops, site, match)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch len(existing) {
+			case 0:
+			case 1:
+				annotate.FromContext(ctx).Set(KeyMatchedExisting, true)
+				return newResult(ctx, existing[0]), nil
+			default:
+				return mcp.NewToolResultError(ambiguousMatchError(ops.resource, match, existing).Error()), nil
+			}
+		}
+
 		dataRaw, err := json.Marshal(dataMap)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to parse data: %v", err)), nil
@@ -152,61 +305,40 @@ func GenericCreate(client any, resourceName string, newTypeFunc func() any) serv
 			return mcp.NewToolResultError("invalid data: " + err.Error()), nil
 		}
 
-		clientVal := reflect.ValueOf(client)
-		method := clientVal.MethodByName(methodName)
-		if !method.IsValid() {
-			return mcp.NewToolResultError(fmt.Sprintf("method %s not found", methodName)), nil
+		if ops.create == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("method Create%s not found", ops.resource)), nil
 		}
-
-		results := method.Call([]reflect.Value{
-			reflect.ValueOf(ctx),
-			reflect.ValueOf(site),
-			reflect.ValueOf(input),
-		})
-
-		if err := extractError(results[1]); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
-		}
-
-		data, err := json.MarshalIndent(results[0].Interface(), "", "  ")
+		result, err := ops.create(ctx, site, input)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			return mcp.NewToolResultError(err.Error()), nil
 		}
-		return mcp.NewToolResultText(string(data)), nil
+		return newResult(ctx, result), nil
 	}
 }
 
 // GenericUpdate creates a handler that calls client.Update<Resource>(ctx, site, &input) via reflection.
 func GenericUpdate(client any, resourceName string, newTypeFunc func() any, isSetting bool) server.ToolHandlerFunc {
-	methodName := "Update" + resourceName
+	return updateHandler(reflectOps(client, resourceName, newTypeFunc, isSetting), isSetting)
+}
 
+func updateHandler(ops resourceOps, isSetting bool) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		site := extractSite(req)
 
 		// Create new instance of the resource type
-		input := newTypeFunc()
+		input := ops.newType()
 
 		args := req.GetArguments()
-		allowedKeys := allowedFieldKeys(input)
-		allowedKeys["site"] = struct{}{}
-		allowedKeys["resolve"] = struct{}{}
+		allowedKeys := allowControlArgs(allowedFieldKeys(input))
 		if !isSetting {
-			allowedKeys["id"] = struct{}{}
+			allowedKeys = allowControlArgs(allowedKeys, "id")
 		}
 
 		if unexpected := unexpectedKeys(args, allowedKeys); len(unexpected) > 0 {
 			return mcp.NewToolResultError("unexpected parameters: " + strings.Join(unexpected, ", ")), nil
 		}
 
-		dataMap := make(map[string]any)
-		for key, value := range args {
-			if key == "site" || key == "id" || key == "resolve" {
-				continue
-			}
-			if _, ok := allowedKeys[key]; ok {
-				dataMap[key] = value
-			}
-		}
+		dataMap := fieldArgs(args)
 
 		if len(dataMap) == 0 {
 			return mcp.NewToolResultError("no fields provided"), nil
@@ -221,24 +353,17 @@ func GenericUpdate(client any, resourceName string, newTypeFunc func() any, isSe
 			}
 		}
 
-		clientVal := reflect.ValueOf(client)
-		getMethod := clientVal.MethodByName("Get" + resourceName)
-		if !getMethod.IsValid() {
-			return mcp.NewToolResultError("missing client method: Get" + resourceName + " (required for updates)"), nil
-		}
-
-		getArgs := []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(site)}
-		if !isSetting {
-			getArgs = append(getArgs, reflect.ValueOf(id))
+		if ops.get == nil {
+			return mcp.NewToolResultError("missing client method: Get" + ops.resource + " (required for updates)"), nil
 		}
-		getResults := getMethod.Call(getArgs)
-		if err := extractError(getResults[1]); err != nil {
+		existing, err := ops.get(ctx, site, id)
+		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
-		if isNilValue(getResults[0]) {
+		if existing == nil {
 			return mcp.NewToolResultError("failed to fetch existing resource"), nil
 		}
-		existingRaw, err := json.Marshal(getResults[0].Interface())
+		existingRaw, err := json.Marshal(existing)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("failed to parse existing resource: %v", err)), nil
 		}
@@ -265,33 +390,101 @@ func GenericUpdate(client any, resourceName string, newTypeFunc func() any, isSe
 			return mcp.NewToolResultError("invalid data: " + err.Error()), nil
 		}
 
-		method := clientVal.MethodByName(methodName)
-		if !method.IsValid() {
-			return mcp.NewToolResultError(fmt.Sprintf("method %s not found", methodName)), nil
+		if ops.update == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("method Update%s not found", ops.resource)), nil
+		}
+		result, err := ops.update(ctx, site, input)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return newResult(ctx, result), nil
+	}
+}
+
+// KeyUpsertAction is the annotation reporting what an upsert did: "created"
+// or "updated".
+const KeyUpsertAction = "action"
+
+// GenericUpsert creates a handler that finds a resource by the match_on fields
+// (default: name) through client.List<Resource> and then updates it via
+// GenericUpdate, or creates it via GenericCreate when nothing matches.
+func GenericUpsert(client any, resourceName string, newTypeFunc func() any) server.ToolHandlerFunc {
+	return upsertHandler(reflectOps(client, resourceName, newTypeFunc, false))
+}
+
+func upsertHandler(ops resourceOps) server.ToolHandlerFunc {
+	create := createHandler(ops)
+	update := updateHandler(ops, false)
+
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		site := extractSite(req)
+
+		args := req.GetArguments()
+		allowedKeys := allowControlArgs(allowedFieldKeys(ops.newType()), "match_on")
+
+		if unexpected := unexpectedKeys(args, allowedKeys); len(unexpected) > 0 {
+			return mcp.NewToolResultError("unexpected parameters: " + strings.Join(unexpected, ", ")), nil
 		}
 
-		results := method.Call([]reflect.Value{
-			reflect.ValueOf(ctx),
-			reflect.ValueOf(site),
-			reflect.ValueOf(input),
-		})
+		// Everything except match_on is passed through to create or update.
+		innerArgs := maps.Clone(args)
+		delete(innerArgs, "match_on")
+		dataMap := fieldArgs(args)
+		if len(dataMap) == 0 {
+			return mcp.NewToolResultError("no fields provided"), nil
+		}
 
-		if err := extractError(results[1]); err != nil {
+		matchOn, ok := args["match_on"]
+		if !ok {
+			if _, hasName := allowedKeys["name"]; !hasName {
+				return mcp.NewToolResultError(fmt.Sprintf("match_on is required: %s has no name field", ops.resource)), nil
+			}
+			matchOn = []any{"name"}
+		}
+		match, err := matchValues(matchOn, dataMap, "match_on")
+		if err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
 
-		data, err := json.MarshalIndent(results[0].Interface(), "", "  ")
+		existing, err := findMatching(ctx, ops, site, match)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		innerReq := mcp.CallToolRequest{}
+		innerReq.Params.Name = req.Params.Name
+		innerReq.Params.Arguments = innerArgs
+
+		var action string
+		var result *mcp.CallToolResult
+		switch len(existing) {
+		case 0:
+			action = "created"
+			result, err = create(ctx, innerReq)
+		case 1:
+			id, _ := existing[0]["_id"].(string)
+			if id == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("matching %s has no ID", ops.resource)), nil
+			}
+			innerArgs["id"] = id
+			action = "updated"
+			result, err = update(ctx, innerReq)
+		default:
+			return mcp.NewToolResultError(ambiguousMatchError(ops.resource, match, existing).Error()), nil
+		}
+		if err == nil && result != nil && !result.IsError {
+			annotate.FromContext(ctx).Set(KeyUpsertAction, action)
 		}
-		return mcp.NewToolResultText(string(data)), nil
+		return result, err
 	}
 }
 
 // GenericDelete creates a handler that calls client.Delete<Resource>(ctx, site, id) via reflection.
 func GenericDelete(client any, resourceName string) server.ToolHandlerFunc {
-	methodName := "Delete" + resourceName
+	return deleteHandler(reflectOps(client, resourceName, nil, false))
+}
 
+func deleteHandler(ops resourceOps) server.ToolHandlerFunc {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		site := extractSite(req)
 		id, ok := req.GetArguments()["id"].(string)
@@ -299,19 +492,10 @@ func GenericDelete(client any, resourceName string) server.ToolHandlerFunc {
 			return mcp.NewToolResultError("required parameter 'id' is missing or invalid"), nil
 		}
 
-		clientVal := reflect.ValueOf(client)
-		method := clientVal.MethodByName(methodName)
-		if !method.IsValid() {
-			return mcp.NewToolResultError(fmt.Sprintf("method %s not found", methodName)), nil
+		if ops.delete == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("method Delete%s not found", ops.resource)), nil
 		}
-
-		results := method.Call([]reflect.Value{
-			reflect.ValueOf(ctx),
-			reflect.ValueOf(site),
-			reflect.ValueOf(id),
-		})
-
-		if err := extractError(results[0]); err != nil {
+		if err := ops.delete(ctx, site, id); err != nil {
 			return mcp.NewToolResultError(err.Error()), nil
 		}
 
@@ -319,6 +503,142 @@ func GenericDelete(client any, resourceName string) server.ToolHandlerFunc {
 	}
 }
 
+// KeyMatchedExisting is the annotation set when a create call returns an
+// existing object found through match_existing instead of creating one.
+const KeyMatchedExisting = "matched_existing"
+
+// matchValues builds the natural key for a match_existing style argument: the
+// listed field names mapped to their values in data.
+func matchValues(fieldsArg any, data map[string]any, argName string) (map[string]any, error) {
+	fields, ok := fieldsArg.([]any)
+	if !ok || len(fields) == 0 {
+		return nil, fmt.Errorf("%s must be a non-empty array of field names", argName)
+	}
+	match := make(map[string]any, len(fields))
+	for _, f := range fields {
+		name, ok := f.(string)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%s must be a non-empty array of field names", argName)
+		}
+		value, ok := data[name]
+		if !ok {
+			return nil, fmt.Errorf("%s field %q must also be provided as a parameter", argName, name)
+		}
+		match[name] = value
+	}
+	return match, nil
+}
+
+// findMatching lists all resources of ops.resource and returns those whose
+// fields equal every value in match, compared in their JSON form.
+func findMatching(ctx context.Context, ops resourceOps, site string, match map[string]any) ([]map[string]any, error) {
+	if ops.list == nil {
+		return nil, fmt.Errorf("missing client method: List%s (required for matching existing objects)", ops.resource)
+	}
+	list, err := ops.list(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process existing objects: %w", err)
+	}
+	var items []map[string]any
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("failed to process existing objects: %w", err)
+	}
+	wantRaw, err := json.Marshal(match)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process match values: %w", err)
+	}
+	var want map[string]any
+	if err := json.Unmarshal(wantRaw, &want); err != nil {
+		return nil, fmt.Errorf("failed to process match values: %w", err)
+	}
+
+	var matches []map[string]any
+	for _, item := range items {
+		if matchesAll(item, want) {
+			matches = append(matches, item)
+		}
+	}
+	return matches, nil
+}
+
+func matchesAll(item, want map[string]any) bool {
+	for key, value := range want {
+		if !reflect.DeepEqual(item[key], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// ambiguousMatchError reports that more than one existing object matched.
+func ambiguousMatchError(resourceName string, match map[string]any, matches []map[string]any) error {
+	fields := make([]string, 0, len(match))
+	for field := range match {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	ids := make([]string, 0, len(matches))
+	for _, m := range matches {
+		id, _ := m["_id"].(string)
+		ids = append(ids, id)
+	}
+	return fmt.Errorf("%d existing %s objects match on %s: %s",
+		len(matches), resourceName, strings.Join(fields, ", "), strings.Join(ids, ", "))
+}
+
+// newResult converts a client result to a tool result, structured when the
+// caller renders it (see payload.Render) and indented JSON text otherwise.
+func newResult(ctx context.Context, v any) *mcp.CallToolResult {
+	value, err := payload.FromGo(v)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err))
+	}
+	return payload.NewResult(ctx, value)
+}
+
+// controlArgs are the arguments of create, update and upsert tools that steer
+// the handler or its middleware instead of setting fields of the object. They
+// are never sent to the controller. A new argument added to the write tools
+// must be listed here.
+var controlArgs = map[string]struct{}{
+	"site":            {},
+	"id":              {},
+	"resolve":         {},
+	"humanize":        {},
+	"idempotency_key": {},
+	"match_existing":  {},
+	"match_on":        {},
+}
+
+// commonControlArgs are the control arguments every write tool accepts.
+var commonControlArgs = []string{"site", "resolve", "humanize"}
+
+// allowControlArgs adds the common control arguments and the given ones to
+// the allowed keys of a write tool.
+func allowControlArgs(allowed map[string]struct{}, extra ...string) map[string]struct{} {
+	for _, key := range slices.Concat(commonControlArgs, extra) {
+		allowed[key] = struct{}{}
+	}
+	return allowed
+}
+
+// fieldArgs returns the arguments that set fields of the object, leaving out
+// the control arguments.
+func fieldArgs(args map[string]any) map[string]any {
+	data := make(map[string]any, len(args))
+	for key, value := range args {
+		if _, ok := controlArgs[key]; !ok {
+			data[key] = value
+		}
+	}
+	return data
+}
+
 // extractSite extracts the site parameter from the request, defaulting to "default".
 func extractSite(req mcp.CallToolRequest) string {
 	site, _ := req.GetArguments()["site"].(string)
@@ -436,6 +756,17 @@ func ValidateClientMethods(client any, tools []ToolMetadata, typeRegistry map[st
 			if _, ok := typeRegistry[meta.Resource]; !ok {
 				return fmt.Errorf("missing type in registry for resource %s (tool %s)", meta.Resource, meta.Name)
 			}
+		case "upsert":
+			methodName = "List" + meta.Resource
+			// Upserts also create and update, which need the resource type
+			if _, ok := typeRegistry[meta.Resource]; !ok {
+				return fmt.Errorf("missing type in registry for resource %s (tool %s)", meta.Resource, meta.Name)
+			}
+			for _, name := range []string{"Get", "Create", "Update"} {
+				if !clientVal.MethodByName(name + meta.Resource).IsValid() {
+					return fmt.Errorf("missing client method: %s%s (for tool %s)", name, meta.Resource, meta.Name)
+				}
+			}
 		case "delete":
 			methodName = "Delete" + meta.Resource
 		default:
@@ -451,7 +782,7 @@ func ValidateClientMethods(client any, tools []ToolMetadata, typeRegistry map[st
 		methodType := method.Type()
 		var expectedIn, expectedOut int
 		switch meta.Category {
-		case "list":
+		case "list", "upsert":
 			expectedIn, expectedOut = 2, 2 // (ctx, site) -> (result, error)
 		case "get":
 			if meta.IsSetting {
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -412,10 +414,11 @@ func TestGenericCreate_UnexpectedParameters(t *testing.T) {
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]any{
-		"site":  "default",
-		"name":  "value",
-		"data":  map[string]any{"name": "ignored"},
-		"extra": true,
+		"site":     "default",
+		"name":     "value",
+		"data":     map[string]any{"name": "ignored"},
+		"extra":    true,
+		"humanize": true,
 	}
 
 	result, err := handler(context.Background(), req)
@@ -427,6 +430,7 @@ func TestGenericCreate_UnexpectedParameters(t *testing.T) {
 	assert.Contains(t, content.Text, "unexpected parameters")
 	assert.Contains(t, content.Text, "data")
 	assert.Contains(t, content.Text, "extra")
+	assert.NotContains(t, content.Text, "humanize")
 }
 
 func TestGenericUpdate_UnexpectedParameters(t *testing.T) {
@@ -439,11 +443,12 @@ func TestGenericUpdate_UnexpectedParameters(t *testing.T) {
 
 	req := mcp.CallToolRequest{}
 	req.Params.Arguments = map[string]any{
-		"site":  "default",
-		"id":    "123",
-		"name":  "value",
-		"data":  map[string]any{"name": "ignored"},
-		"extra": true,
+		"site":     "default",
+		"id":       "123",
+		"name":     "value",
+		"data":     map[string]any{"name": "ignored"},
+		"extra":    true,
+		"humanize": true,
 	}
 
 	result, err := handler(context.Background(), req)
@@ -455,6 +460,34 @@ func TestGenericUpdate_UnexpectedParameters(t *testing.T) {
 	assert.Contains(t, content.Text, "unexpected parameters")
 	assert.Contains(t, content.Text, "data")
 	assert.Contains(t, content.Text, "extra")
+	assert.NotContains(t, content.Text, "humanize")
+}
+
+func TestControlArgs_CoverWriteToolSchemas(t *testing.T) {
+	// Any argument of a write tool that isn't a field of the resource must be
+	// a control argument, or it would be sent to the controller.
+	for _, meta := range AllToolMetadata {
+		switch meta.Category {
+		case "create", "update", "upsert":
+		default:
+			continue
+		}
+		newType, ok := TypeRegistry[meta.Resource]
+		require.True(t, ok, meta.Resource)
+		fields := allowedFieldKeys(newType())
+		props, _ := meta.InputSchema["properties"].(map[string]any)
+		for arg := range props {
+			_, isField := fields[arg]
+			_, isName := meta.NameInputs[arg]
+			_, isControl := controlArgs[arg]
+			assert.True(t, isField || isName || isControl, "%s: %s is neither a field nor a control argument", meta.Name, arg)
+		}
+	}
+}
+
+func TestFieldArgs(t *testing.T) {
+	args := map[string]any{"site": "default", "id": "1", "name": "a", "idempotency_key": "k", "match_on": []any{"name"}}
+	assert.Equal(t, map[string]any{"name": "a"}, fieldArgs(args))
 }
 
 type testListItem struct {
@@ -698,6 +731,115 @@ func TestGenericCreate_WithFakeClient_Error(t *testing.T) {
 	assert.Contains(t, content.Text, "create error")
 }
 
+func newMatchCreateHandler(client any) server.ToolHandlerFunc {
+	return GenericCreate(client, "Test", func() any {
+		return &testListItem{}
+	})
+}
+
+func TestGenericCreate_MatchExisting(t *testing.T) {
+	handler := newMatchCreateHandler(&FakeTestClient{})
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"name":           "switch-1",
+		"ip":             "10.0.0.99",
+		"match_existing": []any{"name"},
+	}
+
+	result, err := handler(ctx, req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	content := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, content.Text, "10.0.0.1", "existing object should be returned unchanged")
+	assert.NotContains(t, content.Text, "created")
+	matched, _ := annotations.Get(KeyMatchedExisting)
+	assert.Equal(t, true, matched)
+}
+
+func TestGenericCreate_MatchExistingNoMatchCreates(t *testing.T) {
+	handler := newMatchCreateHandler(&FakeTestClient{})
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"name":           "switch-1",
+		"type":           "uap",
+		"match_existing": []any{"name", "type"},
+	}
+
+	result, err := handler(ctx, req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "created")
+	assert.Equal(t, 0, annotations.Len())
+}
+
+func TestGenericCreate_MatchExistingErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  any
+		args    map[string]any
+		wantErr string
+	}{
+		{
+			name:    "ambiguous",
+			client:  &FakeTestClient{},
+			args:    map[string]any{"name": "new", "type": "uap", "match_existing": []any{"type"}},
+			wantErr: "2 existing Test objects match on type",
+		},
+		{
+			name:    "field not provided",
+			client:  &FakeTestClient{},
+			args:    map[string]any{"name": "new", "match_existing": []any{"ip"}},
+			wantErr: `match_existing field "ip" must also be provided`,
+		},
+		{
+			name:    "not an array",
+			client:  &FakeTestClient{},
+			args:    map[string]any{"name": "new", "match_existing": "name"},
+			wantErr: "match_existing must be a non-empty array",
+		},
+		{
+			name:    "empty array",
+			client:  &FakeTestClient{},
+			args:    map[string]any{"name": "new", "match_existing": []any{}},
+			wantErr: "match_existing must be a non-empty array",
+		},
+		{
+			name:    "list error",
+			client:  &FakeTestClient{ShouldError: true},
+			args:    map[string]any{"name": "new", "match_existing": []any{"name"}},
+			wantErr: "list error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = tt.args
+
+			result, err := newMatchCreateHandler(tt.client)(context.Background(), req)
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.wantErr)
+		})
+	}
+}
+
+func TestGenericCreate_AcceptsIdempotencyKey(t *testing.T) {
+	handler := newMatchCreateHandler(&FakeTestClient{})
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"name": "new", "idempotency_key": "abc"}
+
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+}
+
 func TestGenericUpdate_WithFakeClient(t *testing.T) {
 	client := &FakeTestClient{}
 	handler := GenericUpdate(client, "Test", func() any {
@@ -821,6 +963,86 @@ func TestGenericList_WithFilter(t *testing.T) {
 	assert.NotContains(t, content.Text, "amazon-echo")
 }
 
+func TestGenericList_InvalidFilter(t *testing.T) {
+	handler := GenericList(&FakeTestClient{}, "Test")
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"filter": map[string]any{"name": map[string]any{"regex": "[unclosed"}},
+	}
+
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `invalid filter for field "name": invalid regex`)
+}
+
+func TestGenericList_Aggregation(t *testing.T) {
+	handler := GenericList(&FakeTestClient{}, "Test")
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"group_by": "type"}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	text := result.Content[0].(mcp.TextContent).Text
+	assert.Contains(t, text, `"type": "uap"`)
+	assert.Contains(t, text, `"count": 2`)
+	assert.NotContains(t, text, "switch-1")
+
+	req.Params.Arguments = map[string]any{"facets": []any{"type"}}
+	result, err = handler(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"type": [`)
+
+	req.Params.Arguments = map[string]any{"facets": []any{"type"}, "limit": float64(1)}
+	result, err = handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestGenericList_Paginated(t *testing.T) {
+	handler := GenericList(&FakeTestClient{}, "Test")
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"sort":  []any{"-name"},
+		"limit": float64(2),
+	}
+
+	result, err := handler(ctx, req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	content := result.Content[0].(mcp.TextContent)
+	assert.Contains(t, content.Text, "switch-1")
+	assert.Contains(t, content.Text, "ap-living-room")
+	assert.NotContains(t, content.Text, "amazon-echo")
+
+	total, _ := annotations.Get(KeyTotal)
+	returned, _ := annotations.Get(KeyReturned)
+	next, _ := annotations.Get(KeyNextOffset)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, 2, returned)
+	require.NotNil(t, next)
+	assert.Equal(t, 2, *next.(*int))
+}
+
+func TestGenericList_UnpaginatedHasNoPageAnnotations(t *testing.T) {
+	handler := GenericList(&FakeTestClient{}, "Test")
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"sort": "name"}
+
+	result, err := handler(ctx, req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, 0, annotations.Len())
+}
+
 func TestGenericList_WithContainsFilter(t *testing.T) {
 	client := &FakeTestClient{}
 	handler := GenericList(client, "Test")
@@ -928,3 +1150,153 @@ func TestGenericList_WithCombinedQuery(t *testing.T) {
 	assert.NotContains(t, content.Text, "switch-1")
 	assert.NotContains(t, content.Text, "ap-living-room")
 }
+
+// upsertTestClient stores mergeTestResources in memory.
+type upsertTestClient struct {
+	items   []mergeTestResource
+	created []mergeTestResource
+	updated []mergeTestResource
+}
+
+func (c *upsertTestClient) ListTest(_ context.Context, _ string) ([]mergeTestResource, error) {
+	return c.items, nil
+}
+
+func (c *upsertTestClient) GetTest(_ context.Context, _, id string) (*mergeTestResource, error) {
+	for _, item := range c.items {
+		if item.ID == id {
+			return &item, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (c *upsertTestClient) CreateTest(_ context.Context, _ string, input *mergeTestResource) (*mergeTestResource, error) {
+	input.ID = "new"
+	c.created = append(c.created, *input)
+	return input, nil
+}
+
+func (c *upsertTestClient) UpdateTest(_ context.Context, _ string, input *mergeTestResource) (*mergeTestResource, error) {
+	c.updated = append(c.updated, *input)
+	return input, nil
+}
+
+func newUpsertHandler(client any) server.ToolHandlerFunc {
+	return GenericUpsert(client, "Test", func() any { return &mergeTestResource{} })
+}
+
+func TestGenericUpsert_Creates(t *testing.T) {
+	client := &upsertTestClient{items: []mergeTestResource{{ID: "1", Name: "other"}}}
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"name": "nas", "enabled": true}
+
+	result, err := newUpsertHandler(client)(ctx, req)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	require.Len(t, client.created, 1)
+	assert.Equal(t, "nas", client.created[0].Name)
+	assert.Empty(t, client.updated)
+
+	action, _ := annotations.Get(KeyUpsertAction)
+	assert.Equal(t, "created", action)
+}
+
+func TestGenericUpsert_UpdatesSingleMatch(t *testing.T) {
+	client := &upsertTestClient{items: []mergeTestResource{
+		{ID: "1", Name: "nas", Enabled: false},
+		{ID: "2", Name: "printer"},
+	}}
+	ctx, annotations := annotate.NewContext(context.Background())
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"name": "nas", "enabled": true}
+
+	result, err := newUpsertHandler(client)(ctx, req)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	assert.Empty(t, client.created)
+	require.Len(t, client.updated, 1)
+	assert.Equal(t, mergeTestResource{ID: "1", Name: "nas", Enabled: true}, client.updated[0])
+
+	action, _ := annotations.Get(KeyUpsertAction)
+	assert.Equal(t, "updated", action)
+}
+
+func TestGenericUpsert_CustomMatchOn(t *testing.T) {
+	client := &upsertTestClient{items: []mergeTestResource{{ID: "1", Name: "nas", Enabled: true}}}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"name": "renamed", "enabled": true, "match_on": []any{"enabled"}}
+
+	result, err := newUpsertHandler(client)(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, client.updated, 1)
+	assert.Equal(t, "1", client.updated[0].ID)
+	assert.Equal(t, "renamed", client.updated[0].Name)
+}
+
+func TestGenericUpsert_Errors(t *testing.T) {
+	duplicates := []mergeTestResource{{ID: "1", Name: "nas"}, {ID: "2", Name: "nas"}}
+	tests := []struct {
+		name    string
+		items   []mergeTestResource
+		args    map[string]any
+		wantErr string
+	}{
+		{"ambiguous", duplicates, map[string]any{"name": "nas"}, "2 existing Test objects match on name: 1, 2"},
+		{"match field missing", nil, map[string]any{"enabled": true}, `match_on field "name" must also be provided`},
+		{"no fields", nil, map[string]any{"site": "default"}, "no fields provided"},
+		{"unexpected", nil, map[string]any{"name": "nas", "id": "1"}, "unexpected parameters: id"},
+		{"bad match_on", nil, map[string]any{"name": "nas", "match_on": []any{42}}, "match_on must be a non-empty array"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &upsertTestClient{items: tt.items}
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = tt.args
+
+			result, err := newUpsertHandler(client)(context.Background(), req)
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.wantErr)
+			assert.Empty(t, client.created)
+			assert.Empty(t, client.updated)
+		})
+	}
+}
+
+func TestGenericUpsert_MatchOnRequiredWithoutName(t *testing.T) {
+	type keyed struct {
+		ID  string `json:"_id"`
+		Key string `json:"key"`
+	}
+	handler := GenericUpsert(&upsertTestClient{}, "Test", func() any { return &keyed{} })
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"key": "nas.lan"}
+
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "match_on is required: Test has no name field")
+}
+
+func TestValidateClientMethods_Upsert(t *testing.T) {
+	tools := []ToolMetadata{{Name: "upsert_test", Category: "upsert", Resource: "Test"}}
+	registry := map[string]func() any{"Test": func() any { return &mergeTestResource{} }}
+
+	require.NoError(t, ValidateClientMethods(&upsertTestClient{}, tools, registry))
+
+	err := ValidateClientMethods(&mergeUpdateClient{}, tools, registry)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing client method")
+
+	err = ValidateClientMethods(&upsertTestClient{}, tools, map[string]func() any{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing type in registry")
+}
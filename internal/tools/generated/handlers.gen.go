@@ -11,734 +11,831 @@ import (
 type HandlerFunc func(client unifi.Client) server.ToolHandlerFunc
 
 // GetHandlerRegistry returns tool handlers keyed by name.
-// Handlers use reflection to call client methods dynamically.
+// Handlers call the client methods directly through method expressions, so
+// a change to a go-unifi method signature fails the build.
 func GetHandlerRegistry() map[string]HandlerFunc {
 	return map[string]HandlerFunc{
 		"list_ap_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "APGroup")
+			return TypedList(client, unifi.Client.ListAPGroup)
 		},
 		"get_ap_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "APGroup", false)
+			return TypedGet(client, unifi.Client.GetAPGroup)
 		},
 		"create_ap_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "APGroup", TypeRegistry["APGroup"])
+			return TypedCreate(client, unifi.Client.CreateAPGroup, unifi.Client.ListAPGroup)
 		},
 		"update_ap_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "APGroup", TypeRegistry["APGroup"], false)
+			return TypedUpdate(client, unifi.Client.GetAPGroup, unifi.Client.UpdateAPGroup)
+		},
+		"upsert_ap_group": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListAPGroup, unifi.Client.GetAPGroup, unifi.Client.CreateAPGroup, unifi.Client.UpdateAPGroup)
 		},
 		"delete_ap_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "APGroup")
+			return TypedDelete(client, unifi.Client.DeleteAPGroup)
 		},
 		"list_account": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "Account")
+			return TypedList(client, unifi.Client.ListAccount)
 		},
 		"get_account": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "Account", false)
+			return TypedGet(client, unifi.Client.GetAccount)
 		},
 		"create_account": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "Account", TypeRegistry["Account"])
+			return TypedCreate(client, unifi.Client.CreateAccount, unifi.Client.ListAccount)
 		},
 		"update_account": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "Account", TypeRegistry["Account"], false)
+			return TypedUpdate(client, unifi.Client.GetAccount, unifi.Client.UpdateAccount)
+		},
+		"upsert_account": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListAccount, unifi.Client.GetAccount, unifi.Client.CreateAccount, unifi.Client.UpdateAccount)
 		},
 		"delete_account": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "Account")
+			return TypedDelete(client, unifi.Client.DeleteAccount)
 		},
 		"list_broadcast_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "BroadcastGroup")
+			return TypedList(client, unifi.Client.ListBroadcastGroup)
 		},
 		"get_broadcast_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "BroadcastGroup", false)
+			return TypedGet(client, unifi.Client.GetBroadcastGroup)
 		},
 		"create_broadcast_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "BroadcastGroup", TypeRegistry["BroadcastGroup"])
+			return TypedCreate(client, unifi.Client.CreateBroadcastGroup, unifi.Client.ListBroadcastGroup)
 		},
 		"update_broadcast_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "BroadcastGroup", TypeRegistry["BroadcastGroup"], false)
+			return TypedUpdate(client, unifi.Client.GetBroadcastGroup, unifi.Client.UpdateBroadcastGroup)
+		},
+		"upsert_broadcast_group": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListBroadcastGroup, unifi.Client.GetBroadcastGroup, unifi.Client.CreateBroadcastGroup, unifi.Client.UpdateBroadcastGroup)
 		},
 		"delete_broadcast_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "BroadcastGroup")
+			return TypedDelete(client, unifi.Client.DeleteBroadcastGroup)
 		},
 		"list_channel_plan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "ChannelPlan")
+			return TypedList(client, unifi.Client.ListChannelPlan)
 		},
 		"get_channel_plan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "ChannelPlan", false)
+			return TypedGet(client, unifi.Client.GetChannelPlan)
 		},
 		"create_channel_plan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "ChannelPlan", TypeRegistry["ChannelPlan"])
+			return TypedCreate(client, unifi.Client.CreateChannelPlan, unifi.Client.ListChannelPlan)
 		},
 		"update_channel_plan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "ChannelPlan", TypeRegistry["ChannelPlan"], false)
+			return TypedUpdate(client, unifi.Client.GetChannelPlan, unifi.Client.UpdateChannelPlan)
+		},
+		"upsert_channel_plan": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListChannelPlan, unifi.Client.GetChannelPlan, unifi.Client.CreateChannelPlan, unifi.Client.UpdateChannelPlan)
 		},
 		"delete_channel_plan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "ChannelPlan")
+			return TypedDelete(client, unifi.Client.DeleteChannelPlan)
 		},
 		"list_dhcp_option": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "DHCPOption")
+			return TypedList(client, unifi.Client.ListDHCPOption)
 		},
 		"get_dhcp_option": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "DHCPOption", false)
+			return TypedGet(client, unifi.Client.GetDHCPOption)
 		},
 		"create_dhcp_option": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "DHCPOption", TypeRegistry["DHCPOption"])
+			return TypedCreate(client, unifi.Client.CreateDHCPOption, unifi.Client.ListDHCPOption)
 		},
 		"update_dhcp_option": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "DHCPOption", TypeRegistry["DHCPOption"], false)
+			return TypedUpdate(client, unifi.Client.GetDHCPOption, unifi.Client.UpdateDHCPOption)
+		},
+		"upsert_dhcp_option": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListDHCPOption, unifi.Client.GetDHCPOption, unifi.Client.CreateDHCPOption, unifi.Client.UpdateDHCPOption)
 		},
 		"delete_dhcp_option": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "DHCPOption")
+			return TypedDelete(client, unifi.Client.DeleteDHCPOption)
 		},
 		"list_dns_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "DNSRecord")
+			return TypedList(client, unifi.Client.ListDNSRecord)
 		},
 		"get_dns_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "DNSRecord", false)
+			return TypedGet(client, unifi.Client.GetDNSRecord)
 		},
 		"create_dns_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "DNSRecord", TypeRegistry["DNSRecord"])
+			return TypedCreate(client, unifi.Client.CreateDNSRecord, unifi.Client.ListDNSRecord)
 		},
 		"update_dns_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "DNSRecord", TypeRegistry["DNSRecord"], false)
+			return TypedUpdate(client, unifi.Client.GetDNSRecord, unifi.Client.UpdateDNSRecord)
+		},
+		"upsert_dns_record": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListDNSRecord, unifi.Client.GetDNSRecord, unifi.Client.CreateDNSRecord, unifi.Client.UpdateDNSRecord)
 		},
 		"delete_dns_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "DNSRecord")
+			return TypedDelete(client, unifi.Client.DeleteDNSRecord)
 		},
 		"list_dashboard": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "Dashboard")
+			return TypedList(client, unifi.Client.ListDashboard)
 		},
 		"get_dashboard": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "Dashboard", false)
+			return TypedGet(client, unifi.Client.GetDashboard)
 		},
 		"create_dashboard": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "Dashboard", TypeRegistry["Dashboard"])
+			return TypedCreate(client, unifi.Client.CreateDashboard, unifi.Client.ListDashboard)
 		},
 		"update_dashboard": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "Dashboard", TypeRegistry["Dashboard"], false)
+			return TypedUpdate(client, unifi.Client.GetDashboard, unifi.Client.UpdateDashboard)
+		},
+		"upsert_dashboard": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListDashboard, unifi.Client.GetDashboard, unifi.Client.CreateDashboard, unifi.Client.UpdateDashboard)
 		},
 		"delete_dashboard": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "Dashboard")
+			return TypedDelete(client, unifi.Client.DeleteDashboard)
 		},
 		"list_device": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "Device")
+			return TypedList(client, unifi.Client.ListDevice)
 		},
 		"get_device": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "Device", false)
+			return TypedGet(client, unifi.Client.GetDevice)
 		},
 		"list_dynamic_dns": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "DynamicDNS")
+			return TypedList(client, unifi.Client.ListDynamicDNS)
 		},
 		"get_dynamic_dns": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "DynamicDNS", false)
+			return TypedGet(client, unifi.Client.GetDynamicDNS)
 		},
 		"create_dynamic_dns": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "DynamicDNS", TypeRegistry["DynamicDNS"])
+			return TypedCreate(client, unifi.Client.CreateDynamicDNS, unifi.Client.ListDynamicDNS)
 		},
 		"update_dynamic_dns": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "DynamicDNS", TypeRegistry["DynamicDNS"], false)
+			return TypedUpdate(client, unifi.Client.GetDynamicDNS, unifi.Client.UpdateDynamicDNS)
+		},
+		"upsert_dynamic_dns": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListDynamicDNS, unifi.Client.GetDynamicDNS, unifi.Client.CreateDynamicDNS, unifi.Client.UpdateDynamicDNS)
 		},
 		"delete_dynamic_dns": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "DynamicDNS")
+			return TypedDelete(client, unifi.Client.DeleteDynamicDNS)
 		},
 		"list_firewall_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "FirewallGroup")
+			return TypedList(client, unifi.Client.ListFirewallGroup)
 		},
 		"get_firewall_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "FirewallGroup", false)
+			return TypedGet(client, unifi.Client.GetFirewallGroup)
 		},
 		"create_firewall_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "FirewallGroup", TypeRegistry["FirewallGroup"])
+			return TypedCreate(client, unifi.Client.CreateFirewallGroup, unifi.Client.ListFirewallGroup)
 		},
 		"update_firewall_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "FirewallGroup", TypeRegistry["FirewallGroup"], false)
+			return TypedUpdate(client, unifi.Client.GetFirewallGroup, unifi.Client.UpdateFirewallGroup)
+		},
+		"upsert_firewall_group": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListFirewallGroup, unifi.Client.GetFirewallGroup, unifi.Client.CreateFirewallGroup, unifi.Client.UpdateFirewallGroup)
 		},
 		"delete_firewall_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "FirewallGroup")
+			return TypedDelete(client, unifi.Client.DeleteFirewallGroup)
 		},
 		"list_firewall_rule": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "FirewallRule")
+			return TypedList(client, unifi.Client.ListFirewallRule)
 		},
 		"get_firewall_rule": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "FirewallRule", false)
+			return TypedGet(client, unifi.Client.GetFirewallRule)
 		},
 		"create_firewall_rule": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "FirewallRule", TypeRegistry["FirewallRule"])
+			return TypedCreate(client, unifi.Client.CreateFirewallRule, unifi.Client.ListFirewallRule)
 		},
 		"update_firewall_rule": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "FirewallRule", TypeRegistry["FirewallRule"], false)
+			return TypedUpdate(client, unifi.Client.GetFirewallRule, unifi.Client.UpdateFirewallRule)
+		},
+		"upsert_firewall_rule": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListFirewallRule, unifi.Client.GetFirewallRule, unifi.Client.CreateFirewallRule, unifi.Client.UpdateFirewallRule)
 		},
 		"delete_firewall_rule": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "FirewallRule")
+			return TypedDelete(client, unifi.Client.DeleteFirewallRule)
 		},
 		"list_firewall_zone": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "FirewallZone")
+			return TypedList(client, unifi.Client.ListFirewallZone)
 		},
 		"get_firewall_zone": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "FirewallZone", false)
+			return TypedGet(client, unifi.Client.GetFirewallZone)
 		},
 		"create_firewall_zone": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "FirewallZone", TypeRegistry["FirewallZone"])
+			return TypedCreate(client, unifi.Client.CreateFirewallZone, unifi.Client.ListFirewallZone)
 		},
 		"update_firewall_zone": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "FirewallZone", TypeRegistry["FirewallZone"], false)
+			return TypedUpdate(client, unifi.Client.GetFirewallZone, unifi.Client.UpdateFirewallZone)
+		},
+		"upsert_firewall_zone": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListFirewallZone, unifi.Client.GetFirewallZone, unifi.Client.CreateFirewallZone, unifi.Client.UpdateFirewallZone)
 		},
 		"delete_firewall_zone": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "FirewallZone")
+			return TypedDelete(client, unifi.Client.DeleteFirewallZone)
 		},
 		"list_firewall_zone_policy": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "FirewallZonePolicy")
+			return TypedList(client, unifi.Client.ListFirewallZonePolicy)
 		},
 		"get_firewall_zone_policy": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "FirewallZonePolicy", false)
+			return TypedGet(client, unifi.Client.GetFirewallZonePolicy)
 		},
 		"create_firewall_zone_policy": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "FirewallZonePolicy", TypeRegistry["FirewallZonePolicy"])
+			return TypedCreate(client, unifi.Client.CreateFirewallZonePolicy, unifi.Client.ListFirewallZonePolicy)
 		},
 		"update_firewall_zone_policy": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "FirewallZonePolicy", TypeRegistry["FirewallZonePolicy"], false)
+			return TypedUpdate(client, unifi.Client.GetFirewallZonePolicy, unifi.Client.UpdateFirewallZonePolicy)
+		},
+		"upsert_firewall_zone_policy": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListFirewallZonePolicy, unifi.Client.GetFirewallZonePolicy, unifi.Client.CreateFirewallZonePolicy, unifi.Client.UpdateFirewallZonePolicy)
 		},
 		"delete_firewall_zone_policy": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "FirewallZonePolicy")
+			return TypedDelete(client, unifi.Client.DeleteFirewallZonePolicy)
 		},
 		"list_heat_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "HeatMap")
+			return TypedList(client, unifi.Client.ListHeatMap)
 		},
 		"get_heat_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "HeatMap", false)
+			return TypedGet(client, unifi.Client.GetHeatMap)
 		},
 		"create_heat_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "HeatMap", TypeRegistry["HeatMap"])
+			return TypedCreate(client, unifi.Client.CreateHeatMap, unifi.Client.ListHeatMap)
 		},
 		"update_heat_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "HeatMap", TypeRegistry["HeatMap"], false)
+			return TypedUpdate(client, unifi.Client.GetHeatMap, unifi.Client.UpdateHeatMap)
+		},
+		"upsert_heat_map": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListHeatMap, unifi.Client.GetHeatMap, unifi.Client.CreateHeatMap, unifi.Client.UpdateHeatMap)
 		},
 		"delete_heat_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "HeatMap")
+			return TypedDelete(client, unifi.Client.DeleteHeatMap)
 		},
 		"list_heat_map_point": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "HeatMapPoint")
+			return TypedList(client, unifi.Client.ListHeatMapPoint)
 		},
 		"get_heat_map_point": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "HeatMapPoint", false)
+			return TypedGet(client, unifi.Client.GetHeatMapPoint)
 		},
 		"create_heat_map_point": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "HeatMapPoint", TypeRegistry["HeatMapPoint"])
+			return TypedCreate(client, unifi.Client.CreateHeatMapPoint, unifi.Client.ListHeatMapPoint)
 		},
 		"update_heat_map_point": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "HeatMapPoint", TypeRegistry["HeatMapPoint"], false)
+			return TypedUpdate(client, unifi.Client.GetHeatMapPoint, unifi.Client.UpdateHeatMapPoint)
+		},
+		"upsert_heat_map_point": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListHeatMapPoint, unifi.Client.GetHeatMapPoint, unifi.Client.CreateHeatMapPoint, unifi.Client.UpdateHeatMapPoint)
 		},
 		"delete_heat_map_point": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "HeatMapPoint")
+			return TypedDelete(client, unifi.Client.DeleteHeatMapPoint)
 		},
 		"list_hotspot_2_conf": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "Hotspot2Conf")
+			return TypedList(client, unifi.Client.ListHotspot2Conf)
 		},
 		"get_hotspot_2_conf": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "Hotspot2Conf", false)
+			return TypedGet(client, unifi.Client.GetHotspot2Conf)
 		},
 		"create_hotspot_2_conf": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "Hotspot2Conf", TypeRegistry["Hotspot2Conf"])
+			return TypedCreate(client, unifi.Client.CreateHotspot2Conf, unifi.Client.ListHotspot2Conf)
 		},
 		"update_hotspot_2_conf": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "Hotspot2Conf", TypeRegistry["Hotspot2Conf"], false)
+			return TypedUpdate(client, unifi.Client.GetHotspot2Conf, unifi.Client.UpdateHotspot2Conf)
+		},
+		"upsert_hotspot_2_conf": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListHotspot2Conf, unifi.Client.GetHotspot2Conf, unifi.Client.CreateHotspot2Conf, unifi.Client.UpdateHotspot2Conf)
 		},
 		"delete_hotspot_2_conf": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "Hotspot2Conf")
+			return TypedDelete(client, unifi.Client.DeleteHotspot2Conf)
 		},
 		"list_hotspot_op": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "HotspotOp")
+			return TypedList(client, unifi.Client.ListHotspotOp)
 		},
 		"get_hotspot_op": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "HotspotOp", false)
+			return TypedGet(client, unifi.Client.GetHotspotOp)
 		},
 		"create_hotspot_op": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "HotspotOp", TypeRegistry["HotspotOp"])
+			return TypedCreate(client, unifi.Client.CreateHotspotOp, unifi.Client.ListHotspotOp)
 		},
 		"update_hotspot_op": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "HotspotOp", TypeRegistry["HotspotOp"], false)
+			return TypedUpdate(client, unifi.Client.GetHotspotOp, unifi.Client.UpdateHotspotOp)
+		},
+		"upsert_hotspot_op": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListHotspotOp, unifi.Client.GetHotspotOp, unifi.Client.CreateHotspotOp, unifi.Client.UpdateHotspotOp)
 		},
 		"delete_hotspot_op": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "HotspotOp")
+			return TypedDelete(client, unifi.Client.DeleteHotspotOp)
 		},
 		"list_hotspot_package": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "HotspotPackage")
+			return TypedList(client, unifi.Client.ListHotspotPackage)
 		},
 		"get_hotspot_package": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "HotspotPackage", false)
+			return TypedGet(client, unifi.Client.GetHotspotPackage)
 		},
 		"create_hotspot_package": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "HotspotPackage", TypeRegistry["HotspotPackage"])
+			return TypedCreate(client, unifi.Client.CreateHotspotPackage, unifi.Client.ListHotspotPackage)
 		},
 		"update_hotspot_package": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "HotspotPackage", TypeRegistry["HotspotPackage"], false)
+			return TypedUpdate(client, unifi.Client.GetHotspotPackage, unifi.Client.UpdateHotspotPackage)
+		},
+		"upsert_hotspot_package": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListHotspotPackage, unifi.Client.GetHotspotPackage, unifi.Client.CreateHotspotPackage, unifi.Client.UpdateHotspotPackage)
 		},
 		"delete_hotspot_package": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "HotspotPackage")
+			return TypedDelete(client, unifi.Client.DeleteHotspotPackage)
 		},
 		"list_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "Map")
+			return TypedList(client, unifi.Client.ListMap)
 		},
 		"get_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "Map", false)
+			return TypedGet(client, unifi.Client.GetMap)
 		},
 		"create_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "Map", TypeRegistry["Map"])
+			return TypedCreate(client, unifi.Client.CreateMap, unifi.Client.ListMap)
 		},
 		"update_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "Map", TypeRegistry["Map"], false)
+			return TypedUpdate(client, unifi.Client.GetMap, unifi.Client.UpdateMap)
+		},
+		"upsert_map": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListMap, unifi.Client.GetMap, unifi.Client.CreateMap, unifi.Client.UpdateMap)
 		},
 		"delete_map": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "Map")
+			return TypedDelete(client, unifi.Client.DeleteMap)
 		},
 		"list_media_file": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "MediaFile")
+			return TypedList(client, unifi.Client.ListMediaFile)
 		},
 		"get_media_file": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "MediaFile", false)
+			return TypedGet(client, unifi.Client.GetMediaFile)
 		},
 		"create_media_file": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "MediaFile", TypeRegistry["MediaFile"])
+			return TypedCreate(client, unifi.Client.CreateMediaFile, unifi.Client.ListMediaFile)
 		},
 		"update_media_file": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "MediaFile", TypeRegistry["MediaFile"], false)
+			return TypedUpdate(client, unifi.Client.GetMediaFile, unifi.Client.UpdateMediaFile)
+		},
+		"upsert_media_file": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListMediaFile, unifi.Client.GetMediaFile, unifi.Client.CreateMediaFile, unifi.Client.UpdateMediaFile)
 		},
 		"delete_media_file": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "MediaFile")
+			return TypedDelete(client, unifi.Client.DeleteMediaFile)
 		},
 		"list_network": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "Network")
+			return TypedList(client, unifi.Client.ListNetwork)
 		},
 		"get_network": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "Network", false)
+			return TypedGet(client, unifi.Client.GetNetwork)
 		},
 		"create_network": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "Network", TypeRegistry["Network"])
+			return TypedCreate(client, unifi.Client.CreateNetwork, unifi.Client.ListNetwork)
 		},
 		"update_network": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "Network", TypeRegistry["Network"], false)
+			return TypedUpdate(client, unifi.Client.GetNetwork, unifi.Client.UpdateNetwork)
+		},
+		"upsert_network": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListNetwork, unifi.Client.GetNetwork, unifi.Client.CreateNetwork, unifi.Client.UpdateNetwork)
 		},
 		"delete_network": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "Network")
+			return TypedDelete(client, unifi.Client.DeleteNetwork)
 		},
 		"list_port_forward": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "PortForward")
+			return TypedList(client, unifi.Client.ListPortForward)
 		},
 		"get_port_forward": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "PortForward", false)
+			return TypedGet(client, unifi.Client.GetPortForward)
 		},
 		"create_port_forward": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "PortForward", TypeRegistry["PortForward"])
+			return TypedCreate(client, unifi.Client.CreatePortForward, unifi.Client.ListPortForward)
 		},
 		"update_port_forward": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "PortForward", TypeRegistry["PortForward"], false)
+			return TypedUpdate(client, unifi.Client.GetPortForward, unifi.Client.UpdatePortForward)
+		},
+		"upsert_port_forward": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListPortForward, unifi.Client.GetPortForward, unifi.Client.CreatePortForward, unifi.Client.UpdatePortForward)
 		},
 		"delete_port_forward": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "PortForward")
+			return TypedDelete(client, unifi.Client.DeletePortForward)
 		},
 		"list_port_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "PortProfile")
+			return TypedList(client, unifi.Client.ListPortProfile)
 		},
 		"get_port_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "PortProfile", false)
+			return TypedGet(client, unifi.Client.GetPortProfile)
 		},
 		"create_port_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "PortProfile", TypeRegistry["PortProfile"])
+			return TypedCreate(client, unifi.Client.CreatePortProfile, unifi.Client.ListPortProfile)
 		},
 		"update_port_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "PortProfile", TypeRegistry["PortProfile"], false)
+			return TypedUpdate(client, unifi.Client.GetPortProfile, unifi.Client.UpdatePortProfile)
+		},
+		"upsert_port_profile": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListPortProfile, unifi.Client.GetPortProfile, unifi.Client.CreatePortProfile, unifi.Client.UpdatePortProfile)
 		},
 		"delete_port_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "PortProfile")
+			return TypedDelete(client, unifi.Client.DeletePortProfile)
 		},
 		"list_radius_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "RADIUSProfile")
+			return TypedList(client, unifi.Client.ListRADIUSProfile)
 		},
 		"get_radius_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "RADIUSProfile", false)
+			return TypedGet(client, unifi.Client.GetRADIUSProfile)
 		},
 		"create_radius_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "RADIUSProfile", TypeRegistry["RADIUSProfile"])
+			return TypedCreate(client, unifi.Client.CreateRADIUSProfile, unifi.Client.ListRADIUSProfile)
 		},
 		"update_radius_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "RADIUSProfile", TypeRegistry["RADIUSProfile"], false)
+			return TypedUpdate(client, unifi.Client.GetRADIUSProfile, unifi.Client.UpdateRADIUSProfile)
+		},
+		"upsert_radius_profile": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListRADIUSProfile, unifi.Client.GetRADIUSProfile, unifi.Client.CreateRADIUSProfile, unifi.Client.UpdateRADIUSProfile)
 		},
 		"delete_radius_profile": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "RADIUSProfile")
+			return TypedDelete(client, unifi.Client.DeleteRADIUSProfile)
 		},
 		"list_routing": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "Routing")
+			return TypedList(client, unifi.Client.ListRouting)
 		},
 		"get_routing": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "Routing", false)
+			return TypedGet(client, unifi.Client.GetRouting)
 		},
 		"create_routing": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "Routing", TypeRegistry["Routing"])
+			return TypedCreate(client, unifi.Client.CreateRouting, unifi.Client.ListRouting)
 		},
 		"update_routing": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "Routing", TypeRegistry["Routing"], false)
+			return TypedUpdate(client, unifi.Client.GetRouting, unifi.Client.UpdateRouting)
+		},
+		"upsert_routing": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListRouting, unifi.Client.GetRouting, unifi.Client.CreateRouting, unifi.Client.UpdateRouting)
 		},
 		"delete_routing": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "Routing")
+			return TypedDelete(client, unifi.Client.DeleteRouting)
 		},
 		"list_schedule_task": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "ScheduleTask")
+			return TypedList(client, unifi.Client.ListScheduleTask)
 		},
 		"get_schedule_task": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "ScheduleTask", false)
+			return TypedGet(client, unifi.Client.GetScheduleTask)
 		},
 		"create_schedule_task": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "ScheduleTask", TypeRegistry["ScheduleTask"])
+			return TypedCreate(client, unifi.Client.CreateScheduleTask, unifi.Client.ListScheduleTask)
 		},
 		"update_schedule_task": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "ScheduleTask", TypeRegistry["ScheduleTask"], false)
+			return TypedUpdate(client, unifi.Client.GetScheduleTask, unifi.Client.UpdateScheduleTask)
+		},
+		"upsert_schedule_task": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListScheduleTask, unifi.Client.GetScheduleTask, unifi.Client.CreateScheduleTask, unifi.Client.UpdateScheduleTask)
 		},
 		"delete_schedule_task": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "ScheduleTask")
+			return TypedDelete(client, unifi.Client.DeleteScheduleTask)
 		},
 		"get_setting_auto_speedtest": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingAutoSpeedtest", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingAutoSpeedtest)
 		},
 		"update_setting_auto_speedtest": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingAutoSpeedtest", TypeRegistry["SettingAutoSpeedtest"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingAutoSpeedtest, unifi.Client.UpdateSettingAutoSpeedtest)
 		},
 		"get_setting_baresip": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingBaresip", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingBaresip)
 		},
 		"update_setting_baresip": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingBaresip", TypeRegistry["SettingBaresip"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingBaresip, unifi.Client.UpdateSettingBaresip)
 		},
 		"get_setting_broadcast": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingBroadcast", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingBroadcast)
 		},
 		"update_setting_broadcast": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingBroadcast", TypeRegistry["SettingBroadcast"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingBroadcast, unifi.Client.UpdateSettingBroadcast)
 		},
 		"get_setting_connectivity": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingConnectivity", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingConnectivity)
 		},
 		"update_setting_connectivity": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingConnectivity", TypeRegistry["SettingConnectivity"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingConnectivity, unifi.Client.UpdateSettingConnectivity)
 		},
 		"get_setting_country": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingCountry", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingCountry)
 		},
 		"update_setting_country": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingCountry", TypeRegistry["SettingCountry"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingCountry, unifi.Client.UpdateSettingCountry)
 		},
 		"get_setting_dashboard": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingDashboard", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingDashboard)
 		},
 		"update_setting_dashboard": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingDashboard", TypeRegistry["SettingDashboard"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingDashboard, unifi.Client.UpdateSettingDashboard)
 		},
 		"get_setting_doh": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingDoh", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingDoh)
 		},
 		"update_setting_doh": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingDoh", TypeRegistry["SettingDoh"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingDoh, unifi.Client.UpdateSettingDoh)
 		},
 		"get_setting_dpi": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingDpi", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingDpi)
 		},
 		"update_setting_dpi": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingDpi", TypeRegistry["SettingDpi"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingDpi, unifi.Client.UpdateSettingDpi)
 		},
 		"get_setting_element_adopt": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingElementAdopt", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingElementAdopt)
 		},
 		"update_setting_element_adopt": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingElementAdopt", TypeRegistry["SettingElementAdopt"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingElementAdopt, unifi.Client.UpdateSettingElementAdopt)
 		},
 		"get_setting_ether_lighting": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingEtherLighting", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingEtherLighting)
 		},
 		"update_setting_ether_lighting": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingEtherLighting", TypeRegistry["SettingEtherLighting"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingEtherLighting, unifi.Client.UpdateSettingEtherLighting)
 		},
 		"get_setting_evaluation_score": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingEvaluationScore", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingEvaluationScore)
 		},
 		"update_setting_evaluation_score": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingEvaluationScore", TypeRegistry["SettingEvaluationScore"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingEvaluationScore, unifi.Client.UpdateSettingEvaluationScore)
 		},
 		"get_setting_global_ap": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingGlobalAp", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingGlobalAp)
 		},
 		"update_setting_global_ap": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingGlobalAp", TypeRegistry["SettingGlobalAp"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingGlobalAp, unifi.Client.UpdateSettingGlobalAp)
 		},
 		"get_setting_global_nat": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingGlobalNat", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingGlobalNat)
 		},
 		"update_setting_global_nat": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingGlobalNat", TypeRegistry["SettingGlobalNat"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingGlobalNat, unifi.Client.UpdateSettingGlobalNat)
 		},
 		"get_setting_global_switch": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingGlobalSwitch", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingGlobalSwitch)
 		},
 		"update_setting_global_switch": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingGlobalSwitch", TypeRegistry["SettingGlobalSwitch"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingGlobalSwitch, unifi.Client.UpdateSettingGlobalSwitch)
 		},
 		"get_setting_guest_access": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingGuestAccess", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingGuestAccess)
 		},
 		"update_setting_guest_access": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingGuestAccess", TypeRegistry["SettingGuestAccess"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingGuestAccess, unifi.Client.UpdateSettingGuestAccess)
 		},
 		"get_setting_ips": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingIps", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingIps)
 		},
 		"update_setting_ips": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingIps", TypeRegistry["SettingIps"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingIps, unifi.Client.UpdateSettingIps)
 		},
 		"get_setting_lcm": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingLcm", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingLcm)
 		},
 		"update_setting_lcm": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingLcm", TypeRegistry["SettingLcm"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingLcm, unifi.Client.UpdateSettingLcm)
 		},
 		"get_setting_locale": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingLocale", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingLocale)
 		},
 		"update_setting_locale": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingLocale", TypeRegistry["SettingLocale"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingLocale, unifi.Client.UpdateSettingLocale)
 		},
 		"get_setting_magic_site_to_site_vpn": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingMagicSiteToSiteVpn", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingMagicSiteToSiteVpn)
 		},
 		"update_setting_magic_site_to_site_vpn": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingMagicSiteToSiteVpn", TypeRegistry["SettingMagicSiteToSiteVpn"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingMagicSiteToSiteVpn, unifi.Client.UpdateSettingMagicSiteToSiteVpn)
 		},
 		"get_setting_mgmt": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingMgmt", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingMgmt)
 		},
 		"update_setting_mgmt": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingMgmt", TypeRegistry["SettingMgmt"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingMgmt, unifi.Client.UpdateSettingMgmt)
 		},
 		"get_setting_netflow": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingNetflow", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingNetflow)
 		},
 		"update_setting_netflow": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingNetflow", TypeRegistry["SettingNetflow"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingNetflow, unifi.Client.UpdateSettingNetflow)
 		},
 		"get_setting_network_optimization": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingNetworkOptimization", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingNetworkOptimization)
 		},
 		"update_setting_network_optimization": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingNetworkOptimization", TypeRegistry["SettingNetworkOptimization"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingNetworkOptimization, unifi.Client.UpdateSettingNetworkOptimization)
 		},
 		"get_setting_ntp": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingNtp", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingNtp)
 		},
 		"update_setting_ntp": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingNtp", TypeRegistry["SettingNtp"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingNtp, unifi.Client.UpdateSettingNtp)
 		},
 		"get_setting_porta": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingPorta", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingPorta)
 		},
 		"update_setting_porta": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingPorta", TypeRegistry["SettingPorta"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingPorta, unifi.Client.UpdateSettingPorta)
 		},
 		"get_setting_radio_ai": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingRadioAi", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingRadioAi)
 		},
 		"update_setting_radio_ai": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingRadioAi", TypeRegistry["SettingRadioAi"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingRadioAi, unifi.Client.UpdateSettingRadioAi)
 		},
 		"get_setting_radius": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingRadius", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingRadius)
 		},
 		"update_setting_radius": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingRadius", TypeRegistry["SettingRadius"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingRadius, unifi.Client.UpdateSettingRadius)
 		},
 		"get_setting_rsyslogd": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingRsyslogd", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingRsyslogd)
 		},
 		"update_setting_rsyslogd": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingRsyslogd", TypeRegistry["SettingRsyslogd"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingRsyslogd, unifi.Client.UpdateSettingRsyslogd)
 		},
 		"get_setting_snmp": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSnmp", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSnmp)
 		},
 		"update_setting_snmp": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSnmp", TypeRegistry["SettingSnmp"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSnmp, unifi.Client.UpdateSettingSnmp)
 		},
 		"get_setting_ssl_inspection": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSslInspection", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSslInspection)
 		},
 		"update_setting_ssl_inspection": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSslInspection", TypeRegistry["SettingSslInspection"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSslInspection, unifi.Client.UpdateSettingSslInspection)
 		},
 		"get_setting_super_cloudaccess": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSuperCloudaccess", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSuperCloudaccess)
 		},
 		"update_setting_super_cloudaccess": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSuperCloudaccess", TypeRegistry["SettingSuperCloudaccess"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSuperCloudaccess, unifi.Client.UpdateSettingSuperCloudaccess)
 		},
 		"get_setting_super_events": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSuperEvents", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSuperEvents)
 		},
 		"update_setting_super_events": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSuperEvents", TypeRegistry["SettingSuperEvents"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSuperEvents, unifi.Client.UpdateSettingSuperEvents)
 		},
 		"get_setting_super_fwupdate": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSuperFwupdate", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSuperFwupdate)
 		},
 		"update_setting_super_fwupdate": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSuperFwupdate", TypeRegistry["SettingSuperFwupdate"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSuperFwupdate, unifi.Client.UpdateSettingSuperFwupdate)
 		},
 		"get_setting_super_identity": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSuperIdentity", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSuperIdentity)
 		},
 		"update_setting_super_identity": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSuperIdentity", TypeRegistry["SettingSuperIdentity"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSuperIdentity, unifi.Client.UpdateSettingSuperIdentity)
 		},
 		"get_setting_super_mail": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSuperMail", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSuperMail)
 		},
 		"update_setting_super_mail": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSuperMail", TypeRegistry["SettingSuperMail"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSuperMail, unifi.Client.UpdateSettingSuperMail)
 		},
 		"get_setting_super_mgmt": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSuperMgmt", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSuperMgmt)
 		},
 		"update_setting_super_mgmt": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSuperMgmt", TypeRegistry["SettingSuperMgmt"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSuperMgmt, unifi.Client.UpdateSettingSuperMgmt)
 		},
 		"get_setting_super_sdn": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSuperSdn", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSuperSdn)
 		},
 		"update_setting_super_sdn": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSuperSdn", TypeRegistry["SettingSuperSdn"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSuperSdn, unifi.Client.UpdateSettingSuperSdn)
 		},
 		"get_setting_super_smtp": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingSuperSmtp", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingSuperSmtp)
 		},
 		"update_setting_super_smtp": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingSuperSmtp", TypeRegistry["SettingSuperSmtp"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingSuperSmtp, unifi.Client.UpdateSettingSuperSmtp)
 		},
 		"get_setting_teleport": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingTeleport", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingTeleport)
 		},
 		"update_setting_teleport": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingTeleport", TypeRegistry["SettingTeleport"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingTeleport, unifi.Client.UpdateSettingTeleport)
 		},
 		"get_setting_usg": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingUsg", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingUsg)
 		},
 		"update_setting_usg": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingUsg", TypeRegistry["SettingUsg"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingUsg, unifi.Client.UpdateSettingUsg)
 		},
 		"get_setting_usw": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SettingUsw", true)
+			return TypedGetSetting(client, unifi.Client.GetSettingUsw)
 		},
 		"update_setting_usw": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SettingUsw", TypeRegistry["SettingUsw"], true)
+			return TypedUpdateSetting(client, unifi.Client.GetSettingUsw, unifi.Client.UpdateSettingUsw)
 		},
 		"list_spatial_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "SpatialRecord")
+			return TypedList(client, unifi.Client.ListSpatialRecord)
 		},
 		"get_spatial_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "SpatialRecord", false)
+			return TypedGet(client, unifi.Client.GetSpatialRecord)
 		},
 		"create_spatial_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "SpatialRecord", TypeRegistry["SpatialRecord"])
+			return TypedCreate(client, unifi.Client.CreateSpatialRecord, unifi.Client.ListSpatialRecord)
 		},
 		"update_spatial_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "SpatialRecord", TypeRegistry["SpatialRecord"], false)
+			return TypedUpdate(client, unifi.Client.GetSpatialRecord, unifi.Client.UpdateSpatialRecord)
+		},
+		"upsert_spatial_record": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListSpatialRecord, unifi.Client.GetSpatialRecord, unifi.Client.CreateSpatialRecord, unifi.Client.UpdateSpatialRecord)
 		},
 		"delete_spatial_record": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "SpatialRecord")
+			return TypedDelete(client, unifi.Client.DeleteSpatialRecord)
 		},
 		"list_tag": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "Tag")
+			return TypedList(client, unifi.Client.ListTag)
 		},
 		"get_tag": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "Tag", false)
+			return TypedGet(client, unifi.Client.GetTag)
 		},
 		"create_tag": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "Tag", TypeRegistry["Tag"])
+			return TypedCreate(client, unifi.Client.CreateTag, unifi.Client.ListTag)
 		},
 		"update_tag": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "Tag", TypeRegistry["Tag"], false)
+			return TypedUpdate(client, unifi.Client.GetTag, unifi.Client.UpdateTag)
+		},
+		"upsert_tag": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListTag, unifi.Client.GetTag, unifi.Client.CreateTag, unifi.Client.UpdateTag)
 		},
 		"delete_tag": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "Tag")
+			return TypedDelete(client, unifi.Client.DeleteTag)
 		},
 		"list_user": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "User")
+			return TypedList(client, unifi.Client.ListUser)
 		},
 		"get_user": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "User", false)
+			return TypedGet(client, unifi.Client.GetUser)
 		},
 		"create_user": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "User", TypeRegistry["User"])
+			return TypedCreate(client, unifi.Client.CreateUser, unifi.Client.ListUser)
 		},
 		"update_user": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "User", TypeRegistry["User"], false)
+			return TypedUpdate(client, unifi.Client.GetUser, unifi.Client.UpdateUser)
+		},
+		"upsert_user": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListUser, unifi.Client.GetUser, unifi.Client.CreateUser, unifi.Client.UpdateUser)
 		},
 		"delete_user": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "User")
+			return TypedDelete(client, unifi.Client.DeleteUser)
 		},
 		"list_user_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "UserGroup")
+			return TypedList(client, unifi.Client.ListUserGroup)
 		},
 		"get_user_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "UserGroup", false)
+			return TypedGet(client, unifi.Client.GetUserGroup)
 		},
 		"create_user_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "UserGroup", TypeRegistry["UserGroup"])
+			return TypedCreate(client, unifi.Client.CreateUserGroup, unifi.Client.ListUserGroup)
 		},
 		"update_user_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "UserGroup", TypeRegistry["UserGroup"], false)
+			return TypedUpdate(client, unifi.Client.GetUserGroup, unifi.Client.UpdateUserGroup)
+		},
+		"upsert_user_group": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListUserGroup, unifi.Client.GetUserGroup, unifi.Client.CreateUserGroup, unifi.Client.UpdateUserGroup)
 		},
 		"delete_user_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "UserGroup")
+			return TypedDelete(client, unifi.Client.DeleteUserGroup)
 		},
 		"list_virtual_device": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "VirtualDevice")
+			return TypedList(client, unifi.Client.ListVirtualDevice)
 		},
 		"get_virtual_device": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "VirtualDevice", false)
+			return TypedGet(client, unifi.Client.GetVirtualDevice)
 		},
 		"create_virtual_device": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "VirtualDevice", TypeRegistry["VirtualDevice"])
+			return TypedCreate(client, unifi.Client.CreateVirtualDevice, unifi.Client.ListVirtualDevice)
 		},
 		"update_virtual_device": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "VirtualDevice", TypeRegistry["VirtualDevice"], false)
+			return TypedUpdate(client, unifi.Client.GetVirtualDevice, unifi.Client.UpdateVirtualDevice)
+		},
+		"upsert_virtual_device": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListVirtualDevice, unifi.Client.GetVirtualDevice, unifi.Client.CreateVirtualDevice, unifi.Client.UpdateVirtualDevice)
 		},
 		"delete_virtual_device": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "VirtualDevice")
+			return TypedDelete(client, unifi.Client.DeleteVirtualDevice)
 		},
 		"list_wlan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "WLAN")
+			return TypedList(client, unifi.Client.ListWLAN)
 		},
 		"get_wlan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "WLAN", false)
+			return TypedGet(client, unifi.Client.GetWLAN)
 		},
 		"create_wlan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "WLAN", TypeRegistry["WLAN"])
+			return TypedCreate(client, unifi.Client.CreateWLAN, unifi.Client.ListWLAN)
 		},
 		"update_wlan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "WLAN", TypeRegistry["WLAN"], false)
+			return TypedUpdate(client, unifi.Client.GetWLAN, unifi.Client.UpdateWLAN)
+		},
+		"upsert_wlan": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListWLAN, unifi.Client.GetWLAN, unifi.Client.CreateWLAN, unifi.Client.UpdateWLAN)
 		},
 		"delete_wlan": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "WLAN")
+			return TypedDelete(client, unifi.Client.DeleteWLAN)
 		},
 		"list_wlan_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericList(client, "WLANGroup")
+			return TypedList(client, unifi.Client.ListWLANGroup)
 		},
 		"get_wlan_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericGet(client, "WLANGroup", false)
+			return TypedGet(client, unifi.Client.GetWLANGroup)
 		},
 		"create_wlan_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericCreate(client, "WLANGroup", TypeRegistry["WLANGroup"])
+			return TypedCreate(client, unifi.Client.CreateWLANGroup, unifi.Client.ListWLANGroup)
 		},
 		"update_wlan_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericUpdate(client, "WLANGroup", TypeRegistry["WLANGroup"], false)
+			return TypedUpdate(client, unifi.Client.GetWLANGroup, unifi.Client.UpdateWLANGroup)
+		},
+		"upsert_wlan_group": func(client unifi.Client) server.ToolHandlerFunc {
+			return TypedUpsert(client, unifi.Client.ListWLANGroup, unifi.Client.GetWLANGroup, unifi.Client.CreateWLANGroup, unifi.Client.UpdateWLANGroup)
 		},
 		"delete_wlan_group": func(client unifi.Client) server.ToolHandlerFunc {
-			return GenericDelete(client, "WLANGroup")
+			return TypedDelete(client, unifi.Client.DeleteWLANGroup)
 		},
 	}
 }
@@ -0,0 +1,86 @@
+package generated
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/filipowm/go-unifi/unifi"
+)
+
+// Call describes a single resource method invocation on an InterceptedClient.
+type Call struct {
+	Method   string // e.g., "ListNetwork"
+	Resource string // e.g., "Network"
+	Category string // list, get, create, update, delete, or raw
+	Site     string
+	ID       string // resource ID for get/delete calls, empty otherwise
+	Path     string // API path for raw calls, empty otherwise
+	Result   any    // pointer to the method's result value, nil for delete
+}
+
+// CategoryRaw is the category of raw API requests made through Do, Get, Post,
+// Put and Delete.
+const CategoryRaw = "raw"
+
+// Interceptor wraps a client method call. Implementations invoke next to call
+// the underlying client and may write a replacement value through call.Result
+// before returning.
+type Interceptor func(ctx context.Context, call Call, next func(context.Context) error) error
+
+// InterceptedClient decorates a unifi.Client so that every generated resource
+// method and every raw API request passes through a chain of interceptors.
+// Other methods (login, version, etc.) are promoted from the embedded client
+// unchanged.
+type InterceptedClient struct {
+	unifi.Client
+	interceptors []Interceptor
+}
+
+// NewInterceptedClient wraps client with the given interceptors. The first
+// interceptor is the outermost one.
+func NewInterceptedClient(client unifi.Client, interceptors ...Interceptor) *InterceptedClient {
+	return &InterceptedClient{
+		Client:       client,
+		interceptors: interceptors,
+	}
+}
+
+// intercept runs fn through the interceptor chain.
+func (c *InterceptedClient) intercept(ctx context.Context, call Call, fn func(context.Context) error) error {
+	next := fn
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context) error {
+			return interceptor(ctx, call, inner)
+		}
+	}
+	return next(ctx)
+}
+
+// Do performs a raw API request through the interceptor chain.
+func (c *InterceptedClient) Do(ctx context.Context, method, apiPath string, reqBody, respBody any) error {
+	call := Call{Method: method, Category: CategoryRaw, Path: apiPath, Result: respBody}
+	return c.intercept(ctx, call, func(ctx context.Context) error {
+		return c.Client.Do(ctx, method, apiPath, reqBody, respBody)
+	})
+}
+
+// Get performs a raw GET request through the interceptor chain.
+func (c *InterceptedClient) Get(ctx context.Context, apiPath string, reqBody, respBody any) error {
+	return c.Do(ctx, http.MethodGet, apiPath, reqBody, respBody)
+}
+
+// Post performs a raw POST request through the interceptor chain.
+func (c *InterceptedClient) Post(ctx context.Context, apiPath string, reqBody, respBody any) error {
+	return c.Do(ctx, http.MethodPost, apiPath, reqBody, respBody)
+}
+
+// Put performs a raw PUT request through the interceptor chain.
+func (c *InterceptedClient) Put(ctx context.Context, apiPath string, reqBody, respBody any) error {
+	return c.Do(ctx, http.MethodPut, apiPath, reqBody, respBody)
+}
+
+// Delete performs a raw DELETE request through the interceptor chain.
+func (c *InterceptedClient) Delete(ctx context.Context, apiPath string, reqBody, respBody any) error {
+	return c.Do(ctx, http.MethodDelete, apiPath, reqBody, respBody)
+}
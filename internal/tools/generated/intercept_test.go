@@ -0,0 +1,136 @@
+package generated
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type interceptTestClient struct {
+	unifi.Client
+	deleted []string
+	raw     []string
+}
+
+func (c *interceptTestClient) ListNetwork(_ context.Context, _ string) ([]unifi.Network, error) {
+	return []unifi.Network{{ID: "net1", Name: "LAN"}}, nil
+}
+
+func (c *interceptTestClient) Do(_ context.Context, method, apiPath string, _, respBody any) error {
+	c.raw = append(c.raw, method+" "+apiPath)
+	if out, ok := respBody.(*string); ok {
+		*out = "ok"
+	}
+	return nil
+}
+
+func (c *interceptTestClient) DeleteNetwork(_ context.Context, _, id string) error {
+	c.deleted = append(c.deleted, id)
+	return nil
+}
+
+func TestInterceptedClient_ChainOrder(t *testing.T) {
+	var order []string
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, call Call, next func(context.Context) error) error {
+			order = append(order, name+":before")
+			err := next(ctx)
+			order = append(order, name+":after")
+			return err
+		}
+	}
+
+	client := NewInterceptedClient(&interceptTestClient{}, record("outer"), record("inner"))
+	networks, err := client.ListNetwork(context.Background(), "default")
+	require.NoError(t, err)
+	assert.Len(t, networks, 1)
+	assert.Equal(t, []string{"outer:before", "inner:before", "inner:after", "outer:after"}, order)
+}
+
+func TestInterceptedClient_CallDescription(t *testing.T) {
+	var calls []Call
+	client := NewInterceptedClient(&interceptTestClient{}, func(ctx context.Context, call Call, next func(context.Context) error) error {
+		calls = append(calls, call)
+		return next(ctx)
+	})
+
+	_, err := client.ListNetwork(context.Background(), "site1")
+	require.NoError(t, err)
+	require.NoError(t, client.DeleteNetwork(context.Background(), "site1", "net1"))
+
+	require.Len(t, calls, 2)
+	assert.Equal(t, "ListNetwork", calls[0].Method)
+	assert.Equal(t, "Network", calls[0].Resource)
+	assert.Equal(t, "list", calls[0].Category)
+	assert.Equal(t, "site1", calls[0].Site)
+	assert.IsType(t, &[]unifi.Network{}, calls[0].Result)
+
+	assert.Equal(t, "DeleteNetwork", calls[1].Method)
+	assert.Equal(t, "delete", calls[1].Category)
+	assert.Equal(t, "net1", calls[1].ID)
+	assert.Nil(t, calls[1].Result)
+}
+
+func TestInterceptedClient_ReplacesResult(t *testing.T) {
+	client := NewInterceptedClient(&interceptTestClient{}, func(ctx context.Context, call Call, next func(context.Context) error) error {
+		if err := next(ctx); err != nil {
+			return err
+		}
+		*call.Result.(*[]unifi.Network) = []unifi.Network{{ID: "replaced"}}
+		return nil
+	})
+
+	networks, err := client.ListNetwork(context.Background(), "default")
+	require.NoError(t, err)
+	require.Len(t, networks, 1)
+	assert.Equal(t, "replaced", networks[0].ID)
+}
+
+func TestInterceptedClient_ShortCircuit(t *testing.T) {
+	base := &interceptTestClient{}
+	errBlocked := errors.New("blocked")
+	client := NewInterceptedClient(base, func(_ context.Context, _ Call, _ func(context.Context) error) error {
+		return errBlocked
+	})
+
+	err := client.DeleteNetwork(context.Background(), "default", "net1")
+	assert.ErrorIs(t, err, errBlocked)
+	assert.Empty(t, base.deleted)
+}
+
+func TestInterceptedClient_RawRequests(t *testing.T) {
+	base := &interceptTestClient{}
+	var calls []Call
+	client := NewInterceptedClient(base, func(ctx context.Context, call Call, next func(context.Context) error) error {
+		calls = append(calls, call)
+		return next(ctx)
+	})
+
+	var resp string
+	require.NoError(t, client.Get(context.Background(), "api/s/default/stat/health", nil, &resp))
+	require.NoError(t, client.Post(context.Background(), "api/s/default/cmd/devmgr", nil, nil))
+	require.NoError(t, client.Put(context.Background(), "api/s/default/rest/user/1", nil, nil))
+	require.NoError(t, client.Delete(context.Background(), "api/s/default/rest/user/1", nil, nil))
+
+	assert.Equal(t, "ok", resp)
+	assert.Equal(t, []string{
+		"GET api/s/default/stat/health",
+		"POST api/s/default/cmd/devmgr",
+		"PUT api/s/default/rest/user/1",
+		"DELETE api/s/default/rest/user/1",
+	}, base.raw)
+	require.Len(t, calls, 4)
+	assert.Equal(t, CategoryRaw, calls[0].Category)
+	assert.Equal(t, "GET", calls[0].Method)
+	assert.Equal(t, "api/s/default/stat/health", calls[0].Path)
+	assert.Same(t, &resp, calls[0].Result)
+}
+
+func TestInterceptedClient_ValidatesAgainstMetadata(t *testing.T) {
+	client := NewInterceptedClient(&interceptTestClient{})
+	assert.NoError(t, ValidateClientMethods(client, AllToolMetadata, TypeRegistry))
+}
@@ -6,10 +6,13 @@ package generated
 type ToolMetadata struct {
 	Name        string
 	Description string
-	Category    string         // list, get, create, update, delete
-	Resource    string         // e.g., "Network"
-	IsSetting   bool           // true for settings resources
-	InputSchema map[string]any // JSON Schema
+	Category    string            // list, get, create, update, upsert, delete
+	Resource    string            // e.g., "Network"
+	IsSetting   bool              // true for settings resources
+	InputSchema map[string]any    // JSON Schema
+	NameInputs  map[string]string // create, update and upsert arguments naming the object an ID field references -> that field
+
+	SummaryFields []string // fields of the "summary" view of list and get tools, if any
 }
 
 // AllToolMetadata contains metadata for all generated tools.
@@ -28,17 +31,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -61,14 +121,35 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the APGroup by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -105,6 +186,19 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -126,7 +220,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -150,12 +244,65 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_ap_group",
+		Description: "Create or update APGroup matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "APGroup",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing APGroup (default: [\"name\"]). One match is updated, none creates a new APGroup, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"device_macs": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -172,10 +319,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the APGroup by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -192,17 +342,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -225,14 +432,35 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Account by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -274,6 +502,10 @@ var AllToolMetadata = []ToolMetadata{
 				"networkconf_id": map[string]any{
 					"type": "string",
 				},
+				"networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for networkconf_id, in place of its ID",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
@@ -300,12 +532,28 @@ var AllToolMetadata = []ToolMetadata{
 				"x_password": map[string]any{
 					"type": "string",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"networkconf_name": "networkconf_id",
+		},
 	},
 	{
 		Name:        "update_account",
@@ -321,7 +569,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -350,6 +598,10 @@ var AllToolMetadata = []ToolMetadata{
 				"networkconf_id": map[string]any{
 					"type": "string",
 				},
+				"networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for networkconf_id, in place of its ID",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
@@ -376,18 +628,24 @@ var AllToolMetadata = []ToolMetadata{
 				"x_password": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
+		},
+		NameInputs: map[string]string{
+			"networkconf_name": "networkconf_id",
 		},
 	},
 	{
-		Name:        "delete_account",
-		Description: "Delete Account by ID",
-		Category:    "delete",
+		Name:        "upsert_account",
+		Description: "Create or update Account matched by natural key fields",
+		Category:    "upsert",
 		Resource:    "Account",
 		InputSchema: map[string]any{
 			"type": "object",
@@ -396,39 +654,71 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing Account (default: [\"name\"]). One match is updated, none creates a new Account, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"filter_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": "^[^\"' ]+$",
+				},
+				"networkconf_id": map[string]any{
+					"type": "string",
+				},
+				"networkconf_name": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Name of the Network for networkconf_id, in place of its ID",
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "list_broadcast_group",
-		Description: "List all BroadcastGroup resources",
-		Category:    "list",
-		Resource:    "BroadcastGroup",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"tunnel_config_type": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "One of: vpn|802.1x|custom",
+					"enum":        []any{"vpn", "802.1x", "custom"},
 				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
+				"tunnel_medium_type": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9]|1[0-5]|^$",
 				},
-				"fields": map[string]any{
-					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
-					"items":       map[string]any{"type": "string"},
+				"tunnel_type": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9]|1[0-3]|^$",
 				},
-				"search": map[string]any{
-					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+				"ulp_user_id": map[string]any{
+					"type": "string",
+				},
+				"vlan": map[string]any{
+					"type":    "integer",
+					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|400[0-9]|^$",
+				},
+				"x_password": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -436,12 +726,15 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"networkconf_name": "networkconf_id",
+		},
 	},
 	{
-		Name:        "get_broadcast_group",
-		Description: "Get BroadcastGroup by ID",
-		Category:    "get",
-		Resource:    "BroadcastGroup",
+		Name:        "delete_account",
+		Description: "Delete Account by ID",
+		Category:    "delete",
+		Resource:    "Account",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -451,20 +744,19 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID to delete (omit to select by name)",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Account by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "create_broadcast_group",
-		Description: "Create new BroadcastGroup",
-		Category:    "create",
+		Name:        "list_broadcast_group",
+		Description: "List all BroadcastGroup resources",
+		Category:    "list",
 		Resource:    "BroadcastGroup",
 		InputSchema: map[string]any{
 			"type": "object",
@@ -473,7 +765,142 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"attr_hidden": map[string]any{
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "get_broadcast_group",
+		Description: "Get BroadcastGroup by ID",
+		Category:    "get",
+		Resource:    "BroadcastGroup",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the BroadcastGroup by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "create_broadcast_group",
+		Description: "Create new BroadcastGroup",
+		Category:    "create",
+		Resource:    "BroadcastGroup",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
 				"attr_hidden_id": map[string]any{
@@ -495,6 +922,19 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -516,7 +956,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -540,12 +980,65 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_broadcast_group",
+		Description: "Create or update BroadcastGroup matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "BroadcastGroup",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing BroadcastGroup (default: [\"name\"]). One match is updated, none creates a new BroadcastGroup, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"member_table": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -562,10 +1055,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the BroadcastGroup by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -582,17 +1078,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -617,6 +1170,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "Resource ID",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -695,6 +1266,19 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -776,6 +1360,10 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -785,9 +1373,9 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "delete_channel_plan",
-		Description: "Delete ChannelPlan by ID",
-		Category:    "delete",
+		Name:        "upsert_channel_plan",
+		Description: "Create or update ChannelPlan matched by natural key fields",
+		Category:    "upsert",
 		Resource:    "ChannelPlan",
 		InputSchema: map[string]any{
 			"type": "object",
@@ -796,69 +1384,233 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID to delete",
-				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "list_dhcp_option",
-		Description: "List all DHCPOption resources",
-		Category:    "list",
-		Resource:    "DHCPOption",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
-				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
-				},
-				"fields": map[string]any{
+				"match_on": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names identifying an existing ChannelPlan (default: [\"name\"]). One match is updated, none creates a new ChannelPlan, several is an error",
 					"items":       map[string]any{"type": "string"},
 				},
-				"search": map[string]any{
-					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+				"ap_blacklisted_channels": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_dhcp_option",
-		Description: "Get DHCPOption by ID",
-		Category:    "get",
-		Resource:    "DHCPOption",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-			},
-			"required": []any{"id"},
+				"conf_source": map[string]any{
+					"type":        "string",
+					"description": "One of: manual|radio-ai",
+					"enum":        []any{"manual", "radio-ai"},
+				},
+				"coupling": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"date": map[string]any{
+					"type":    "string",
+					"pattern": "^$|^(20[0-9]{2}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])T([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9])Z?$",
+				},
+				"fitness": map[string]any{
+					"type": "number",
+				},
+				"note": map[string]any{
+					"type":    "string",
+					"pattern": ".{0,1024}",
+				},
+				"radio": map[string]any{
+					"type":    "string",
+					"pattern": "na|ng|ng\\+na",
+				},
+				"radio_table": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"satisfaction": map[string]any{
+					"type": "number",
+				},
+				"satisfaction_table": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"site_blacklisted_channels": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "delete_channel_plan",
+		Description: "Delete ChannelPlan by ID",
+		Category:    "delete",
+		Resource:    "ChannelPlan",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete",
+				},
+			},
+			"required": []any{"id"},
+		},
+	},
+	{
+		Name:        "list_dhcp_option",
+		Description: "List all DHCPOption resources",
+		Category:    "list",
+		Resource:    "DHCPOption",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "get_dhcp_option",
+		Description: "Get DHCPOption by ID",
+		Category:    "get",
+		Resource:    "DHCPOption",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the DHCPOption by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
 		},
 	},
 	{
@@ -907,6 +1659,19 @@ var AllToolMetadata = []ToolMetadata{
 					"type":    "integer",
 					"pattern": "^(8|16|32)$",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -928,7 +1693,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -964,12 +1729,77 @@ var AllToolMetadata = []ToolMetadata{
 					"type":    "integer",
 					"pattern": "^(8|16|32)$",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_dhcp_option",
+		Description: "Create or update DHCPOption matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "DHCPOption",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing DHCPOption (default: [\"name\"]). One match is updated, none creates a new DHCPOption, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"code": map[string]any{
+					"type":    "string",
+					"pattern": "^(?!(?:15|42|43|44|51|66|67|252)$)([7-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-4])$",
+				},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": "^[A-Za-z0-9-_]{1,25}$",
+				},
+				"signed": map[string]any{
+					"type": "boolean",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"type": map[string]any{
+					"type":    "string",
+					"pattern": "^(boolean|hexarray|integer|ipaddress|macaddress|text)$",
+				},
+				"width": map[string]any{
+					"type":    "integer",
+					"pattern": "^(8|16|32)$",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -986,10 +1816,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the DHCPOption by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -1006,17 +1839,79 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each DNSRecord (_id, key, record_type, value, enabled, ttl), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
+				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -1024,6 +1919,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		SummaryFields: []string{"_id", "key", "record_type", "value", "enabled", "ttl"},
 	},
 	{
 		Name:        "get_dns_record",
@@ -1041,13 +1937,37 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "Resource ID",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the DNSRecord (_id, key, record_type, value, enabled, ttl), full (default) for all of them. Ignored when fields is given.",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 			"required": []any{"id"},
 		},
+		SummaryFields: []string{"_id", "key", "record_type", "value", "enabled", "ttl"},
 	},
 	{
 		Name:        "create_dns_record",
@@ -1108,6 +2028,19 @@ var AllToolMetadata = []ToolMetadata{
 					"type":    "integer",
 					"pattern": "^[0-9][0-9]?$|^",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -1178,6 +2111,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":    "integer",
 					"pattern": "^[0-9][0-9]?$|^",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -1186,6 +2123,81 @@ var AllToolMetadata = []ToolMetadata{
 			"required": []any{"id"},
 		},
 	},
+	{
+		Name:        "upsert_dns_record",
+		Description: "Create or update DNSRecord matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "DNSRecord",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing DNSRecord (default: [\"name\"]). One match is updated, none creates a new DNSRecord, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"key": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
+				},
+				"port": map[string]any{
+					"type":    "integer",
+					"pattern": "^[0-9][0-9]?$|^",
+				},
+				"priority": map[string]any{
+					"type":    "integer",
+					"pattern": "^[0-9][0-9]?$|^",
+				},
+				"record_type": map[string]any{
+					"type":        "string",
+					"description": "One of: A|AAAA|CNAME|MX|NS|PTR|SOA|SRV|TXT",
+					"enum":        []any{"A", "AAAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT"},
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"ttl": map[string]any{
+					"type":    "integer",
+					"pattern": "^[0-9][0-9]?$|^",
+				},
+				"value": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
+				},
+				"weight": map[string]any{
+					"type":    "integer",
+					"pattern": "^[0-9][0-9]?$|^",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
 	{
 		Name:        "delete_dns_record",
 		Description: "Delete DNSRecord by ID",
@@ -1220,17 +2232,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -1253,14 +2322,35 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Dashboard by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -1306,6 +2396,19 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -1327,7 +2430,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -1360,12 +2463,74 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_dashboard",
+		Description: "Create or update Dashboard matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "Dashboard",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing Dashboard (default: [\"name\"]). One match is updated, none creates a new Dashboard, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"controller_version": map[string]any{
+					"type": "string",
+				},
+				"desc": map[string]any{
+					"type": "string",
+				},
+				"is_public": map[string]any{
+					"type": "boolean",
+				},
+				"modules": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -1382,10 +2547,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Dashboard by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -1402,17 +2570,79 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each Device (_id, name, mac, model, type, state, adopted, disabled), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
+				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -1420,6 +2650,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		SummaryFields: []string{"_id", "name", "mac", "model", "type", "state", "adopted", "disabled"},
 	},
 	{
 		Name:        "get_device",
@@ -1435,15 +2666,46 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name or mac)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Device by name instead of id",
+				},
+				"mac": map[string]any{
+					"type":        "string",
+					"description": "Select the Device by MAC address instead of id",
+				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the Device (_id, name, mac, model, type, state, adopted, disabled), full (default) for all of them. Ignored when fields is given.",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
+		SummaryFields: []string{"_id", "name", "mac", "model", "type", "state", "adopted", "disabled"},
 	},
 	{
 		Name:        "list_dynamic_dns",
@@ -1459,30 +2721,87 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_dynamic_dns",
-		Description: "Get DynamicDNS by ID",
-		Category:    "get",
-		Resource:    "DynamicDNS",
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "get_dynamic_dns",
+		Description: "Get DynamicDNS by ID",
+		Category:    "get",
+		Resource:    "DynamicDNS",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -1494,6 +2813,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "Resource ID",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -1564,6 +2901,19 @@ var AllToolMetadata = []ToolMetadata{
 					"type":    "string",
 					"pattern": "^[^\"' ]+$",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -1637,6 +2987,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":    "string",
 					"pattern": "^[^\"' ]+$",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -1645,6 +2999,84 @@ var AllToolMetadata = []ToolMetadata{
 			"required": []any{"id"},
 		},
 	},
+	{
+		Name:        "upsert_dynamic_dns",
+		Description: "Create or update DynamicDNS matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "DynamicDNS",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing DynamicDNS (default: [\"name\"]). One match is updated, none creates a new DynamicDNS, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"custom_service": map[string]any{
+					"type":    "string",
+					"pattern": "^[^\"' ]+$",
+				},
+				"host_name": map[string]any{
+					"type":    "string",
+					"pattern": "^[^\"' ]+$",
+				},
+				"interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"login": map[string]any{
+					"type":    "string",
+					"pattern": "^[^\"' ]+$",
+				},
+				"options": map[string]any{
+					"type":    "array",
+					"pattern": "^[^\"' ]+$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"server": map[string]any{
+					"type":    "string",
+					"pattern": "^[^\"' ]+$|^$",
+				},
+				"service": map[string]any{
+					"type":        "string",
+					"description": "One of: afraid|changeip|cloudflare|cloudxns|ddnss|dhis|dnsexit|dnsomatic|dnspark|dnspod|dslreports|dtdns|duckdns|duiadns|dyn|dyndns|dynv6|easydns|freemyip|googledomains|loopia|namecheap|noip|nsupdate|ovh|sitelutions|spdyn|strato|tunnelbroker|zoneedit|custom",
+					"enum":        []any{"afraid", "changeip", "cloudflare", "cloudxns", "ddnss", "dhis", "dnsexit", "dnsomatic", "dnspark", "dnspod", "dslreports", "dtdns", "duckdns", "duiadns", "dyn", "dyndns", "dynv6", "easydns", "freemyip", "googledomains", "loopia", "namecheap", "noip", "nsupdate", "ovh", "sitelutions", "spdyn", "strato", "tunnelbroker", "zoneedit", "custom"},
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"x_password": map[string]any{
+					"type":    "string",
+					"pattern": "^[^\"' ]+$",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
 	{
 		Name:        "delete_dynamic_dns",
 		Description: "Delete DynamicDNS by ID",
@@ -1679,17 +3111,79 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each FirewallGroup (_id, name, group_type, group_members), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
+				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -1697,6 +3191,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		SummaryFields: []string{"_id", "name", "group_type", "group_members"},
 	},
 	{
 		Name:        "get_firewall_group",
@@ -1712,15 +3207,42 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the FirewallGroup by name instead of id",
+				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the FirewallGroup (_id, name, group_type, group_members), full (default) for all of them. Ignored when fields is given.",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
+		SummaryFields: []string{"_id", "name", "group_type", "group_members"},
 	},
 	{
 		Name:        "create_firewall_group",
@@ -1762,6 +3284,19 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -1783,7 +3318,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -1813,12 +3348,71 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_firewall_group",
+		Description: "Create or update FirewallGroup matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "FirewallGroup",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing FirewallGroup (default: [\"name\"]). One match is updated, none creates a new FirewallGroup, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"group_members": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"group_type": map[string]any{
+					"type":        "string",
+					"description": "One of: address-group|port-group|ipv6-address-group",
+					"enum":        []any{"address-group", "port-group", "ipv6-address-group"},
+				},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,64}",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -1835,10 +3429,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the FirewallGroup by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -1855,17 +3452,79 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each FirewallRule (_id, name, ruleset, rule_index, action, enabled, protocol, src_address, dst_address, dst_port), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
+				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -1873,6 +3532,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		SummaryFields: []string{"_id", "name", "ruleset", "rule_index", "action", "enabled", "protocol", "src_address", "dst_address", "dst_port"},
 	},
 	{
 		Name:        "get_firewall_rule",
@@ -1888,15 +3548,42 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
-				"resolve": map[string]any{
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the FirewallRule by name instead of id",
+				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the FirewallRule (_id, name, ruleset, rule_index, action, enabled, protocol, src_address, dst_address, dst_port), full (default) for all of them. Ignored when fields is given.",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
+		SummaryFields: []string{"_id", "name", "ruleset", "rule_index", "action", "enabled", "protocol", "src_address", "dst_address", "dst_port"},
 	},
 	{
 		Name:        "create_firewall_rule",
@@ -1938,10 +3625,19 @@ var AllToolMetadata = []ToolMetadata{
 					"pattern": "[\\d\\w]+",
 					"items":   map[string]any{"type": "string"},
 				},
+				"dst_firewallgroup_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the FirewallGroup objects for dst_firewallgroup_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
 				"dst_networkconf_id": map[string]any{
 					"type":    "string",
 					"pattern": "[\\d\\w]+|^$",
 				},
+				"dst_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for dst_networkconf_id, in place of its ID",
+				},
 				"dst_networkconf_type": map[string]any{
 					"type":        "string",
 					"description": "One of: ADDRv4|NETv4",
@@ -2011,6 +3707,11 @@ var AllToolMetadata = []ToolMetadata{
 					"pattern": "[\\d\\w]+",
 					"items":   map[string]any{"type": "string"},
 				},
+				"src_firewallgroup_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the FirewallGroup objects for src_firewallgroup_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
 				"src_mac_address": map[string]any{
 					"type":    "string",
 					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$|^$",
@@ -2019,6 +3720,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":    "string",
 					"pattern": "[\\d\\w]+|^$",
 				},
+				"src_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for src_networkconf_id, in place of its ID",
+				},
 				"src_networkconf_type": map[string]any{
 					"type":        "string",
 					"description": "One of: ADDRv4|NETv4",
@@ -2039,12 +3744,31 @@ var AllToolMetadata = []ToolMetadata{
 				"state_related": map[string]any{
 					"type": "boolean",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"dst_firewallgroup_names": "dst_firewallgroup_ids",
+			"dst_networkconf_name":    "dst_networkconf_id",
+			"src_firewallgroup_names": "src_firewallgroup_ids",
+			"src_networkconf_name":    "src_networkconf_id",
+		},
 	},
 	{
 		Name:        "update_firewall_rule",
@@ -2060,7 +3784,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"action": map[string]any{
 					"type":        "string",
@@ -2090,10 +3814,19 @@ var AllToolMetadata = []ToolMetadata{
 					"pattern": "[\\d\\w]+",
 					"items":   map[string]any{"type": "string"},
 				},
+				"dst_firewallgroup_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the FirewallGroup objects for dst_firewallgroup_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
 				"dst_networkconf_id": map[string]any{
 					"type":    "string",
 					"pattern": "[\\d\\w]+|^$",
 				},
+				"dst_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for dst_networkconf_id, in place of its ID",
+				},
 				"dst_networkconf_type": map[string]any{
 					"type":        "string",
 					"description": "One of: ADDRv4|NETv4",
@@ -2163,6 +3896,11 @@ var AllToolMetadata = []ToolMetadata{
 					"pattern": "[\\d\\w]+",
 					"items":   map[string]any{"type": "string"},
 				},
+				"src_firewallgroup_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the FirewallGroup objects for src_firewallgroup_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
 				"src_mac_address": map[string]any{
 					"type":    "string",
 					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$|^$",
@@ -2171,6 +3909,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":    "string",
 					"pattern": "[\\d\\w]+|^$",
 				},
+				"src_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for src_networkconf_id, in place of its ID",
+				},
 				"src_networkconf_type": map[string]any{
 					"type":        "string",
 					"description": "One of: ADDRv4|NETv4",
@@ -2191,12 +3933,202 @@ var AllToolMetadata = []ToolMetadata{
 				"state_related": map[string]any{
 					"type": "boolean",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+		NameInputs: map[string]string{
+			"dst_firewallgroup_names": "dst_firewallgroup_ids",
+			"dst_networkconf_name":    "dst_networkconf_id",
+			"src_firewallgroup_names": "src_firewallgroup_ids",
+			"src_networkconf_name":    "src_networkconf_id",
+		},
+	},
+	{
+		Name:        "upsert_firewall_rule",
+		Description: "Create or update FirewallRule matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "FirewallRule",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing FirewallRule (default: [\"name\"]). One match is updated, none creates a new FirewallRule, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"action": map[string]any{
+					"type":        "string",
+					"description": "One of: drop|reject|accept",
+					"enum":        []any{"drop", "reject", "accept"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"dst_address": map[string]any{
+					"type": "string",
+				},
+				"dst_address_ipv6": map[string]any{
+					"type": "string",
+				},
+				"dst_firewallgroup_ids": map[string]any{
+					"type":    "array",
+					"pattern": "[\\d\\w]+",
+					"items":   map[string]any{"type": "string"},
+				},
+				"dst_firewallgroup_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the FirewallGroup objects for dst_firewallgroup_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"dst_networkconf_id": map[string]any{
+					"type":    "string",
+					"pattern": "[\\d\\w]+|^$",
+				},
+				"dst_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for dst_networkconf_id, in place of its ID",
+				},
+				"dst_networkconf_type": map[string]any{
+					"type":        "string",
+					"description": "One of: ADDRv4|NETv4",
+					"enum":        []any{"ADDRv4", "NETv4"},
+				},
+				"dst_port": map[string]any{
+					"type": "string",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"icmp_typename": map[string]any{
+					"type":    "string",
+					"pattern": "^$|address-mask-reply|address-mask-request|any|communication-prohibited|destination-unreachable|echo-reply|echo-request|fragmentation-needed|host-precedence-violation|host-prohibited|host-redirect|host-unknown|host-unreachable|ip-header-bad|network-prohibited|network-redirect|network-unknown|network-unreachable|parameter-problem|port-unreachable|precedence-cutoff|protocol-unreachable|redirect|required-option-missing|router-advertisement|router-solicitation|source-quench|source-route-failed|time-exceeded|timestamp-reply|timestamp-request|TOS-host-redirect|TOS-host-unreachable|TOS-network-redirect|TOS-network-unreachable|ttl-zero-during-reassembly|ttl-zero-during-transit",
+				},
+				"icmpv6_typename": map[string]any{
+					"type":    "string",
+					"pattern": "^$|address-unreachable|bad-header|beyond-scope|communication-prohibited|destination-unreachable|echo-reply|echo-request|failed-policy|neighbor-advertisement|neighbor-solicitation|no-route|packet-too-big|parameter-problem|port-unreachable|redirect|reject-route|router-advertisement|router-solicitation|time-exceeded|ttl-zero-during-reassembly|ttl-zero-during-transit|unknown-header-type|unknown-option",
+				},
+				"ipsec": map[string]any{
+					"type":    "string",
+					"pattern": "match-ipsec|match-none|^$",
+				},
+				"logging": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
+				},
+				"protocol": map[string]any{
+					"type":    "string",
+					"pattern": "^$|all|([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])|tcp_udp|ah|ax.25|dccp|ddp|egp|eigrp|encap|esp|etherip|fc|ggp|gre|hip|hmp|icmp|idpr-cmtp|idrp|igmp|igp|ip|ipcomp|ipencap|ipip|ipv6|ipv6-frag|ipv6-icmp|ipv6-nonxt|ipv6-opts|ipv6-route|isis|iso-tp4|l2tp|manet|mobility-header|mpls-in-ip|ospf|pim|pup|rdp|rohc|rspf|rsvp|sctp|shim6|skip|st|tcp|udp|udplite|vmtp|vrrp|wesp|xns-idp|xtp",
+				},
+				"protocol_match_excepted": map[string]any{
+					"type": "boolean",
+				},
+				"protocol_v6": map[string]any{
+					"type":    "string",
+					"pattern": "^$|([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])|ah|all|dccp|eigrp|esp|gre|icmpv6|ipcomp|ipv6|ipv6-frag|ipv6-icmp|ipv6-nonxt|ipv6-opts|ipv6-route|isis|l2tp|manet|mobility-header|mpls-in-ip|ospf|pim|rsvp|sctp|shim6|tcp|tcp_udp|udp|vrrp",
+				},
+				"rule_index": map[string]any{
+					"type":    "integer",
+					"pattern": "2[0-9]{3,4}|4[0-9]{3,4}",
+				},
+				"ruleset": map[string]any{
+					"type":        "string",
+					"description": "One of: WAN_IN|WAN_OUT|WAN_LOCAL|LAN_IN|LAN_OUT|LAN_LOCAL|GUEST_IN|GUEST_OUT|GUEST_LOCAL|WANv6_IN|WANv6_OUT|WANv6_LOCAL|LANv6_IN|LANv6_OUT|LANv6_LOCAL|GUESTv6_IN|GUESTv6_OUT|GUESTv6_LOCAL",
+					"enum":        []any{"WAN_IN", "WAN_OUT", "WAN_LOCAL", "LAN_IN", "LAN_OUT", "LAN_LOCAL", "GUEST_IN", "GUEST_OUT", "GUEST_LOCAL", "WANv6_IN", "WANv6_OUT", "WANv6_LOCAL", "LANv6_IN", "LANv6_OUT", "LANv6_LOCAL", "GUESTv6_IN", "GUESTv6_OUT", "GUESTv6_LOCAL"},
+				},
+				"setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"src_address": map[string]any{
+					"type": "string",
+				},
+				"src_address_ipv6": map[string]any{
+					"type": "string",
+				},
+				"src_firewallgroup_ids": map[string]any{
+					"type":    "array",
+					"pattern": "[\\d\\w]+",
+					"items":   map[string]any{"type": "string"},
+				},
+				"src_firewallgroup_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the FirewallGroup objects for src_firewallgroup_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"src_mac_address": map[string]any{
+					"type":    "string",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$|^$",
+				},
+				"src_networkconf_id": map[string]any{
+					"type":    "string",
+					"pattern": "[\\d\\w]+|^$",
+				},
+				"src_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for src_networkconf_id, in place of its ID",
+				},
+				"src_networkconf_type": map[string]any{
+					"type":        "string",
+					"description": "One of: ADDRv4|NETv4",
+					"enum":        []any{"ADDRv4", "NETv4"},
+				},
+				"src_port": map[string]any{
+					"type": "string",
+				},
+				"state_established": map[string]any{
+					"type": "boolean",
+				},
+				"state_invalid": map[string]any{
+					"type": "boolean",
+				},
+				"state_new": map[string]any{
+					"type": "boolean",
+				},
+				"state_related": map[string]any{
+					"type": "boolean",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
+		},
+		NameInputs: map[string]string{
+			"dst_firewallgroup_names": "dst_firewallgroup_ids",
+			"dst_networkconf_name":    "dst_networkconf_id",
+			"src_firewallgroup_names": "src_firewallgroup_ids",
+			"src_networkconf_name":    "src_networkconf_id",
 		},
 	},
 	{
@@ -2213,10 +4145,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the FirewallRule by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -2233,17 +4168,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -2266,14 +4258,35 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the FirewallZone by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -2307,8 +4320,26 @@ var AllToolMetadata = []ToolMetadata{
 					"type":  "array",
 					"items": map[string]any{"type": "string"},
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"network_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for network_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -2316,6 +4347,9 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"network_names": "network_ids",
+		},
 	},
 	{
 		Name:        "update_firewall_zone",
@@ -2331,7 +4365,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -2352,15 +4386,84 @@ var AllToolMetadata = []ToolMetadata{
 					"type":  "array",
 					"items": map[string]any{"type": "string"},
 				},
+				"network_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for network_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
+		},
+		NameInputs: map[string]string{
+			"network_names": "network_ids",
+		},
+	},
+	{
+		Name:        "upsert_firewall_zone",
+		Description: "Create or update FirewallZone matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "FirewallZone",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing FirewallZone (default: [\"name\"]). One match is updated, none creates a new FirewallZone, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"network_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"network_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for network_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+		NameInputs: map[string]string{
+			"network_names": "network_ids",
 		},
 	},
 	{
@@ -2377,10 +4480,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the FirewallZone by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -2397,17 +4503,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -2430,14 +4593,35 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the FirewallZonePolicy by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -2535,6 +4719,19 @@ var AllToolMetadata = []ToolMetadata{
 				"source": map[string]any{
 					"type": "object",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -2556,7 +4753,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"action": map[string]any{
 					"type":        "string",
@@ -2641,12 +4838,126 @@ var AllToolMetadata = []ToolMetadata{
 				"source": map[string]any{
 					"type": "object",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_firewall_zone_policy",
+		Description: "Create or update FirewallZonePolicy matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "FirewallZonePolicy",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing FirewallZonePolicy (default: [\"name\"]). One match is updated, none creates a new FirewallZonePolicy, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"action": map[string]any{
+					"type":        "string",
+					"description": "One of: ALLOW|BLOCK|REJECT",
+					"enum":        []any{"ALLOW", "BLOCK", "REJECT"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"connection_state_type": map[string]any{
+					"type":        "string",
+					"description": "One of: ALL|RESPOND_ONLY|CUSTOM",
+					"enum":        []any{"ALL", "RESPOND_ONLY", "CUSTOM"},
+				},
+				"connection_states": map[string]any{
+					"type":        "array",
+					"description": "One of: ESTABLISHED|NEW|RELATED|INVALID",
+					"enum":        []any{"ESTABLISHED", "NEW", "RELATED", "INVALID"},
+					"items":       map[string]any{"type": "string"},
+				},
+				"create_allow_respond": map[string]any{
+					"type": "boolean",
+				},
+				"description": map[string]any{
+					"type": "string",
+				},
+				"destination": map[string]any{
+					"type": "object",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"index": map[string]any{
+					"type":    "integer",
+					"pattern": "^[0-9][0-9]?$|^",
+				},
+				"ip_version": map[string]any{
+					"type":        "string",
+					"description": "One of: BOTH|IPV4|IPV6",
+					"enum":        []any{"BOTH", "IPV4", "IPV6"},
+				},
+				"logging": map[string]any{
+					"type": "boolean",
+				},
+				"match_ip_sec": map[string]any{
+					"type": "boolean",
+				},
+				"match_ip_sec_type": map[string]any{
+					"type":        "string",
+					"description": "One of: MATCH_IP_SEC|MATCH_NON_IP_SEC",
+					"enum":        []any{"MATCH_IP_SEC", "MATCH_NON_IP_SEC"},
+				},
+				"match_opposite_protocol": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"predefined": map[string]any{
+					"type": "boolean",
+				},
+				"protocol": map[string]any{
+					"type":        "string",
+					"description": "One of: all|tcp_udp|tcp|udp|ah|dccp|eigrp|esp|gre|icmp|icmpv6|igmp|igp|ip|ipcomp|ipip|ipv6|isis|l2tp|manet|mobility-header|mpls-in-ip|number|ospf|pim|pup|rdp|rohc|rspf|rcvp|sctp|shim6|skip|st|vmtp|vrrp|wesp|xtp",
+					"enum":        []any{"all", "tcp_udp", "tcp", "udp", "ah", "dccp", "eigrp", "esp", "gre", "icmp", "icmpv6", "igmp", "igp", "ip", "ipcomp", "ipip", "ipv6", "isis", "l2tp", "manet", "mobility-header", "mpls-in-ip", "number", "ospf", "pim", "pup", "rdp", "rohc", "rspf", "rcvp", "sctp", "shim6", "skip", "st", "vmtp", "vrrp", "wesp", "xtp"},
+				},
+				"schedule": map[string]any{
+					"type": "object",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"source": map[string]any{
+					"type": "object",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -2663,10 +4974,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the FirewallZonePolicy by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -2683,17 +4997,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -2716,14 +5087,35 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
-				"resolve": map[string]any{
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the HeatMap by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -2756,6 +5148,10 @@ var AllToolMetadata = []ToolMetadata{
 				"map_id": map[string]any{
 					"type": "string",
 				},
+				"map_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Map for map_id, in place of its ID",
+				},
 				"name": map[string]any{
 					"type":    "string",
 					"pattern": ".*[^\\s]+.*",
@@ -2768,12 +5164,28 @@ var AllToolMetadata = []ToolMetadata{
 					"description": "One of: download|upload",
 					"enum":        []any{"download", "upload"},
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"map_name": "map_id",
+		},
 	},
 	{
 		Name:        "update_heat_map",
@@ -2789,7 +5201,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -2809,6 +5221,10 @@ var AllToolMetadata = []ToolMetadata{
 				"map_id": map[string]any{
 					"type": "string",
 				},
+				"map_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Map for map_id, in place of its ID",
+				},
 				"name": map[string]any{
 					"type":    "string",
 					"pattern": ".*[^\\s]+.*",
@@ -2821,12 +5237,83 @@ var AllToolMetadata = []ToolMetadata{
 					"description": "One of: download|upload",
 					"enum":        []any{"download", "upload"},
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
+		},
+		NameInputs: map[string]string{
+			"map_name": "map_id",
+		},
+	},
+	{
+		Name:        "upsert_heat_map",
+		Description: "Create or update HeatMap matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "HeatMap",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing HeatMap (default: [\"name\"]). One match is updated, none creates a new HeatMap, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"description": map[string]any{
+					"type": "string",
+				},
+				"map_id": map[string]any{
+					"type": "string",
+				},
+				"map_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Map for map_id, in place of its ID",
+				},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".*[^\\s]+.*",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"type": map[string]any{
+					"type":        "string",
+					"description": "One of: download|upload",
+					"enum":        []any{"download", "upload"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+		NameInputs: map[string]string{
+			"map_name": "map_id",
 		},
 	},
 	{
@@ -2843,10 +5330,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the HeatMap by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -2863,17 +5353,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -2898,6 +5445,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "Resource ID",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -2936,6 +5501,10 @@ var AllToolMetadata = []ToolMetadata{
 				"heatmap_id": map[string]any{
 					"type": "string",
 				},
+				"heatmap_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the HeatMap for heatmap_id, in place of its ID",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
@@ -2948,12 +5517,28 @@ var AllToolMetadata = []ToolMetadata{
 				"y": map[string]any{
 					"type": "number",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"heatmap_name": "heatmap_id",
+		},
 	},
 	{
 		Name:        "update_heat_map_point",
@@ -2989,6 +5574,10 @@ var AllToolMetadata = []ToolMetadata{
 				"heatmap_id": map[string]any{
 					"type": "string",
 				},
+				"heatmap_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the HeatMap for heatmap_id, in place of its ID",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
@@ -3001,6 +5590,10 @@ var AllToolMetadata = []ToolMetadata{
 				"y": map[string]any{
 					"type": "number",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -3008,6 +5601,74 @@ var AllToolMetadata = []ToolMetadata{
 			},
 			"required": []any{"id"},
 		},
+		NameInputs: map[string]string{
+			"heatmap_name": "heatmap_id",
+		},
+	},
+	{
+		Name:        "upsert_heat_map_point",
+		Description: "Create or update HeatMapPoint matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "HeatMapPoint",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing HeatMapPoint (default: [\"name\"]). One match is updated, none creates a new HeatMapPoint, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"download_speed": map[string]any{
+					"type": "number",
+				},
+				"heatmap_id": map[string]any{
+					"type": "string",
+				},
+				"heatmap_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the HeatMap for heatmap_id, in place of its ID",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"upload_speed": map[string]any{
+					"type": "number",
+				},
+				"x": map[string]any{
+					"type": "number",
+				},
+				"y": map[string]any{
+					"type": "number",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+		NameInputs: map[string]string{
+			"heatmap_name": "heatmap_id",
+		},
 	},
 	{
 		Name:        "delete_heat_map_point",
@@ -3043,17 +5704,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -3076,14 +5794,35 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Hotspot2Conf by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -3294,6 +6033,19 @@ var AllToolMetadata = []ToolMetadata{
 					"description": "One of: 0|1|2|3|4|5|6|7|8|9|10|11|12|13|14|15",
 					"enum":        []any{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"},
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -3315,7 +6067,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"anqp_domain_id": map[string]any{
 					"type":    "integer",
@@ -3513,18 +6265,21 @@ var AllToolMetadata = []ToolMetadata{
 					"description": "One of: 0|1|2|3|4|5|6|7|8|9|10|11|12|13|14|15",
 					"enum":        []any{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"},
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_hotspot_2_conf",
-		Description: "Delete Hotspot2Conf by ID",
-		Category:    "delete",
+		Name:        "upsert_hotspot_2_conf",
+		Description: "Create or update Hotspot2Conf matched by natural key fields",
+		Category:    "upsert",
 		Resource:    "Hotspot2Conf",
 		InputSchema: map[string]any{
 			"type": "object",
@@ -3533,171 +6288,223 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID to delete",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing Hotspot2Conf (default: [\"name\"]). One match is updated, none creates a new Hotspot2Conf, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "list_hotspot_op",
-		Description: "List all HotspotOp resources",
-		Category:    "list",
-		Resource:    "HotspotOp",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
-				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
+				"anqp_domain_id": map[string]any{
+					"type":    "integer",
+					"pattern": "^0|[1-9][0-9]{0,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]|$",
 				},
-				"fields": map[string]any{
-					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
-					"items":       map[string]any{"type": "string"},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"search": map[string]any{
-					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_hotspot_op",
-		Description: "Get HotspotOp by ID",
-		Category:    "get",
-		Resource:    "HotspotOp",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
+				"capab": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"cellular_network_list": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "create_hotspot_op",
-		Description: "Create new HotspotOp",
-		Category:    "create",
-		Resource:    "HotspotOp",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"deauth_req_timeout": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9][0-9]|[1-9][0-9][0-9]|[1-2][0-9][0-9][0-9]|3[0-5][0-9][0-9]|3600",
 				},
-				"attr_hidden": map[string]any{
+				"disable_dgaf": map[string]any{
 					"type": "boolean",
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
+				"domain_name_list": map[string]any{
+					"type":    "array",
+					"pattern": ".{1,128}",
+					"items":   map[string]any{"type": "string"},
 				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
+				"friendly_name": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"attr_no_edit": map[string]any{
+				"gas_advanced": map[string]any{
 					"type": "boolean",
 				},
-				"name": map[string]any{
+				"gas_comeback_delay": map[string]any{
+					"type": "integer",
+				},
+				"gas_frag_limit": map[string]any{
+					"type": "integer",
+				},
+				"hessid": map[string]any{
 					"type":    "string",
-					"pattern": ".{1,256}",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$|^$",
 				},
-				"note": map[string]any{
-					"type": "string",
+				"hessid_used": map[string]any{
+					"type": "boolean",
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"icons": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"x_password": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,256}",
+				"ipaddr_type_avail_v4": map[string]any{
+					"type":        "integer",
+					"description": "One of: 0|1|2|3|4|5|6|7",
+					"enum":        []any{"0", "1", "2", "3", "4", "5", "6", "7"},
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"ipaddr_type_avail_v6": map[string]any{
+					"type":        "integer",
+					"description": "One of: 0|1|2",
+					"enum":        []any{"0", "1", "2"},
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_hotspot_op",
-		Description: "Update HotspotOp by ID",
-		Category:    "update",
-		Resource:    "HotspotOp",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"metrics_downlink_load": map[string]any{
+					"type": "integer",
 				},
-				"id": map[string]any{
+				"metrics_downlink_load_set": map[string]any{
+					"type": "boolean",
+				},
+				"metrics_downlink_speed": map[string]any{
+					"type": "integer",
+				},
+				"metrics_downlink_speed_set": map[string]any{
+					"type": "boolean",
+				},
+				"metrics_info_at_capacity": map[string]any{
+					"type": "boolean",
+				},
+				"metrics_info_link_status": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "One of: up|down|test",
+					"enum":        []any{"up", "down", "test"},
 				},
-				"attr_hidden": map[string]any{
+				"metrics_info_symmetric": map[string]any{
 					"type": "boolean",
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
+				"metrics_measurement": map[string]any{
+					"type": "integer",
 				},
-				"attr_no_delete": map[string]any{
+				"metrics_measurement_set": map[string]any{
 					"type": "boolean",
 				},
-				"attr_no_edit": map[string]any{
+				"metrics_status": map[string]any{
+					"type": "boolean",
+				},
+				"metrics_uplink_load": map[string]any{
+					"type": "integer",
+				},
+				"metrics_uplink_load_set": map[string]any{
+					"type": "boolean",
+				},
+				"metrics_uplink_speed": map[string]any{
+					"type": "integer",
+				},
+				"metrics_uplink_speed_set": map[string]any{
 					"type": "boolean",
 				},
+				"nai_realm_list": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
 				"name": map[string]any{
 					"type":    "string",
-					"pattern": ".{1,256}",
+					"pattern": ".{1,128}",
 				},
-				"note": map[string]any{
+				"network_access_asra": map[string]any{
+					"type": "boolean",
+				},
+				"network_access_esr": map[string]any{
+					"type": "boolean",
+				},
+				"network_access_internet": map[string]any{
+					"type": "boolean",
+				},
+				"network_access_uesa": map[string]any{
+					"type": "boolean",
+				},
+				"network_auth_type": map[string]any{
+					"type":        "integer",
+					"description": "One of: -1|0|1|2|3",
+					"enum":        []any{"-1", "0", "1", "2", "3"},
+				},
+				"network_auth_url": map[string]any{
+					"type": "string",
+				},
+				"network_type": map[string]any{
+					"type":        "integer",
+					"description": "One of: 0|1|2|3|4|5|14|15",
+					"enum":        []any{"0", "1", "2", "3", "4", "5", "14", "15"},
+				},
+				"osu": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"osu_ssid": map[string]any{
+					"type": "string",
+				},
+				"qos_map_dcsp": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"qos_map_exceptions": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"qos_map_status": map[string]any{
+					"type": "boolean",
+				},
+				"roaming_consortium_list": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"save_timestamp": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"x_password": map[string]any{
+				"t_c_filename": map[string]any{
 					"type":    "string",
 					"pattern": ".{1,256}",
 				},
+				"t_c_timestamp": map[string]any{
+					"type": "integer",
+				},
+				"venue_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 0|1|2|3|4|5|6|7|8|9|10|11",
+					"enum":        []any{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"},
+				},
+				"venue_name": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"venue_type": map[string]any{
+					"type":        "integer",
+					"description": "One of: 0|1|2|3|4|5|6|7|8|9|10|11|12|13|14|15",
+					"enum":        []any{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_hotspot_op",
-		Description: "Delete HotspotOp by ID",
+		Name:        "delete_hotspot_2_conf",
+		Description: "Delete Hotspot2Conf by ID",
 		Category:    "delete",
-		Resource:    "HotspotOp",
+		Resource:    "Hotspot2Conf",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -3707,17 +6514,20 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Hotspot2Conf by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "list_hotspot_package",
-		Description: "List all HotspotPackage resources",
+		Name:        "list_hotspot_op",
+		Description: "List all HotspotOp resources",
 		Category:    "list",
-		Resource:    "HotspotPackage",
+		Resource:    "HotspotOp",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -3727,17 +6537,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -3747,10 +6614,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_hotspot_package",
-		Description: "Get HotspotPackage by ID",
+		Name:        "get_hotspot_op",
+		Description: "Get HotspotOp by ID",
 		Category:    "get",
-		Resource:    "HotspotPackage",
+		Resource:    "HotspotOp",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -3760,21 +6627,42 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the HotspotOp by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "create_hotspot_package",
-		Description: "Create new HotspotPackage",
+		Name:        "create_hotspot_op",
+		Description: "Create new HotspotOp",
 		Category:    "create",
-		Resource:    "HotspotPackage",
+		Resource:    "HotspotOp",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -3782,9 +6670,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"amount": map[string]any{
-					"type": "number",
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -3797,93 +6682,85 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"charged_as": map[string]any{
-					"type": "string",
-				},
-				"currency": map[string]any{
+				"name": map[string]any{
 					"type":    "string",
-					"pattern": "[A-Z]{3}",
-				},
-				"custom_payment_fields_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"hours": map[string]any{
-					"type": "integer",
-				},
-				"index": map[string]any{
-					"type": "integer",
-				},
-				"limit_down": map[string]any{
-					"type": "integer",
-				},
-				"limit_overwrite": map[string]any{
-					"type": "boolean",
-				},
-				"limit_quota": map[string]any{
-					"type": "integer",
-				},
-				"limit_up": map[string]any{
-					"type": "integer",
+					"pattern": ".{1,256}",
 				},
-				"name": map[string]any{
+				"note": map[string]any{
 					"type": "string",
 				},
-				"payment_fields_address_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"payment_fields_address_required": map[string]any{
-					"type": "boolean",
-				},
-				"payment_fields_city_enabled": map[string]any{
-					"type": "boolean",
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"payment_fields_city_required": map[string]any{
-					"type": "boolean",
+				"x_password": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
 				},
-				"payment_fields_country_enabled": map[string]any{
-					"type": "boolean",
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"payment_fields_country_required": map[string]any{
-					"type": "boolean",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
 				},
-				"payment_fields_email_enabled": map[string]any{
-					"type": "boolean",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"payment_fields_email_required": map[string]any{
-					"type": "boolean",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"payment_fields_first_name_enabled": map[string]any{
-					"type": "boolean",
+			},
+		},
+	},
+	{
+		Name:        "update_hotspot_op",
+		Description: "Update HotspotOp by ID",
+		Category:    "update",
+		Resource:    "HotspotOp",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"payment_fields_first_name_required": map[string]any{
-					"type": "boolean",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
 				},
-				"payment_fields_last_name_enabled": map[string]any{
+				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
-				"payment_fields_last_name_required": map[string]any{
-					"type": "boolean",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"payment_fields_state_enabled": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"payment_fields_state_required": map[string]any{
+				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"payment_fields_zip_enabled": map[string]any{
-					"type": "boolean",
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
 				},
-				"payment_fields_zip_required": map[string]any{
-					"type": "boolean",
+				"note": map[string]any{
+					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"trial_duration_minutes": map[string]any{
-					"type": "integer",
+				"x_password": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
 				},
-				"trial_reset": map[string]any{
-					"type": "number",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -3893,10 +6770,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_hotspot_package",
-		Description: "Update HotspotPackage by ID",
-		Category:    "update",
-		Resource:    "HotspotPackage",
+		Name:        "upsert_hotspot_op",
+		Description: "Create or update HotspotOp matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "HotspotOp",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -3904,12 +6781,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
-				},
-				"amount": map[string]any{
-					"type": "number",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing HotspotOp (default: [\"name\"]). One match is updated, none creates a new HotspotOp, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -3923,106 +6798,148 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"charged_as": map[string]any{
-					"type": "string",
-				},
-				"currency": map[string]any{
+				"name": map[string]any{
 					"type":    "string",
-					"pattern": "[A-Z]{3}",
+					"pattern": ".{1,256}",
 				},
-				"custom_payment_fields_enabled": map[string]any{
-					"type": "boolean",
+				"note": map[string]any{
+					"type": "string",
 				},
-				"hours": map[string]any{
-					"type": "integer",
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"index": map[string]any{
-					"type": "integer",
+				"x_password": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
 				},
-				"limit_down": map[string]any{
-					"type": "integer",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"limit_overwrite": map[string]any{
-					"type": "boolean",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"limit_quota": map[string]any{
-					"type": "integer",
+			},
+		},
+	},
+	{
+		Name:        "delete_hotspot_op",
+		Description: "Delete HotspotOp by ID",
+		Category:    "delete",
+		Resource:    "HotspotOp",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"limit_up": map[string]any{
-					"type": "integer",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name)",
 				},
 				"name": map[string]any{
-					"type": "string",
+					"type":        "string",
+					"description": "Select the HotspotOp by name instead of id",
 				},
-				"payment_fields_address_enabled": map[string]any{
-					"type": "boolean",
+			},
+		},
+	},
+	{
+		Name:        "list_hotspot_package",
+		Description: "List all HotspotPackage resources",
+		Category:    "list",
+		Resource:    "HotspotPackage",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"payment_fields_address_required": map[string]any{
-					"type": "boolean",
-				},
-				"payment_fields_city_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"payment_fields_city_required": map[string]any{
-					"type": "boolean",
-				},
-				"payment_fields_country_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"payment_fields_country_required": map[string]any{
-					"type": "boolean",
-				},
-				"payment_fields_email_enabled": map[string]any{
-					"type": "boolean",
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
 				},
-				"payment_fields_email_required": map[string]any{
-					"type": "boolean",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"payment_fields_first_name_enabled": map[string]any{
-					"type": "boolean",
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
 				},
-				"payment_fields_first_name_required": map[string]any{
-					"type": "boolean",
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"payment_fields_last_name_enabled": map[string]any{
-					"type": "boolean",
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
 				},
-				"payment_fields_last_name_required": map[string]any{
-					"type": "boolean",
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
 				},
-				"payment_fields_state_enabled": map[string]any{
-					"type": "boolean",
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"payment_fields_state_required": map[string]any{
-					"type": "boolean",
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"payment_fields_zip_enabled": map[string]any{
-					"type": "boolean",
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"payment_fields_zip_required": map[string]any{
-					"type": "boolean",
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
 				},
-				"trial_duration_minutes": map[string]any{
-					"type": "integer",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"trial_reset": map[string]any{
-					"type": "number",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_hotspot_package",
-		Description: "Delete HotspotPackage by ID",
-		Category:    "delete",
+		Name:        "get_hotspot_package",
+		Description: "Get HotspotPackage by ID",
+		Category:    "get",
 		Resource:    "HotspotPackage",
 		InputSchema: map[string]any{
 			"type": "object",
@@ -4033,74 +6950,42 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID (omit to select by name)",
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "list_map",
-		Description: "List all Map resources",
-		Category:    "list",
-		Resource:    "Map",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"name": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
-				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
+					"description": "Select the HotspotPackage by name instead of id",
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
 					"items":       map[string]any{"type": "string"},
 				},
-				"search": map[string]any{
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
-				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_map",
-		Description: "Get Map by ID",
-		Category:    "get",
-		Resource:    "Map",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"format": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "create_map",
-		Description: "Create new Map",
+		Name:        "create_hotspot_package",
+		Description: "Create new HotspotPackage",
 		Category:    "create",
-		Resource:    "Map",
+		Resource:    "HotspotPackage",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -4108,6 +6993,9 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"amount": map[string]any{
+					"type": "number",
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -4120,56 +7008,106 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"lat": map[string]any{
-					"type":    "string",
-					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+				"charged_as": map[string]any{
+					"type": "string",
 				},
-				"lng": map[string]any{
+				"currency": map[string]any{
 					"type":    "string",
-					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+					"pattern": "[A-Z]{3}",
 				},
-				"mapTypeId": map[string]any{
-					"type":        "string",
-					"description": "One of: satellite|roadmap|hybrid|terrain",
-					"enum":        []any{"satellite", "roadmap", "hybrid", "terrain"},
+				"custom_payment_fields_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"hours": map[string]any{
+					"type": "integer",
+				},
+				"index": map[string]any{
+					"type": "integer",
+				},
+				"limit_down": map[string]any{
+					"type": "integer",
+				},
+				"limit_overwrite": map[string]any{
+					"type": "boolean",
+				},
+				"limit_quota": map[string]any{
+					"type": "integer",
+				},
+				"limit_up": map[string]any{
+					"type": "integer",
 				},
 				"name": map[string]any{
 					"type": "string",
 				},
-				"offset_left": map[string]any{
-					"type": "number",
+				"payment_fields_address_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"offset_top": map[string]any{
-					"type": "number",
+				"payment_fields_address_required": map[string]any{
+					"type": "boolean",
 				},
-				"opacity": map[string]any{
-					"type":    "number",
-					"pattern": "^(0(\\.[\\d]{1,2})?|1)$|^$",
+				"payment_fields_city_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"selected": map[string]any{
+				"payment_fields_city_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_country_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_country_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_email_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_email_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_first_name_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_first_name_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_last_name_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_last_name_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_state_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_state_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_zip_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_zip_required": map[string]any{
 					"type": "boolean",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"tilt": map[string]any{
+				"trial_duration_minutes": map[string]any{
 					"type": "integer",
 				},
-				"type": map[string]any{
-					"type":        "string",
-					"description": "One of: designerMap|imageMap|googleMap",
-					"enum":        []any{"designerMap", "imageMap", "googleMap"},
+				"trial_reset": map[string]any{
+					"type": "number",
 				},
-				"unit": map[string]any{
+				"idempotency_key": map[string]any{
 					"type":        "string",
-					"description": "One of: m|f",
-					"enum":        []any{"m", "f"},
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"upp": map[string]any{
-					"type": "number",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
 				},
-				"zoom": map[string]any{
-					"type": "integer",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -4179,10 +7117,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_map",
-		Description: "Update Map by ID",
+		Name:        "update_hotspot_package",
+		Description: "Update HotspotPackage by ID",
 		Category:    "update",
-		Resource:    "Map",
+		Resource:    "HotspotPackage",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -4192,7 +7130,10 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"amount": map[string]any{
+					"type": "number",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -4206,70 +7147,241 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"lat": map[string]any{
-					"type":    "string",
-					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+				"charged_as": map[string]any{
+					"type": "string",
 				},
-				"lng": map[string]any{
+				"currency": map[string]any{
 					"type":    "string",
-					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+					"pattern": "[A-Z]{3}",
 				},
-				"mapTypeId": map[string]any{
-					"type":        "string",
-					"description": "One of: satellite|roadmap|hybrid|terrain",
-					"enum":        []any{"satellite", "roadmap", "hybrid", "terrain"},
+				"custom_payment_fields_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"name": map[string]any{
-					"type": "string",
+				"hours": map[string]any{
+					"type": "integer",
 				},
-				"offset_left": map[string]any{
-					"type": "number",
+				"index": map[string]any{
+					"type": "integer",
 				},
-				"offset_top": map[string]any{
-					"type": "number",
+				"limit_down": map[string]any{
+					"type": "integer",
 				},
-				"opacity": map[string]any{
-					"type":    "number",
-					"pattern": "^(0(\\.[\\d]{1,2})?|1)$|^$",
+				"limit_overwrite": map[string]any{
+					"type": "boolean",
 				},
-				"selected": map[string]any{
+				"limit_quota": map[string]any{
+					"type": "integer",
+				},
+				"limit_up": map[string]any{
+					"type": "integer",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"payment_fields_address_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_address_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_city_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_city_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_country_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_country_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_email_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_email_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_first_name_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_first_name_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_last_name_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_last_name_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_state_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_state_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_zip_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_zip_required": map[string]any{
 					"type": "boolean",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"tilt": map[string]any{
+				"trial_duration_minutes": map[string]any{
 					"type": "integer",
 				},
-				"type": map[string]any{
-					"type":        "string",
-					"description": "One of: designerMap|imageMap|googleMap",
-					"enum":        []any{"designerMap", "imageMap", "googleMap"},
+				"trial_reset": map[string]any{
+					"type": "number",
 				},
-				"unit": map[string]any{
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_hotspot_package",
+		Description: "Create or update HotspotPackage matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "HotspotPackage",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: m|f",
-					"enum":        []any{"m", "f"},
+					"description": "UniFi site name (default: 'default')",
 				},
-				"upp": map[string]any{
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing HotspotPackage (default: [\"name\"]). One match is updated, none creates a new HotspotPackage, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"amount": map[string]any{
 					"type": "number",
 				},
-				"zoom": map[string]any{
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"charged_as": map[string]any{
+					"type": "string",
+				},
+				"currency": map[string]any{
+					"type":    "string",
+					"pattern": "[A-Z]{3}",
+				},
+				"custom_payment_fields_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"hours": map[string]any{
+					"type": "integer",
+				},
+				"index": map[string]any{
+					"type": "integer",
+				},
+				"limit_down": map[string]any{
+					"type": "integer",
+				},
+				"limit_overwrite": map[string]any{
+					"type": "boolean",
+				},
+				"limit_quota": map[string]any{
+					"type": "integer",
+				},
+				"limit_up": map[string]any{
+					"type": "integer",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"payment_fields_address_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_address_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_city_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_city_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_country_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_country_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_email_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_email_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_first_name_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_first_name_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_last_name_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_last_name_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_state_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_state_required": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_zip_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"payment_fields_zip_required": map[string]any{
+					"type": "boolean",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"trial_duration_minutes": map[string]any{
 					"type": "integer",
 				},
+				"trial_reset": map[string]any{
+					"type": "number",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_map",
-		Description: "Delete Map by ID",
+		Name:        "delete_hotspot_package",
+		Description: "Delete HotspotPackage by ID",
 		Category:    "delete",
-		Resource:    "Map",
+		Resource:    "HotspotPackage",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -4279,17 +7391,20 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the HotspotPackage by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "list_media_file",
-		Description: "List all MediaFile resources",
+		Name:        "list_map",
+		Description: "List all Map resources",
 		Category:    "list",
-		Resource:    "MediaFile",
+		Resource:    "Map",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -4299,17 +7414,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -4319,10 +7491,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_media_file",
-		Description: "Get MediaFile by ID",
+		Name:        "get_map",
+		Description: "Get Map by ID",
 		Category:    "get",
-		Resource:    "MediaFile",
+		Resource:    "Map",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -4332,21 +7504,227 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Map by name instead of id",
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "create_media_file",
-		Description: "Create new MediaFile",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "create_map",
+		Description: "Create new Map",
 		Category:    "create",
-		Resource:    "MediaFile",
+		Resource:    "Map",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"lat": map[string]any{
+					"type":    "string",
+					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+				},
+				"lng": map[string]any{
+					"type":    "string",
+					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+				},
+				"mapTypeId": map[string]any{
+					"type":        "string",
+					"description": "One of: satellite|roadmap|hybrid|terrain",
+					"enum":        []any{"satellite", "roadmap", "hybrid", "terrain"},
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"offset_left": map[string]any{
+					"type": "number",
+				},
+				"offset_top": map[string]any{
+					"type": "number",
+				},
+				"opacity": map[string]any{
+					"type":    "number",
+					"pattern": "^(0(\\.[\\d]{1,2})?|1)$|^$",
+				},
+				"selected": map[string]any{
+					"type": "boolean",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"tilt": map[string]any{
+					"type": "integer",
+				},
+				"type": map[string]any{
+					"type":        "string",
+					"description": "One of: designerMap|imageMap|googleMap",
+					"enum":        []any{"designerMap", "imageMap", "googleMap"},
+				},
+				"unit": map[string]any{
+					"type":        "string",
+					"description": "One of: m|f",
+					"enum":        []any{"m", "f"},
+				},
+				"upp": map[string]any{
+					"type": "number",
+				},
+				"zoom": map[string]any{
+					"type": "integer",
+				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "update_map",
+		Description: "Update Map by ID",
+		Category:    "update",
+		Resource:    "Map",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"lat": map[string]any{
+					"type":    "string",
+					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+				},
+				"lng": map[string]any{
+					"type":    "string",
+					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+				},
+				"mapTypeId": map[string]any{
+					"type":        "string",
+					"description": "One of: satellite|roadmap|hybrid|terrain",
+					"enum":        []any{"satellite", "roadmap", "hybrid", "terrain"},
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"offset_left": map[string]any{
+					"type": "number",
+				},
+				"offset_top": map[string]any{
+					"type": "number",
+				},
+				"opacity": map[string]any{
+					"type":    "number",
+					"pattern": "^(0(\\.[\\d]{1,2})?|1)$|^$",
+				},
+				"selected": map[string]any{
+					"type": "boolean",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"tilt": map[string]any{
+					"type": "integer",
+				},
+				"type": map[string]any{
+					"type":        "string",
+					"description": "One of: designerMap|imageMap|googleMap",
+					"enum":        []any{"designerMap", "imageMap", "googleMap"},
+				},
+				"unit": map[string]any{
+					"type":        "string",
+					"description": "One of: m|f",
+					"enum":        []any{"m", "f"},
+				},
+				"upp": map[string]any{
+					"type": "number",
+				},
+				"zoom": map[string]any{
+					"type": "integer",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_map",
+		Description: "Create or update Map matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "Map",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -4354,6 +7732,11 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing Map (default: [\"name\"]). One match is updated, none creates a new Map, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -4366,66 +7749,3359 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
+				"lat": map[string]any{
+					"type":    "string",
+					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+				},
+				"lng": map[string]any{
+					"type":    "string",
+					"pattern": "^([-]?[\\d]+[.]?[\\d]*([eE][-+]?[\\d]+)?)$",
+				},
+				"mapTypeId": map[string]any{
+					"type":        "string",
+					"description": "One of: satellite|roadmap|hybrid|terrain",
+					"enum":        []any{"satellite", "roadmap", "hybrid", "terrain"},
+				},
 				"name": map[string]any{
 					"type": "string",
 				},
+				"offset_left": map[string]any{
+					"type": "number",
+				},
+				"offset_top": map[string]any{
+					"type": "number",
+				},
+				"opacity": map[string]any{
+					"type":    "number",
+					"pattern": "^(0(\\.[\\d]{1,2})?|1)$|^$",
+				},
+				"selected": map[string]any{
+					"type": "boolean",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"tilt": map[string]any{
+					"type": "integer",
+				},
+				"type": map[string]any{
+					"type":        "string",
+					"description": "One of: designerMap|imageMap|googleMap",
+					"enum":        []any{"designerMap", "imageMap", "googleMap"},
+				},
+				"unit": map[string]any{
+					"type":        "string",
+					"description": "One of: m|f",
+					"enum":        []any{"m", "f"},
+				},
+				"upp": map[string]any{
+					"type": "number",
+				},
+				"zoom": map[string]any{
+					"type": "integer",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "delete_map",
+		Description: "Delete Map by ID",
+		Category:    "delete",
+		Resource:    "Map",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Map by name instead of id",
+				},
+			},
+		},
+	},
+	{
+		Name:        "list_media_file",
+		Description: "List all MediaFile resources",
+		Category:    "list",
+		Resource:    "MediaFile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "get_media_file",
+		Description: "Get MediaFile by ID",
+		Category:    "get",
+		Resource:    "MediaFile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the MediaFile by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "create_media_file",
+		Description: "Create new MediaFile",
+		Category:    "create",
+		Resource:    "MediaFile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "update_media_file",
+		Description: "Update MediaFile by ID",
+		Category:    "update",
+		Resource:    "MediaFile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_media_file",
+		Description: "Create or update MediaFile matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "MediaFile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing MediaFile (default: [\"name\"]). One match is updated, none creates a new MediaFile, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "delete_media_file",
+		Description: "Delete MediaFile by ID",
+		Category:    "delete",
+		Resource:    "MediaFile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the MediaFile by name instead of id",
+				},
+			},
+		},
+	},
+	{
+		Name:        "list_network",
+		Description: "List all Network resources",
+		Category:    "list",
+		Resource:    "Network",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
+				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each Network (_id, name, purpose, vlan, ip_subnet, enabled), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+		SummaryFields: []string{"_id", "name", "purpose", "vlan", "ip_subnet", "enabled"},
+	},
+	{
+		Name:        "get_network",
+		Description: "Get Network by ID",
+		Category:    "get",
+		Resource:    "Network",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Network by name instead of id",
+				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the Network (_id, name, purpose, vlan, ip_subnet, enabled), full (default) for all of them. Ignored when fields is given.",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+		SummaryFields: []string{"_id", "name", "purpose", "vlan", "ip_subnet", "enabled"},
+	},
+	{
+		Name:        "create_network",
+		Description: "Create new Network",
+		Category:    "create",
+		Resource:    "Network",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"auto_scale_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcp_relay_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_boot_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_boot_filename": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
+				},
+				"dhcpd_boot_server": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|[a-zA-Z0-9-]{1,63}|^$",
+				},
+				"dhcpd_conflict_checking": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_dns_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_4": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_gateway": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_gateway_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_ip_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ip_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ip_3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_leasetime": map[string]any{
+					"type": "integer",
+				},
+				"dhcpd_mac_1": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"dhcpd_mac_2": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"dhcpd_mac_3": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"dhcpd_ntp_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ntp_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ntp_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_start": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_stop": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_tftp_server": map[string]any{
+					"type": "string",
+				},
+				"dhcpd_time_offset": map[string]any{
+					"type":    "integer",
+					"pattern": "^0$|^-?([1-9]([0-9]{1,3})?|[1-7][0-9]{4}|[8][0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				},
+				"dhcpd_time_offset_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_unifi_controller": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_wins_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_wins_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_wins_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_wpad_url": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_allow_slaac": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpdv6_dns_1": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_2": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_3": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_4": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_auto": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpdv6_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpdv6_leasetime": map[string]any{
+					"type": "integer",
+				},
+				"dhcpdv6_start": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_stop": map[string]any{
+					"type": "string",
+				},
+				"dhcpguard_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"domain_name": map[string]any{
+					"type":    "string",
+					"pattern": "(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^$|[a-zA-Z0-9-]{1,63}",
+				},
+				"dpi_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dpigroup_id": map[string]any{
+					"type":    "string",
+					"pattern": "[\\d\\w]+|^$",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"exposed_to_site_vpn": map[string]any{
+					"type": "boolean",
+				},
+				"firewall_zone_id": map[string]any{
+					"type": "string",
+				},
+				"firewall_zone_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the FirewallZone for firewall_zone_id, in place of its ID",
+				},
+				"gateway_device": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"gateway_type": map[string]any{
+					"type":        "string",
+					"description": "One of: default|switch",
+					"enum":        []any{"default", "switch"},
+				},
+				"igmp_fastleave": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_forward_unknown_multicast": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_groupmembership": map[string]any{
+					"type":    "integer",
+					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+				},
+				"igmp_maxresponse": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9]|1[0-9]|2[0-5]|^$",
+				},
+				"igmp_mcrtrexpiretime": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+				},
+				"igmp_proxy_downstream_networkconf_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"igmp_proxy_downstream_networkconf_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for igmp_proxy_downstream_networkconf_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"igmp_proxy_for": map[string]any{
+					"type":        "string",
+					"description": "One of: all|some|none",
+					"enum":        []any{"all", "some", "none"},
+				},
+				"igmp_proxy_upstream": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_querier_switches": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"igmp_snooping": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_supression": map[string]any{
+					"type": "boolean",
+				},
+				"interface_mtu": map[string]any{
+					"type":    "integer",
+					"pattern": "^(6[89]|[7-9][0-9]|[1-9][0-9]{2,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|65500)$",
+				},
+				"interface_mtu_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"internet_access_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ip_subnet": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+				},
+				"ipsec_dh_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 2|5|14|15|16|19|20|21|25|26",
+					"enum":        []any{"2", "5", "14", "15", "16", "19", "20", "21", "25", "26"},
+				},
+				"ipsec_dynamic_routing": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_encryption": map[string]any{
+					"type":        "string",
+					"description": "One of: aes128|aes192|aes256|3des",
+					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				},
+				"ipsec_esp_dh_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
+					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+				},
+				"ipsec_esp_encryption": map[string]any{
+					"type":        "string",
+					"description": "One of: aes128|aes192|aes256|3des",
+					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				},
+				"ipsec_esp_hash": map[string]any{
+					"type":        "string",
+					"description": "One of: sha1|md5|sha256|sha384|sha512",
+					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				},
+				"ipsec_esp_lifetime": map[string]any{
+					"type":    "string",
+					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				},
+				"ipsec_hash": map[string]any{
+					"type":        "string",
+					"description": "One of: sha1|md5|sha256|sha384|sha512",
+					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				},
+				"ipsec_ike_dh_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
+					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+				},
+				"ipsec_ike_encryption": map[string]any{
+					"type":        "string",
+					"description": "One of: aes128|aes192|aes256|3des",
+					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				},
+				"ipsec_ike_hash": map[string]any{
+					"type":        "string",
+					"description": "One of: sha1|md5|sha256|sha384|sha512",
+					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				},
+				"ipsec_ike_lifetime": map[string]any{
+					"type":    "string",
+					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				},
+				"ipsec_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"ipsec_key_exchange": map[string]any{
+					"type":        "string",
+					"description": "One of: ikev1|ikev2",
+					"enum":        []any{"ikev1", "ikev2"},
+				},
+				"ipsec_local_identifier": map[string]any{
+					"type": "string",
+				},
+				"ipsec_local_identifier_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_local_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"ipsec_peer_ip": map[string]any{
+					"type": "string",
+				},
+				"ipsec_pfs": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_profile": map[string]any{
+					"type":        "string",
+					"description": "One of: customized|azure_dynamic|azure_static",
+					"enum":        []any{"customized", "azure_dynamic", "azure_static"},
+				},
+				"ipsec_remote_identifier": map[string]any{
+					"type": "string",
+				},
+				"ipsec_remote_identifier_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_separate_ikev2_networks": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_tunnel_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+				},
+				"ipsec_tunnel_ip_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipv6_client_address_assignment": map[string]any{
+					"type":        "string",
+					"description": "One of: slaac|dhcpv6",
+					"enum":        []any{"slaac", "dhcpv6"},
+				},
+				"ipv6_interface_type": map[string]any{
+					"type":        "string",
+					"description": "One of: static|pd|single_network|none",
+					"enum":        []any{"static", "pd", "single_network", "none"},
+				},
+				"ipv6_pd_auto_prefixid_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipv6_pd_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"ipv6_pd_prefixid": map[string]any{
+					"type":    "string",
+					"pattern": "^$|[a-fA-F0-9]{1,4}",
+				},
+				"ipv6_pd_start": map[string]any{
+					"type": "string",
+				},
+				"ipv6_pd_stop": map[string]any{
+					"type": "string",
+				},
+				"ipv6_ra_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipv6_ra_preferred_lifetime": map[string]any{
+					"type":    "integer",
+					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+				},
+				"ipv6_ra_priority": map[string]any{
+					"type":        "string",
+					"description": "One of: high|medium|low",
+					"enum":        []any{"high", "medium", "low"},
+				},
+				"ipv6_ra_valid_lifetime": map[string]any{
+					"type":    "integer",
+					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+				},
+				"ipv6_setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"ipv6_single_network_interface": map[string]any{
+					"type": "string",
+				},
+				"ipv6_subnet": map[string]any{
+					"type": "string",
+				},
+				"ipv6_wan_delegation_type": map[string]any{
+					"type":        "string",
+					"description": "One of: pd|single_network|none",
+					"enum":        []any{"pd", "single_network", "none"},
+				},
+				"is_nat": map[string]any{
+					"type": "boolean",
+				},
+				"l2tp_allow_weak_ciphers": map[string]any{
+					"type": "boolean",
+				},
+				"l2tp_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"l2tp_local_wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"local_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"lte_lan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"mac_override": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"mac_override_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"mdns_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
+				},
+				"nat_outbound_ip_addresses": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"network_isolation_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"networkgroup": map[string]any{
+					"type":    "string",
+					"pattern": "LAN[2-8]?",
+				},
+				"openvpn_configuration": map[string]any{
+					"type": "string",
+				},
+				"openvpn_configuration_filename": map[string]any{
+					"type": "string",
+				},
+				"openvpn_encryption_cipher": map[string]any{
+					"type":        "string",
+					"description": "One of: AES_256_GCM|AES_256_CBC|BF_CBC",
+					"enum":        []any{"AES_256_GCM", "AES_256_CBC", "BF_CBC"},
+				},
+				"openvpn_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"openvpn_local_address": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_local_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"openvpn_local_wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: site-to-site|client|server",
+					"enum":        []any{"site-to-site", "client", "server"},
+				},
+				"openvpn_remote_address": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_remote_host": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_remote_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"openvpn_username": map[string]any{
+					"type": "string",
+				},
+				"pptpc_require_mppe": map[string]any{
+					"type": "boolean",
+				},
+				"pptpc_route_distance": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+				},
+				"pptpc_server_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^[a-zA-Z0-9-]{1,63}$",
+				},
+				"pptpc_username": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+",
+				},
+				"priority": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-4]",
+				},
+				"purpose": map[string]any{
+					"type":        "string",
+					"description": "One of: corporate|guest|remote-user-vpn|site-vpn|vlan-only|vpn-client|wan",
+					"enum":        []any{"corporate", "guest", "remote-user-vpn", "site-vpn", "vlan-only", "vpn-client", "wan"},
+				},
+				"radiusprofile_id": map[string]any{
+					"type": "string",
+				},
+				"radiusprofile_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the RADIUSProfile for radiusprofile_id, in place of its ID",
+				},
+				"remote_site_id": map[string]any{
+					"type": "string",
+				},
+				"remote_site_subnets": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"remote_vpn_dynamic_subnets_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"remote_vpn_subnets": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"report_wan_event": map[string]any{
+					"type": "boolean",
+				},
+				"require_mschapv2": map[string]any{
+					"type": "boolean",
+				},
+				"route_distance": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+				},
+				"sdwan_remote_site_id": map[string]any{
+					"type": "string",
+				},
+				"setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"single_network_lan": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"uid_policy_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_policy_name": map[string]any{
+					"type": "string",
+				},
+				"uid_public_gateway_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"uid_traffic_rules_allowed_ips_and_hostnames": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"uid_traffic_rules_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_vpn_custom_routing": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"uid_vpn_default_dns_suffix": map[string]any{
+					"type": "string",
+				},
+				"uid_vpn_masquerade_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_vpn_max_connection_time_seconds": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9][0-9]*$",
+				},
+				"uid_vpn_sync_public_ip": map[string]any{
+					"type": "boolean",
+				},
+				"uid_vpn_type": map[string]any{
+					"type":        "string",
+					"description": "One of: openvpn|wireguard",
+					"enum":        []any{"openvpn", "wireguard"},
+				},
+				"uid_workspace_url": map[string]any{
+					"type": "string",
+				},
+				"upnp_lan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"usergroup_id": map[string]any{
+					"type": "string",
+				},
+				"usergroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the UserGroup for usergroup_id, in place of its ID",
+				},
+				"vlan": map[string]any{
+					"type":    "integer",
+					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|400[0-9]|401[0-8]|^$",
+				},
+				"vlan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_client_configuration_remote_ip_override": map[string]any{
+					"type": "string",
+				},
+				"vpn_client_configuration_remote_ip_override_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_client_default_route": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_client_pull_dns": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_protocol": map[string]any{
+					"type":        "string",
+					"description": "One of: TCP|UDP",
+					"enum":        []any{"TCP", "UDP"},
+				},
+				"vpn_type": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|ipsec-vpn|openvpn-client|openvpn-server|openvpn-vpn|pptp-client|l2tp-server|pptp-server|sdwan-hub-spoke-tunnel|sdwan-mesh-tunnel|uid-server|wireguard-server|wireguard-client",
+					"enum":        []any{"auto", "ipsec-vpn", "openvpn-client", "openvpn-server", "openvpn-vpn", "pptp-client", "l2tp-server", "pptp-server", "sdwan-hub-spoke-tunnel", "sdwan-mesh-tunnel", "uid-server", "wireguard-server", "wireguard-client"},
+				},
+				"vrrp_ip_subnet_gw1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				},
+				"vrrp_ip_subnet_gw2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				},
+				"vrrp_vrid": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9]|[1-9][0-9]",
+				},
+				"wan_dhcp_cos": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-7]|^$",
+				},
+				"wan_dhcp_options": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"wan_dhcpv6_pd_size": map[string]any{
+					"type":    "integer",
+					"pattern": "^(4[89]|5[0-9]|6[0-4])$|^$",
+				},
+				"wan_dns1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns4": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"wan_dslite_remote_host": map[string]any{
+					"type": "string",
+				},
+				"wan_egress_qos": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-7]|^$",
+				},
+				"wan_gateway": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"wan_gateway_v6": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"wan_ip_aliases": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([8-9]|[1-2][0-9]|3[0-2])$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"wan_ipv6": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ipv6_dns1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ipv6_dns2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ipv6_dns_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"wan_load_balance_type": map[string]any{
+					"type":        "string",
+					"description": "One of: failover-only|weighted",
+					"enum":        []any{"failover-only", "weighted"},
+				},
+				"wan_load_balance_weight": map[string]any{
+					"type":    "integer",
+					"pattern": "^$|[1-9]|[1-9][0-9]",
+				},
+				"wan_netmask": map[string]any{
+					"type":    "string",
+					"pattern": "^((128|192|224|240|248|252|254)\\.0\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0)|255\\.(0|128|192|224|240|248|252|254)))))$",
+				},
+				"wan_networkgroup": map[string]any{
+					"type":    "string",
+					"pattern": "WAN[2]?|WAN_LTE_FAILOVER",
+				},
+				"wan_pppoe_password_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wan_pppoe_username_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wan_prefixlen": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])$|^$",
+				},
+				"wan_provider_capabilities": map[string]any{
+					"type": "object",
+				},
+				"wan_smartq_down_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]{1,6}|1000000",
+				},
+				"wan_smartq_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wan_smartq_up_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]{1,6}|1000000",
+				},
+				"wan_type": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|dhcp|static|pppoe|dslite",
+					"enum":        []any{"disabled", "dhcp", "static", "pppoe", "dslite"},
+				},
+				"wan_type_v6": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|slaac|dhcpv6|static",
+					"enum":        []any{"disabled", "slaac", "dhcpv6", "static"},
+				},
+				"wan_username": map[string]any{
+					"type":    "string",
+					"pattern": "[^\"' ]+|^$",
+				},
+				"wan_vlan": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|40[0-8][0-9]|409[0-4]|^$",
+				},
+				"wan_vlan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wireguard_client_configuration_file": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_configuration_filename": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: file|manual",
+					"enum":        []any{"file", "manual"},
+				},
+				"wireguard_client_peer_ip": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_peer_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"wireguard_client_peer_public_key": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_preshared_key": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_preshared_key_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wireguard_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"wireguard_local_wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"wireguard_public_key": map[string]any{
+					"type": "string",
+				},
+				"x_auth_key": map[string]any{
+					"type": "string",
+				},
+				"x_ca_crt": map[string]any{
+					"type": "string",
+				},
+				"x_ca_key": map[string]any{
+					"type": "string",
+				},
+				"x_dh_key": map[string]any{
+					"type": "string",
+				},
+				"x_ipsec_pre_shared_key": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+",
+				},
+				"x_openvpn_password": map[string]any{
+					"type": "string",
+				},
+				"x_openvpn_shared_secret_key": map[string]any{
+					"type":    "string",
+					"pattern": "[0-9A-Fa-f]{512}",
+				},
+				"x_pptpc_password": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+",
+				},
+				"x_server_crt": map[string]any{
+					"type": "string",
+				},
+				"x_server_key": map[string]any{
+					"type": "string",
+				},
+				"x_shared_client_crt": map[string]any{
+					"type": "string",
+				},
+				"x_shared_client_key": map[string]any{
+					"type": "string",
+				},
+				"x_wan_password": map[string]any{
+					"type":    "string",
+					"pattern": "[^\"' ]+|^$",
+				},
+				"x_wireguard_private_key": map[string]any{
+					"type": "string",
+				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+		NameInputs: map[string]string{
+			"firewall_zone_name":                      "firewall_zone_id",
+			"igmp_proxy_downstream_networkconf_names": "igmp_proxy_downstream_networkconf_ids",
+			"radiusprofile_name":                      "radiusprofile_id",
+			"usergroup_name":                          "usergroup_id",
+		},
+	},
+	{
+		Name:        "update_network",
+		Description: "Update Network by ID",
+		Category:    "update",
+		Resource:    "Network",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"auto_scale_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcp_relay_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_boot_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_boot_filename": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
+				},
+				"dhcpd_boot_server": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|[a-zA-Z0-9-]{1,63}|^$",
+				},
+				"dhcpd_conflict_checking": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_dns_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_4": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_gateway": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_gateway_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_ip_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ip_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ip_3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_leasetime": map[string]any{
+					"type": "integer",
+				},
+				"dhcpd_mac_1": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"dhcpd_mac_2": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"dhcpd_mac_3": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"dhcpd_ntp_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ntp_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ntp_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_start": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_stop": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_tftp_server": map[string]any{
+					"type": "string",
+				},
+				"dhcpd_time_offset": map[string]any{
+					"type":    "integer",
+					"pattern": "^0$|^-?([1-9]([0-9]{1,3})?|[1-7][0-9]{4}|[8][0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				},
+				"dhcpd_time_offset_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_unifi_controller": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_wins_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_wins_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_wins_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_wpad_url": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_allow_slaac": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpdv6_dns_1": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_2": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_3": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_4": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_auto": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpdv6_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpdv6_leasetime": map[string]any{
+					"type": "integer",
+				},
+				"dhcpdv6_start": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_stop": map[string]any{
+					"type": "string",
+				},
+				"dhcpguard_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"domain_name": map[string]any{
+					"type":    "string",
+					"pattern": "(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^$|[a-zA-Z0-9-]{1,63}",
+				},
+				"dpi_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dpigroup_id": map[string]any{
+					"type":    "string",
+					"pattern": "[\\d\\w]+|^$",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"exposed_to_site_vpn": map[string]any{
+					"type": "boolean",
+				},
+				"firewall_zone_id": map[string]any{
+					"type": "string",
+				},
+				"firewall_zone_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the FirewallZone for firewall_zone_id, in place of its ID",
+				},
+				"gateway_device": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"gateway_type": map[string]any{
+					"type":        "string",
+					"description": "One of: default|switch",
+					"enum":        []any{"default", "switch"},
+				},
+				"igmp_fastleave": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_forward_unknown_multicast": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_groupmembership": map[string]any{
+					"type":    "integer",
+					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+				},
+				"igmp_maxresponse": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9]|1[0-9]|2[0-5]|^$",
+				},
+				"igmp_mcrtrexpiretime": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+				},
+				"igmp_proxy_downstream_networkconf_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"igmp_proxy_downstream_networkconf_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for igmp_proxy_downstream_networkconf_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"igmp_proxy_for": map[string]any{
+					"type":        "string",
+					"description": "One of: all|some|none",
+					"enum":        []any{"all", "some", "none"},
+				},
+				"igmp_proxy_upstream": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_querier_switches": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"igmp_snooping": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_supression": map[string]any{
+					"type": "boolean",
+				},
+				"interface_mtu": map[string]any{
+					"type":    "integer",
+					"pattern": "^(6[89]|[7-9][0-9]|[1-9][0-9]{2,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|65500)$",
+				},
+				"interface_mtu_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"internet_access_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ip_subnet": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+				},
+				"ipsec_dh_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 2|5|14|15|16|19|20|21|25|26",
+					"enum":        []any{"2", "5", "14", "15", "16", "19", "20", "21", "25", "26"},
+				},
+				"ipsec_dynamic_routing": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_encryption": map[string]any{
+					"type":        "string",
+					"description": "One of: aes128|aes192|aes256|3des",
+					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				},
+				"ipsec_esp_dh_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
+					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+				},
+				"ipsec_esp_encryption": map[string]any{
+					"type":        "string",
+					"description": "One of: aes128|aes192|aes256|3des",
+					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				},
+				"ipsec_esp_hash": map[string]any{
+					"type":        "string",
+					"description": "One of: sha1|md5|sha256|sha384|sha512",
+					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				},
+				"ipsec_esp_lifetime": map[string]any{
+					"type":    "string",
+					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				},
+				"ipsec_hash": map[string]any{
+					"type":        "string",
+					"description": "One of: sha1|md5|sha256|sha384|sha512",
+					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				},
+				"ipsec_ike_dh_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
+					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+				},
+				"ipsec_ike_encryption": map[string]any{
+					"type":        "string",
+					"description": "One of: aes128|aes192|aes256|3des",
+					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				},
+				"ipsec_ike_hash": map[string]any{
+					"type":        "string",
+					"description": "One of: sha1|md5|sha256|sha384|sha512",
+					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				},
+				"ipsec_ike_lifetime": map[string]any{
+					"type":    "string",
+					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				},
+				"ipsec_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"ipsec_key_exchange": map[string]any{
+					"type":        "string",
+					"description": "One of: ikev1|ikev2",
+					"enum":        []any{"ikev1", "ikev2"},
+				},
+				"ipsec_local_identifier": map[string]any{
+					"type": "string",
+				},
+				"ipsec_local_identifier_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_local_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"ipsec_peer_ip": map[string]any{
+					"type": "string",
+				},
+				"ipsec_pfs": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_profile": map[string]any{
+					"type":        "string",
+					"description": "One of: customized|azure_dynamic|azure_static",
+					"enum":        []any{"customized", "azure_dynamic", "azure_static"},
+				},
+				"ipsec_remote_identifier": map[string]any{
+					"type": "string",
+				},
+				"ipsec_remote_identifier_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_separate_ikev2_networks": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_tunnel_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+				},
+				"ipsec_tunnel_ip_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipv6_client_address_assignment": map[string]any{
+					"type":        "string",
+					"description": "One of: slaac|dhcpv6",
+					"enum":        []any{"slaac", "dhcpv6"},
+				},
+				"ipv6_interface_type": map[string]any{
+					"type":        "string",
+					"description": "One of: static|pd|single_network|none",
+					"enum":        []any{"static", "pd", "single_network", "none"},
+				},
+				"ipv6_pd_auto_prefixid_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipv6_pd_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"ipv6_pd_prefixid": map[string]any{
+					"type":    "string",
+					"pattern": "^$|[a-fA-F0-9]{1,4}",
+				},
+				"ipv6_pd_start": map[string]any{
+					"type": "string",
+				},
+				"ipv6_pd_stop": map[string]any{
+					"type": "string",
+				},
+				"ipv6_ra_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipv6_ra_preferred_lifetime": map[string]any{
+					"type":    "integer",
+					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+				},
+				"ipv6_ra_priority": map[string]any{
+					"type":        "string",
+					"description": "One of: high|medium|low",
+					"enum":        []any{"high", "medium", "low"},
+				},
+				"ipv6_ra_valid_lifetime": map[string]any{
+					"type":    "integer",
+					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+				},
+				"ipv6_setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"ipv6_single_network_interface": map[string]any{
+					"type": "string",
+				},
+				"ipv6_subnet": map[string]any{
+					"type": "string",
+				},
+				"ipv6_wan_delegation_type": map[string]any{
+					"type":        "string",
+					"description": "One of: pd|single_network|none",
+					"enum":        []any{"pd", "single_network", "none"},
+				},
+				"is_nat": map[string]any{
+					"type": "boolean",
+				},
+				"l2tp_allow_weak_ciphers": map[string]any{
+					"type": "boolean",
+				},
+				"l2tp_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"l2tp_local_wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"local_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"lte_lan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"mac_override": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"mac_override_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"mdns_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
+				},
+				"nat_outbound_ip_addresses": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"network_isolation_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"networkgroup": map[string]any{
+					"type":    "string",
+					"pattern": "LAN[2-8]?",
+				},
+				"openvpn_configuration": map[string]any{
+					"type": "string",
+				},
+				"openvpn_configuration_filename": map[string]any{
+					"type": "string",
+				},
+				"openvpn_encryption_cipher": map[string]any{
+					"type":        "string",
+					"description": "One of: AES_256_GCM|AES_256_CBC|BF_CBC",
+					"enum":        []any{"AES_256_GCM", "AES_256_CBC", "BF_CBC"},
+				},
+				"openvpn_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"openvpn_local_address": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_local_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"openvpn_local_wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: site-to-site|client|server",
+					"enum":        []any{"site-to-site", "client", "server"},
+				},
+				"openvpn_remote_address": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_remote_host": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_remote_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"openvpn_username": map[string]any{
+					"type": "string",
+				},
+				"pptpc_require_mppe": map[string]any{
+					"type": "boolean",
+				},
+				"pptpc_route_distance": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+				},
+				"pptpc_server_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^[a-zA-Z0-9-]{1,63}$",
+				},
+				"pptpc_username": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+",
+				},
+				"priority": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-4]",
+				},
+				"purpose": map[string]any{
+					"type":        "string",
+					"description": "One of: corporate|guest|remote-user-vpn|site-vpn|vlan-only|vpn-client|wan",
+					"enum":        []any{"corporate", "guest", "remote-user-vpn", "site-vpn", "vlan-only", "vpn-client", "wan"},
+				},
+				"radiusprofile_id": map[string]any{
+					"type": "string",
+				},
+				"radiusprofile_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the RADIUSProfile for radiusprofile_id, in place of its ID",
+				},
+				"remote_site_id": map[string]any{
+					"type": "string",
+				},
+				"remote_site_subnets": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"remote_vpn_dynamic_subnets_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"remote_vpn_subnets": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"report_wan_event": map[string]any{
+					"type": "boolean",
+				},
+				"require_mschapv2": map[string]any{
+					"type": "boolean",
+				},
+				"route_distance": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+				},
+				"sdwan_remote_site_id": map[string]any{
+					"type": "string",
+				},
+				"setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"single_network_lan": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"uid_policy_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_policy_name": map[string]any{
+					"type": "string",
+				},
+				"uid_public_gateway_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"uid_traffic_rules_allowed_ips_and_hostnames": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"uid_traffic_rules_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_vpn_custom_routing": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"uid_vpn_default_dns_suffix": map[string]any{
+					"type": "string",
+				},
+				"uid_vpn_masquerade_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_vpn_max_connection_time_seconds": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9][0-9]*$",
+				},
+				"uid_vpn_sync_public_ip": map[string]any{
+					"type": "boolean",
+				},
+				"uid_vpn_type": map[string]any{
+					"type":        "string",
+					"description": "One of: openvpn|wireguard",
+					"enum":        []any{"openvpn", "wireguard"},
+				},
+				"uid_workspace_url": map[string]any{
+					"type": "string",
+				},
+				"upnp_lan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"usergroup_id": map[string]any{
+					"type": "string",
+				},
+				"usergroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the UserGroup for usergroup_id, in place of its ID",
+				},
+				"vlan": map[string]any{
+					"type":    "integer",
+					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|400[0-9]|401[0-8]|^$",
+				},
+				"vlan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_client_configuration_remote_ip_override": map[string]any{
+					"type": "string",
+				},
+				"vpn_client_configuration_remote_ip_override_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_client_default_route": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_client_pull_dns": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_protocol": map[string]any{
+					"type":        "string",
+					"description": "One of: TCP|UDP",
+					"enum":        []any{"TCP", "UDP"},
+				},
+				"vpn_type": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|ipsec-vpn|openvpn-client|openvpn-server|openvpn-vpn|pptp-client|l2tp-server|pptp-server|sdwan-hub-spoke-tunnel|sdwan-mesh-tunnel|uid-server|wireguard-server|wireguard-client",
+					"enum":        []any{"auto", "ipsec-vpn", "openvpn-client", "openvpn-server", "openvpn-vpn", "pptp-client", "l2tp-server", "pptp-server", "sdwan-hub-spoke-tunnel", "sdwan-mesh-tunnel", "uid-server", "wireguard-server", "wireguard-client"},
+				},
+				"vrrp_ip_subnet_gw1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				},
+				"vrrp_ip_subnet_gw2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				},
+				"vrrp_vrid": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9]|[1-9][0-9]",
+				},
+				"wan_dhcp_cos": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-7]|^$",
+				},
+				"wan_dhcp_options": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"wan_dhcpv6_pd_size": map[string]any{
+					"type":    "integer",
+					"pattern": "^(4[89]|5[0-9]|6[0-4])$|^$",
+				},
+				"wan_dns1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns4": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"wan_dslite_remote_host": map[string]any{
+					"type": "string",
+				},
+				"wan_egress_qos": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-7]|^$",
+				},
+				"wan_gateway": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"wan_gateway_v6": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"wan_ip_aliases": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([8-9]|[1-2][0-9]|3[0-2])$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"wan_ipv6": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ipv6_dns1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ipv6_dns2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ipv6_dns_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"wan_load_balance_type": map[string]any{
+					"type":        "string",
+					"description": "One of: failover-only|weighted",
+					"enum":        []any{"failover-only", "weighted"},
+				},
+				"wan_load_balance_weight": map[string]any{
+					"type":    "integer",
+					"pattern": "^$|[1-9]|[1-9][0-9]",
+				},
+				"wan_netmask": map[string]any{
+					"type":    "string",
+					"pattern": "^((128|192|224|240|248|252|254)\\.0\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0)|255\\.(0|128|192|224|240|248|252|254)))))$",
+				},
+				"wan_networkgroup": map[string]any{
+					"type":    "string",
+					"pattern": "WAN[2]?|WAN_LTE_FAILOVER",
+				},
+				"wan_pppoe_password_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wan_pppoe_username_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wan_prefixlen": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])$|^$",
+				},
+				"wan_provider_capabilities": map[string]any{
+					"type": "object",
+				},
+				"wan_smartq_down_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]{1,6}|1000000",
+				},
+				"wan_smartq_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wan_smartq_up_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]{1,6}|1000000",
+				},
+				"wan_type": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|dhcp|static|pppoe|dslite",
+					"enum":        []any{"disabled", "dhcp", "static", "pppoe", "dslite"},
+				},
+				"wan_type_v6": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|slaac|dhcpv6|static",
+					"enum":        []any{"disabled", "slaac", "dhcpv6", "static"},
+				},
+				"wan_username": map[string]any{
+					"type":    "string",
+					"pattern": "[^\"' ]+|^$",
+				},
+				"wan_vlan": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|40[0-8][0-9]|409[0-4]|^$",
+				},
+				"wan_vlan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wireguard_client_configuration_file": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_configuration_filename": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: file|manual",
+					"enum":        []any{"file", "manual"},
+				},
+				"wireguard_client_peer_ip": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_peer_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"wireguard_client_peer_public_key": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_preshared_key": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_preshared_key_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wireguard_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"wireguard_local_wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"wireguard_public_key": map[string]any{
+					"type": "string",
+				},
+				"x_auth_key": map[string]any{
+					"type": "string",
+				},
+				"x_ca_crt": map[string]any{
+					"type": "string",
+				},
+				"x_ca_key": map[string]any{
+					"type": "string",
+				},
+				"x_dh_key": map[string]any{
+					"type": "string",
+				},
+				"x_ipsec_pre_shared_key": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+",
+				},
+				"x_openvpn_password": map[string]any{
+					"type": "string",
+				},
+				"x_openvpn_shared_secret_key": map[string]any{
+					"type":    "string",
+					"pattern": "[0-9A-Fa-f]{512}",
+				},
+				"x_pptpc_password": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+",
+				},
+				"x_server_crt": map[string]any{
+					"type": "string",
+				},
+				"x_server_key": map[string]any{
+					"type": "string",
+				},
+				"x_shared_client_crt": map[string]any{
+					"type": "string",
+				},
+				"x_shared_client_key": map[string]any{
+					"type": "string",
+				},
+				"x_wan_password": map[string]any{
+					"type":    "string",
+					"pattern": "[^\"' ]+|^$",
+				},
+				"x_wireguard_private_key": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+		NameInputs: map[string]string{
+			"firewall_zone_name":                      "firewall_zone_id",
+			"igmp_proxy_downstream_networkconf_names": "igmp_proxy_downstream_networkconf_ids",
+			"radiusprofile_name":                      "radiusprofile_id",
+			"usergroup_name":                          "usergroup_id",
+		},
+	},
+	{
+		Name:        "upsert_network",
+		Description: "Create or update Network matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "Network",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing Network (default: [\"name\"]). One match is updated, none creates a new Network, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"auto_scale_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcp_relay_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_boot_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_boot_filename": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
+				},
+				"dhcpd_boot_server": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|[a-zA-Z0-9-]{1,63}|^$",
+				},
+				"dhcpd_conflict_checking": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_dns_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_4": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_dns_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_gateway": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_gateway_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_ip_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ip_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ip_3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_leasetime": map[string]any{
+					"type": "integer",
+				},
+				"dhcpd_mac_1": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"dhcpd_mac_2": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"dhcpd_mac_3": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"dhcpd_ntp_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ntp_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_ntp_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_start": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_stop": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_tftp_server": map[string]any{
+					"type": "string",
+				},
+				"dhcpd_time_offset": map[string]any{
+					"type":    "integer",
+					"pattern": "^0$|^-?([1-9]([0-9]{1,3})?|[1-7][0-9]{4}|[8][0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				},
+				"dhcpd_time_offset_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_unifi_controller": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_wins_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_wins_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_wins_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_wpad_url": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_allow_slaac": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpdv6_dns_1": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_2": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_3": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_4": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_dns_auto": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpdv6_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpdv6_leasetime": map[string]any{
+					"type": "integer",
+				},
+				"dhcpdv6_start": map[string]any{
+					"type": "string",
+				},
+				"dhcpdv6_stop": map[string]any{
+					"type": "string",
+				},
+				"dhcpguard_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"domain_name": map[string]any{
+					"type":    "string",
+					"pattern": "(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^$|[a-zA-Z0-9-]{1,63}",
+				},
+				"dpi_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dpigroup_id": map[string]any{
+					"type":    "string",
+					"pattern": "[\\d\\w]+|^$",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"exposed_to_site_vpn": map[string]any{
+					"type": "boolean",
+				},
+				"firewall_zone_id": map[string]any{
+					"type": "string",
+				},
+				"firewall_zone_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the FirewallZone for firewall_zone_id, in place of its ID",
+				},
+				"gateway_device": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"gateway_type": map[string]any{
+					"type":        "string",
+					"description": "One of: default|switch",
+					"enum":        []any{"default", "switch"},
+				},
+				"igmp_fastleave": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_forward_unknown_multicast": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_groupmembership": map[string]any{
+					"type":    "integer",
+					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+				},
+				"igmp_maxresponse": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9]|1[0-9]|2[0-5]|^$",
+				},
+				"igmp_mcrtrexpiretime": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+				},
+				"igmp_proxy_downstream_networkconf_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"igmp_proxy_downstream_networkconf_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for igmp_proxy_downstream_networkconf_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"igmp_proxy_for": map[string]any{
+					"type":        "string",
+					"description": "One of: all|some|none",
+					"enum":        []any{"all", "some", "none"},
+				},
+				"igmp_proxy_upstream": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_querier_switches": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"igmp_snooping": map[string]any{
+					"type": "boolean",
+				},
+				"igmp_supression": map[string]any{
+					"type": "boolean",
+				},
+				"interface_mtu": map[string]any{
+					"type":    "integer",
+					"pattern": "^(6[89]|[7-9][0-9]|[1-9][0-9]{2,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|65500)$",
+				},
+				"interface_mtu_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"internet_access_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ip_subnet": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+				},
+				"ipsec_dh_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 2|5|14|15|16|19|20|21|25|26",
+					"enum":        []any{"2", "5", "14", "15", "16", "19", "20", "21", "25", "26"},
+				},
+				"ipsec_dynamic_routing": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_encryption": map[string]any{
+					"type":        "string",
+					"description": "One of: aes128|aes192|aes256|3des",
+					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				},
+				"ipsec_esp_dh_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
+					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+				},
+				"ipsec_esp_encryption": map[string]any{
+					"type":        "string",
+					"description": "One of: aes128|aes192|aes256|3des",
+					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				},
+				"ipsec_esp_hash": map[string]any{
+					"type":        "string",
+					"description": "One of: sha1|md5|sha256|sha384|sha512",
+					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				},
+				"ipsec_esp_lifetime": map[string]any{
+					"type":    "string",
+					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				},
+				"ipsec_hash": map[string]any{
+					"type":        "string",
+					"description": "One of: sha1|md5|sha256|sha384|sha512",
+					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				},
+				"ipsec_ike_dh_group": map[string]any{
+					"type":        "integer",
+					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
+					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+				},
+				"ipsec_ike_encryption": map[string]any{
+					"type":        "string",
+					"description": "One of: aes128|aes192|aes256|3des",
+					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				},
+				"ipsec_ike_hash": map[string]any{
+					"type":        "string",
+					"description": "One of: sha1|md5|sha256|sha384|sha512",
+					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				},
+				"ipsec_ike_lifetime": map[string]any{
+					"type":    "string",
+					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				},
+				"ipsec_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"ipsec_key_exchange": map[string]any{
+					"type":        "string",
+					"description": "One of: ikev1|ikev2",
+					"enum":        []any{"ikev1", "ikev2"},
+				},
+				"ipsec_local_identifier": map[string]any{
+					"type": "string",
+				},
+				"ipsec_local_identifier_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_local_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"ipsec_peer_ip": map[string]any{
+					"type": "string",
+				},
+				"ipsec_pfs": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_profile": map[string]any{
+					"type":        "string",
+					"description": "One of: customized|azure_dynamic|azure_static",
+					"enum":        []any{"customized", "azure_dynamic", "azure_static"},
+				},
+				"ipsec_remote_identifier": map[string]any{
+					"type": "string",
+				},
+				"ipsec_remote_identifier_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_separate_ikev2_networks": map[string]any{
+					"type": "boolean",
+				},
+				"ipsec_tunnel_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+				},
+				"ipsec_tunnel_ip_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipv6_client_address_assignment": map[string]any{
+					"type":        "string",
+					"description": "One of: slaac|dhcpv6",
+					"enum":        []any{"slaac", "dhcpv6"},
+				},
+				"ipv6_interface_type": map[string]any{
+					"type":        "string",
+					"description": "One of: static|pd|single_network|none",
+					"enum":        []any{"static", "pd", "single_network", "none"},
+				},
+				"ipv6_pd_auto_prefixid_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipv6_pd_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"ipv6_pd_prefixid": map[string]any{
+					"type":    "string",
+					"pattern": "^$|[a-fA-F0-9]{1,4}",
+				},
+				"ipv6_pd_start": map[string]any{
+					"type": "string",
+				},
+				"ipv6_pd_stop": map[string]any{
+					"type": "string",
+				},
+				"ipv6_ra_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"ipv6_ra_preferred_lifetime": map[string]any{
+					"type":    "integer",
+					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+				},
+				"ipv6_ra_priority": map[string]any{
+					"type":        "string",
+					"description": "One of: high|medium|low",
+					"enum":        []any{"high", "medium", "low"},
+				},
+				"ipv6_ra_valid_lifetime": map[string]any{
+					"type":    "integer",
+					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+				},
+				"ipv6_setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"ipv6_single_network_interface": map[string]any{
+					"type": "string",
+				},
+				"ipv6_subnet": map[string]any{
+					"type": "string",
+				},
+				"ipv6_wan_delegation_type": map[string]any{
+					"type":        "string",
+					"description": "One of: pd|single_network|none",
+					"enum":        []any{"pd", "single_network", "none"},
+				},
+				"is_nat": map[string]any{
+					"type": "boolean",
+				},
+				"l2tp_allow_weak_ciphers": map[string]any{
+					"type": "boolean",
+				},
+				"l2tp_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"l2tp_local_wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"local_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"lte_lan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"mac_override": map[string]any{
+					"type":    "string",
+					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				},
+				"mac_override_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"mdns_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
+				},
+				"nat_outbound_ip_addresses": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"network_isolation_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"networkgroup": map[string]any{
+					"type":    "string",
+					"pattern": "LAN[2-8]?",
+				},
+				"openvpn_configuration": map[string]any{
+					"type": "string",
+				},
+				"openvpn_configuration_filename": map[string]any{
+					"type": "string",
+				},
+				"openvpn_encryption_cipher": map[string]any{
+					"type":        "string",
+					"description": "One of: AES_256_GCM|AES_256_CBC|BF_CBC",
+					"enum":        []any{"AES_256_GCM", "AES_256_CBC", "BF_CBC"},
+				},
+				"openvpn_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"openvpn_local_address": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_local_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"openvpn_local_wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: site-to-site|client|server",
+					"enum":        []any{"site-to-site", "client", "server"},
+				},
+				"openvpn_remote_address": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_remote_host": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"openvpn_remote_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"openvpn_username": map[string]any{
+					"type": "string",
+				},
+				"pptpc_require_mppe": map[string]any{
+					"type": "boolean",
+				},
+				"pptpc_route_distance": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+				},
+				"pptpc_server_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^[a-zA-Z0-9-]{1,63}$",
+				},
+				"pptpc_username": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+",
+				},
+				"priority": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-4]",
+				},
+				"purpose": map[string]any{
+					"type":        "string",
+					"description": "One of: corporate|guest|remote-user-vpn|site-vpn|vlan-only|vpn-client|wan",
+					"enum":        []any{"corporate", "guest", "remote-user-vpn", "site-vpn", "vlan-only", "vpn-client", "wan"},
+				},
+				"radiusprofile_id": map[string]any{
+					"type": "string",
+				},
+				"radiusprofile_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the RADIUSProfile for radiusprofile_id, in place of its ID",
+				},
+				"remote_site_id": map[string]any{
+					"type": "string",
+				},
+				"remote_site_subnets": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"remote_vpn_dynamic_subnets_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"remote_vpn_subnets": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"report_wan_event": map[string]any{
+					"type": "boolean",
+				},
+				"require_mschapv2": map[string]any{
+					"type": "boolean",
+				},
+				"route_distance": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+				},
+				"sdwan_remote_site_id": map[string]any{
+					"type": "string",
+				},
+				"setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"single_network_lan": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"uid_policy_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_policy_name": map[string]any{
+					"type": "string",
+				},
+				"uid_public_gateway_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"uid_traffic_rules_allowed_ips_and_hostnames": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"uid_traffic_rules_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_vpn_custom_routing": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"uid_vpn_default_dns_suffix": map[string]any{
+					"type": "string",
+				},
+				"uid_vpn_masquerade_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_vpn_max_connection_time_seconds": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9][0-9]*$",
+				},
+				"uid_vpn_sync_public_ip": map[string]any{
+					"type": "boolean",
+				},
+				"uid_vpn_type": map[string]any{
+					"type":        "string",
+					"description": "One of: openvpn|wireguard",
+					"enum":        []any{"openvpn", "wireguard"},
+				},
+				"uid_workspace_url": map[string]any{
+					"type": "string",
+				},
+				"upnp_lan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"usergroup_id": map[string]any{
+					"type": "string",
+				},
+				"usergroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the UserGroup for usergroup_id, in place of its ID",
+				},
+				"vlan": map[string]any{
+					"type":    "integer",
+					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|400[0-9]|401[0-8]|^$",
+				},
+				"vlan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_client_configuration_remote_ip_override": map[string]any{
+					"type": "string",
+				},
+				"vpn_client_configuration_remote_ip_override_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_client_default_route": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_client_pull_dns": map[string]any{
+					"type": "boolean",
+				},
+				"vpn_protocol": map[string]any{
+					"type":        "string",
+					"description": "One of: TCP|UDP",
+					"enum":        []any{"TCP", "UDP"},
+				},
+				"vpn_type": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|ipsec-vpn|openvpn-client|openvpn-server|openvpn-vpn|pptp-client|l2tp-server|pptp-server|sdwan-hub-spoke-tunnel|sdwan-mesh-tunnel|uid-server|wireguard-server|wireguard-client",
+					"enum":        []any{"auto", "ipsec-vpn", "openvpn-client", "openvpn-server", "openvpn-vpn", "pptp-client", "l2tp-server", "pptp-server", "sdwan-hub-spoke-tunnel", "sdwan-mesh-tunnel", "uid-server", "wireguard-server", "wireguard-client"},
+				},
+				"vrrp_ip_subnet_gw1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				},
+				"vrrp_ip_subnet_gw2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				},
+				"vrrp_vrid": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9]|[1-9][0-9]",
+				},
+				"wan_dhcp_cos": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-7]|^$",
+				},
+				"wan_dhcp_options": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"wan_dhcpv6_pd_size": map[string]any{
+					"type":    "integer",
+					"pattern": "^(4[89]|5[0-9]|6[0-4])$|^$",
+				},
+				"wan_dns1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns4": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"wan_dns_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"wan_dslite_remote_host": map[string]any{
+					"type": "string",
+				},
+				"wan_egress_qos": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-7]|^$",
+				},
+				"wan_gateway": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"wan_gateway_v6": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"wan_ip_aliases": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([8-9]|[1-2][0-9]|3[0-2])$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"wan_ipv6": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ipv6_dns1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ipv6_dns2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				},
+				"wan_ipv6_dns_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
+				},
+				"wan_load_balance_type": map[string]any{
+					"type":        "string",
+					"description": "One of: failover-only|weighted",
+					"enum":        []any{"failover-only", "weighted"},
+				},
+				"wan_load_balance_weight": map[string]any{
+					"type":    "integer",
+					"pattern": "^$|[1-9]|[1-9][0-9]",
+				},
+				"wan_netmask": map[string]any{
+					"type":    "string",
+					"pattern": "^((128|192|224|240|248|252|254)\\.0\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0)|255\\.(0|128|192|224|240|248|252|254)))))$",
+				},
+				"wan_networkgroup": map[string]any{
+					"type":    "string",
+					"pattern": "WAN[2]?|WAN_LTE_FAILOVER",
+				},
+				"wan_pppoe_password_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wan_pppoe_username_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wan_prefixlen": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])$|^$",
+				},
+				"wan_provider_capabilities": map[string]any{
+					"type": "object",
+				},
+				"wan_smartq_down_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]{1,6}|1000000",
+				},
+				"wan_smartq_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wan_smartq_up_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]{1,6}|1000000",
+				},
+				"wan_type": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|dhcp|static|pppoe|dslite",
+					"enum":        []any{"disabled", "dhcp", "static", "pppoe", "dslite"},
+				},
+				"wan_type_v6": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|slaac|dhcpv6|static",
+					"enum":        []any{"disabled", "slaac", "dhcpv6", "static"},
+				},
+				"wan_username": map[string]any{
+					"type":    "string",
+					"pattern": "[^\"' ]+|^$",
+				},
+				"wan_vlan": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|40[0-8][0-9]|409[0-4]|^$",
+				},
+				"wan_vlan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wireguard_client_configuration_file": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_configuration_filename": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: file|manual",
+					"enum":        []any{"file", "manual"},
+				},
+				"wireguard_client_peer_ip": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_peer_port": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				},
+				"wireguard_client_peer_public_key": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_preshared_key": map[string]any{
+					"type": "string",
+				},
+				"wireguard_client_preshared_key_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wireguard_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2",
+					"enum":        []any{"wan", "wan2"},
+				},
+				"wireguard_local_wan_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				},
+				"wireguard_public_key": map[string]any{
+					"type": "string",
+				},
+				"x_auth_key": map[string]any{
+					"type": "string",
+				},
+				"x_ca_crt": map[string]any{
+					"type": "string",
+				},
+				"x_ca_key": map[string]any{
+					"type": "string",
+				},
+				"x_dh_key": map[string]any{
+					"type": "string",
+				},
+				"x_ipsec_pre_shared_key": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+",
+				},
+				"x_openvpn_password": map[string]any{
+					"type": "string",
+				},
+				"x_openvpn_shared_secret_key": map[string]any{
+					"type":    "string",
+					"pattern": "[0-9A-Fa-f]{512}",
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_media_file",
-		Description: "Update MediaFile by ID",
-		Category:    "update",
-		Resource:    "MediaFile",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"x_pptpc_password": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]+",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
+				"x_server_crt": map[string]any{
+					"type": "string",
 				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
+				"x_server_key": map[string]any{
+					"type": "string",
 				},
-				"attr_hidden_id": map[string]any{
+				"x_shared_client_crt": map[string]any{
 					"type": "string",
 				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
+				"x_shared_client_key": map[string]any{
+					"type": "string",
 				},
-				"attr_no_edit": map[string]any{
-					"type": "boolean",
+				"x_wan_password": map[string]any{
+					"type":    "string",
+					"pattern": "[^\"' ]+|^$",
 				},
-				"name": map[string]any{
+				"x_wireguard_private_key": map[string]any{
 					"type": "string",
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
+		},
+		NameInputs: map[string]string{
+			"firewall_zone_name":                      "firewall_zone_id",
+			"igmp_proxy_downstream_networkconf_names": "igmp_proxy_downstream_networkconf_ids",
+			"radiusprofile_name":                      "radiusprofile_id",
+			"usergroup_name":                          "usergroup_id",
 		},
 	},
 	{
-		Name:        "delete_media_file",
-		Description: "Delete MediaFile by ID",
+		Name:        "delete_network",
+		Description: "Delete Network by ID",
 		Category:    "delete",
-		Resource:    "MediaFile",
+		Resource:    "Network",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -4435,17 +11111,20 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Network by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "list_network",
-		Description: "List all Network resources",
+		Name:        "list_port_forward",
+		Description: "List all PortForward resources",
 		Category:    "list",
-		Resource:    "Network",
+		Resource:    "PortForward",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -4455,54 +11134,93 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each PortForward (_id, name, enabled, proto, pfwd_interface, dst_port, fwd, fwd_port), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
+				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_network",
-		Description: "Get Network by ID",
-		Category:    "get",
-		Resource:    "Network",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"id": map[string]any{
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
+		SummaryFields: []string{"_id", "name", "enabled", "proto", "pfwd_interface", "dst_port", "fwd", "fwd_port"},
 	},
 	{
-		Name:        "create_network",
-		Description: "Create new Network",
-		Category:    "create",
-		Resource:    "Network",
+		Name:        "get_port_forward",
+		Description: "Get PortForward by ID",
+		Category:    "get",
+		Resource:    "PortForward",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -4510,892 +11228,962 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
-				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
-				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
-				},
-				"attr_no_edit": map[string]any{
-					"type": "boolean",
-				},
-				"auto_scale_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"dhcp_relay_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"dhcpd_boot_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"dhcpd_boot_filename": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,256}",
-				},
-				"dhcpd_boot_server": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|[a-zA-Z0-9-]{1,63}|^$",
-				},
-				"dhcpd_conflict_checking": map[string]any{
-					"type": "boolean",
-				},
-				"dhcpd_dns_1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_dns_2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_dns_3": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_dns_4": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_dns_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"dhcpd_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"dhcpd_gateway": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_gateway_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"dhcpd_ip_1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_ip_2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_ip_3": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_leasetime": map[string]any{
-					"type": "integer",
-				},
-				"dhcpd_mac_1": map[string]any{
-					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
-				},
-				"dhcpd_mac_2": map[string]any{
-					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
-				},
-				"dhcpd_mac_3": map[string]any{
-					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
-				},
-				"dhcpd_ntp_1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_ntp_2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_ntp_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"dhcpd_start": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_stop": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_tftp_server": map[string]any{
-					"type": "string",
-				},
-				"dhcpd_time_offset": map[string]any{
-					"type":    "integer",
-					"pattern": "^0$|^-?([1-9]([0-9]{1,3})?|[1-7][0-9]{4}|[8][0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
-				},
-				"dhcpd_time_offset_enabled": map[string]any{
-					"type": "boolean",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
 				},
-				"dhcpd_unifi_controller": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the PortForward by name instead of id",
 				},
-				"dhcpd_wins_1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the PortForward (_id, name, enabled, proto, pfwd_interface, dst_port, fwd, fwd_port), full (default) for all of them. Ignored when fields is given.",
 				},
-				"dhcpd_wins_2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"dhcpd_wins_enabled": map[string]any{
-					"type": "boolean",
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"dhcpd_wpad_url": map[string]any{
-					"type": "string",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"dhcpdv6_allow_slaac": map[string]any{
-					"type": "boolean",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"dhcpdv6_dns_1": map[string]any{
-					"type": "string",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"dhcpdv6_dns_2": map[string]any{
-					"type": "string",
+			},
+		},
+		SummaryFields: []string{"_id", "name", "enabled", "proto", "pfwd_interface", "dst_port", "fwd", "fwd_port"},
+	},
+	{
+		Name:        "create_port_forward",
+		Description: "Create new PortForward",
+		Category:    "create",
+		Resource:    "PortForward",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"dhcpdv6_dns_3": map[string]any{
-					"type": "string",
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"dhcpdv6_dns_4": map[string]any{
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"dhcpdv6_dns_auto": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpdv6_enabled": map[string]any{
+				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpdv6_leasetime": map[string]any{
-					"type": "integer",
+				"destination_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^any$",
 				},
-				"dhcpdv6_start": map[string]any{
-					"type": "string",
+				"destination_ips": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"dhcpdv6_stop": map[string]any{
-					"type": "string",
+				"dst_port": map[string]any{
+					"type":    "string",
+					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
 				},
-				"dhcpguard_enabled": map[string]any{
+				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"domain_name": map[string]any{
+				"fwd": map[string]any{
 					"type":    "string",
-					"pattern": "(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^$|[a-zA-Z0-9-]{1,63}",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
 				},
-				"dpi_enabled": map[string]any{
+				"fwd_port": map[string]any{
+					"type":    "string",
+					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
+				},
+				"log": map[string]any{
 					"type": "boolean",
 				},
-				"dpigroup_id": map[string]any{
+				"name": map[string]any{
 					"type":    "string",
-					"pattern": "[\\d\\w]+|^$",
+					"pattern": ".{1,128}",
 				},
-				"enabled": map[string]any{
-					"type": "boolean",
+				"pfwd_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: wan|wan2|both|all",
+					"enum":        []any{"wan", "wan2", "both", "all"},
 				},
-				"exposed_to_site_vpn": map[string]any{
-					"type": "boolean",
+				"proto": map[string]any{
+					"type":        "string",
+					"description": "One of: tcp_udp|tcp|udp",
+					"enum":        []any{"tcp_udp", "tcp", "udp"},
 				},
-				"firewall_zone_id": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"gateway_device": map[string]any{
+				"src": map[string]any{
 					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^any$",
 				},
-				"gateway_type": map[string]any{
+				"src_firewall_group_id": map[string]any{
+					"type": "string",
+				},
+				"src_firewall_group_name": map[string]any{
 					"type":        "string",
-					"description": "One of: default|switch",
-					"enum":        []any{"default", "switch"},
+					"description": "Name of the FirewallGroup for src_firewall_group_id, in place of its ID",
 				},
-				"igmp_fastleave": map[string]any{
+				"src_limiting_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"igmp_forward_unknown_multicast": map[string]any{
-					"type": "boolean",
+				"src_limiting_type": map[string]any{
+					"type":        "string",
+					"description": "One of: ip|firewall_group",
+					"enum":        []any{"ip", "firewall_group"},
 				},
-				"igmp_groupmembership": map[string]any{
-					"type":    "integer",
-					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"igmp_maxresponse": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9]|1[0-9]|2[0-5]|^$",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
 				},
-				"igmp_mcrtrexpiretime": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"igmp_proxy_downstream_networkconf_ids": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"igmp_proxy_for": map[string]any{
+			},
+		},
+		NameInputs: map[string]string{
+			"src_firewall_group_name": "src_firewall_group_id",
+		},
+	},
+	{
+		Name:        "update_port_forward",
+		Description: "Update PortForward by ID",
+		Category:    "update",
+		Resource:    "PortForward",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: all|some|none",
-					"enum":        []any{"all", "some", "none"},
-				},
-				"igmp_proxy_upstream": map[string]any{
-					"type": "boolean",
-				},
-				"igmp_querier_switches": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+					"description": "UniFi site name (default: 'default')",
 				},
-				"igmp_snooping": map[string]any{
-					"type": "boolean",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
 				},
-				"igmp_supression": map[string]any{
+				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
-				"interface_mtu": map[string]any{
-					"type":    "integer",
-					"pattern": "^(6[89]|[7-9][0-9]|[1-9][0-9]{2,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|65500)$",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"interface_mtu_enabled": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"internet_access_enabled": map[string]any{
+				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"ip_subnet": map[string]any{
+				"destination_ip": map[string]any{
 					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^any$",
 				},
-				"ipsec_dh_group": map[string]any{
-					"type":        "integer",
-					"description": "One of: 2|5|14|15|16|19|20|21|25|26",
-					"enum":        []any{"2", "5", "14", "15", "16", "19", "20", "21", "25", "26"},
+				"destination_ips": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"ipsec_dynamic_routing": map[string]any{
-					"type": "boolean",
+				"dst_port": map[string]any{
+					"type":    "string",
+					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
 				},
-				"ipsec_encryption": map[string]any{
-					"type":        "string",
-					"description": "One of: aes128|aes192|aes256|3des",
-					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				"enabled": map[string]any{
+					"type": "boolean",
 				},
-				"ipsec_esp_dh_group": map[string]any{
-					"type":        "integer",
-					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
-					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+				"fwd": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
 				},
-				"ipsec_esp_encryption": map[string]any{
-					"type":        "string",
-					"description": "One of: aes128|aes192|aes256|3des",
-					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				"fwd_port": map[string]any{
+					"type":    "string",
+					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
 				},
-				"ipsec_esp_hash": map[string]any{
-					"type":        "string",
-					"description": "One of: sha1|md5|sha256|sha384|sha512",
-					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				"log": map[string]any{
+					"type": "boolean",
 				},
-				"ipsec_esp_lifetime": map[string]any{
+				"name": map[string]any{
 					"type":    "string",
-					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+					"pattern": ".{1,128}",
 				},
-				"ipsec_hash": map[string]any{
+				"pfwd_interface": map[string]any{
 					"type":        "string",
-					"description": "One of: sha1|md5|sha256|sha384|sha512",
-					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
-				},
-				"ipsec_ike_dh_group": map[string]any{
-					"type":        "integer",
-					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
-					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+					"description": "One of: wan|wan2|both|all",
+					"enum":        []any{"wan", "wan2", "both", "all"},
 				},
-				"ipsec_ike_encryption": map[string]any{
+				"proto": map[string]any{
 					"type":        "string",
-					"description": "One of: aes128|aes192|aes256|3des",
-					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+					"description": "One of: tcp_udp|tcp|udp",
+					"enum":        []any{"tcp_udp", "tcp", "udp"},
 				},
-				"ipsec_ike_hash": map[string]any{
-					"type":        "string",
-					"description": "One of: sha1|md5|sha256|sha384|sha512",
-					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"ipsec_ike_lifetime": map[string]any{
+				"src": map[string]any{
 					"type":    "string",
-					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^any$",
 				},
-				"ipsec_interface": map[string]any{
+				"src_firewall_group_id": map[string]any{
+					"type": "string",
+				},
+				"src_firewall_group_name": map[string]any{
 					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
+					"description": "Name of the FirewallGroup for src_firewall_group_id, in place of its ID",
 				},
-				"ipsec_key_exchange": map[string]any{
+				"src_limiting_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"src_limiting_type": map[string]any{
 					"type":        "string",
-					"description": "One of: ikev1|ikev2",
-					"enum":        []any{"ikev1", "ikev2"},
+					"description": "One of: ip|firewall_group",
+					"enum":        []any{"ip", "firewall_group"},
 				},
-				"ipsec_local_identifier": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"ipsec_local_identifier_enabled": map[string]any{
-					"type": "boolean",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"ipsec_local_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+			},
+		},
+		NameInputs: map[string]string{
+			"src_firewall_group_name": "src_firewall_group_id",
+		},
+	},
+	{
+		Name:        "upsert_port_forward",
+		Description: "Create or update PortForward matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "PortForward",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"ipsec_peer_ip": map[string]any{
-					"type": "string",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing PortForward (default: [\"name\"]). One match is updated, none creates a new PortForward, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
-				"ipsec_pfs": map[string]any{
+				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
-				"ipsec_profile": map[string]any{
-					"type":        "string",
-					"description": "One of: customized|azure_dynamic|azure_static",
-					"enum":        []any{"customized", "azure_dynamic", "azure_static"},
-				},
-				"ipsec_remote_identifier": map[string]any{
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"ipsec_remote_identifier_enabled": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"ipsec_separate_ikev2_networks": map[string]any{
+				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"ipsec_tunnel_ip": map[string]any{
+				"destination_ip": map[string]any{
 					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
-				},
-				"ipsec_tunnel_ip_enabled": map[string]any{
-					"type": "boolean",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^any$",
 				},
-				"ipv6_client_address_assignment": map[string]any{
-					"type":        "string",
-					"description": "One of: slaac|dhcpv6",
-					"enum":        []any{"slaac", "dhcpv6"},
+				"destination_ips": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"ipv6_interface_type": map[string]any{
-					"type":        "string",
-					"description": "One of: static|pd|single_network|none",
-					"enum":        []any{"static", "pd", "single_network", "none"},
+				"dst_port": map[string]any{
+					"type":    "string",
+					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
 				},
-				"ipv6_pd_auto_prefixid_enabled": map[string]any{
+				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"ipv6_pd_interface": map[string]any{
-					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
-				},
-				"ipv6_pd_prefixid": map[string]any{
+				"fwd": map[string]any{
 					"type":    "string",
-					"pattern": "^$|[a-fA-F0-9]{1,4}",
-				},
-				"ipv6_pd_start": map[string]any{
-					"type": "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
 				},
-				"ipv6_pd_stop": map[string]any{
-					"type": "string",
+				"fwd_port": map[string]any{
+					"type":    "string",
+					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
 				},
-				"ipv6_ra_enabled": map[string]any{
+				"log": map[string]any{
 					"type": "boolean",
 				},
-				"ipv6_ra_preferred_lifetime": map[string]any{
-					"type":    "integer",
-					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
 				},
-				"ipv6_ra_priority": map[string]any{
+				"pfwd_interface": map[string]any{
 					"type":        "string",
-					"description": "One of: high|medium|low",
-					"enum":        []any{"high", "medium", "low"},
-				},
-				"ipv6_ra_valid_lifetime": map[string]any{
-					"type":    "integer",
-					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+					"description": "One of: wan|wan2|both|all",
+					"enum":        []any{"wan", "wan2", "both", "all"},
 				},
-				"ipv6_setting_preference": map[string]any{
+				"proto": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+					"description": "One of: tcp_udp|tcp|udp",
+					"enum":        []any{"tcp_udp", "tcp", "udp"},
 				},
-				"ipv6_single_network_interface": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"ipv6_subnet": map[string]any{
+				"src": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^any$",
+				},
+				"src_firewall_group_id": map[string]any{
 					"type": "string",
 				},
-				"ipv6_wan_delegation_type": map[string]any{
+				"src_firewall_group_name": map[string]any{
 					"type":        "string",
-					"description": "One of: pd|single_network|none",
-					"enum":        []any{"pd", "single_network", "none"},
+					"description": "Name of the FirewallGroup for src_firewall_group_id, in place of its ID",
 				},
-				"is_nat": map[string]any{
+				"src_limiting_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"l2tp_allow_weak_ciphers": map[string]any{
-					"type": "boolean",
+				"src_limiting_type": map[string]any{
+					"type":        "string",
+					"description": "One of: ip|firewall_group",
+					"enum":        []any{"ip", "firewall_group"},
 				},
-				"l2tp_interface": map[string]any{
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+		NameInputs: map[string]string{
+			"src_firewall_group_name": "src_firewall_group_id",
+		},
+	},
+	{
+		Name:        "delete_port_forward",
+		Description: "Delete PortForward by ID",
+		Category:    "delete",
+		Resource:    "PortForward",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
+					"description": "UniFi site name (default: 'default')",
 				},
-				"l2tp_local_wan_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name)",
 				},
-				"local_port": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the PortForward by name instead of id",
 				},
-				"lte_lan_enabled": map[string]any{
-					"type": "boolean",
+			},
+		},
+	},
+	{
+		Name:        "list_port_profile",
+		Description: "List all PortProfile resources",
+		Category:    "list",
+		Resource:    "PortProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"mac_override": map[string]any{
-					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
 				},
-				"mac_override_enabled": map[string]any{
-					"type": "boolean",
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each PortProfile (_id, name, forward, native_networkconf_id, tagged_vlan_mgmt, poe_mode), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
 				},
-				"mdns_enabled": map[string]any{
-					"type": "boolean",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"name": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,128}",
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
 				},
-				"nat_outbound_ip_addresses": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"network_isolation_enabled": map[string]any{
-					"type": "boolean",
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
 				},
-				"networkgroup": map[string]any{
-					"type":    "string",
-					"pattern": "LAN[2-8]?",
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
 				},
-				"openvpn_configuration": map[string]any{
-					"type": "string",
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"openvpn_configuration_filename": map[string]any{
-					"type": "string",
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"openvpn_encryption_cipher": map[string]any{
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "One of: AES_256_GCM|AES_256_CBC|BF_CBC",
-					"enum":        []any{"AES_256_GCM", "AES_256_CBC", "BF_CBC"},
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"openvpn_interface": map[string]any{
-					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
 				},
-				"openvpn_local_address": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
 				},
-				"openvpn_local_port": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"openvpn_local_wan_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"openvpn_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: site-to-site|client|server",
-					"enum":        []any{"site-to-site", "client", "server"},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"openvpn_remote_address": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+			},
+		},
+		SummaryFields: []string{"_id", "name", "forward", "native_networkconf_id", "tagged_vlan_mgmt", "poe_mode"},
+	},
+	{
+		Name:        "get_port_profile",
+		Description: "Get PortProfile by ID",
+		Category:    "get",
+		Resource:    "PortProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"openvpn_remote_host": map[string]any{
-					"type":    "string",
-					"pattern": "[^\\\"\\' ]+|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
 				},
-				"openvpn_remote_port": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the PortProfile by name instead of id",
 				},
-				"openvpn_username": map[string]any{
-					"type": "string",
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the PortProfile (_id, name, forward, native_networkconf_id, tagged_vlan_mgmt, poe_mode), full (default) for all of them. Ignored when fields is given.",
 				},
-				"pptpc_require_mppe": map[string]any{
-					"type": "boolean",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"pptpc_route_distance": map[string]any{
-					"type":    "integer",
-					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"pptpc_server_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^[a-zA-Z0-9-]{1,63}$",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"pptpc_username": map[string]any{
-					"type":    "string",
-					"pattern": "[^\\\"\\' ]+",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"priority": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-4]",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"purpose": map[string]any{
+			},
+		},
+		SummaryFields: []string{"_id", "name", "forward", "native_networkconf_id", "tagged_vlan_mgmt", "poe_mode"},
+	},
+	{
+		Name:        "create_port_profile",
+		Description: "Create new PortProfile",
+		Category:    "create",
+		Resource:    "PortProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: corporate|guest|remote-user-vpn|site-vpn|vlan-only|vpn-client|wan",
-					"enum":        []any{"corporate", "guest", "remote-user-vpn", "site-vpn", "vlan-only", "vpn-client", "wan"},
+					"description": "UniFi site name (default: 'default')",
 				},
-				"radiusprofile_id": map[string]any{
-					"type": "string",
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"remote_site_id": map[string]any{
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"remote_site_subnets": map[string]any{
-					"type":    "array",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$|^$",
-					"items":   map[string]any{"type": "string"},
-				},
-				"remote_vpn_dynamic_subnets_enabled": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"remote_vpn_subnets": map[string]any{
-					"type":    "array",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^$",
-					"items":   map[string]any{"type": "string"},
-				},
-				"report_wan_event": map[string]any{
+				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"require_mschapv2": map[string]any{
+				"autoneg": map[string]any{
 					"type": "boolean",
 				},
-				"route_distance": map[string]any{
+				"dot1x_ctrl": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|force_authorized|force_unauthorized|mac_based|multi_host",
+					"enum":        []any{"auto", "force_authorized", "force_unauthorized", "mac_based", "multi_host"},
+				},
+				"dot1x_idle_timeout": map[string]any{
 					"type":    "integer",
-					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+					"pattern": "[0-9]|[1-9][0-9]{1,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]",
 				},
-				"sdwan_remote_site_id": map[string]any{
-					"type": "string",
+				"egress_rate_limit_kbps": map[string]any{
+					"type":    "integer",
+					"pattern": "6[4-9]|[7-9][0-9]|[1-9][0-9]{2,6}",
 				},
-				"setting_preference": map[string]any{
+				"egress_rate_limit_kbps_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"excluded_networkconf_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"excluded_networkconf_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for excluded_networkconf_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"fec_mode": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+					"description": "One of: rs-fec|fc-fec|default|disabled",
+					"enum":        []any{"rs-fec", "fc-fec", "default", "disabled"},
 				},
-				"single_network_lan": map[string]any{
-					"type": "string",
+				"forward": map[string]any{
+					"type":        "string",
+					"description": "One of: all|native|customize|disabled",
+					"enum":        []any{"all", "native", "customize", "disabled"},
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"full_duplex": map[string]any{
+					"type": "boolean",
 				},
-				"uid_policy_enabled": map[string]any{
+				"isolation": map[string]any{
 					"type": "boolean",
 				},
-				"uid_policy_name": map[string]any{
-					"type": "string",
+				"lldpmed_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"uid_public_gateway_port": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				"lldpmed_notify_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"uid_traffic_rules_allowed_ips_and_hostnames": map[string]any{
+				"multicast_router_networkconf_ids": map[string]any{
 					"type":  "array",
 					"items": map[string]any{"type": "string"},
 				},
-				"uid_traffic_rules_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"uid_vpn_custom_routing": map[string]any{
-					"type":    "array",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
-					"items":   map[string]any{"type": "string"},
+				"multicast_router_networkconf_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for multicast_router_networkconf_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
 				},
-				"uid_vpn_default_dns_suffix": map[string]any{
+				"name": map[string]any{
 					"type": "string",
 				},
-				"uid_vpn_masquerade_enabled": map[string]any{
-					"type": "boolean",
+				"native_networkconf_id": map[string]any{
+					"type": "string",
 				},
-				"uid_vpn_max_connection_time_seconds": map[string]any{
-					"type":    "integer",
-					"pattern": "^[1-9][0-9]*$",
+				"native_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for native_networkconf_id, in place of its ID",
 				},
-				"uid_vpn_sync_public_ip": map[string]any{
-					"type": "boolean",
+				"op_mode": map[string]any{
+					"type":    "string",
+					"pattern": "switch",
 				},
-				"uid_vpn_type": map[string]any{
+				"poe_mode": map[string]any{
 					"type":        "string",
-					"description": "One of: openvpn|wireguard",
-					"enum":        []any{"openvpn", "wireguard"},
+					"description": "One of: auto|off",
+					"enum":        []any{"auto", "off"},
 				},
-				"uid_workspace_url": map[string]any{
-					"type": "string",
+				"port_keepalive_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"upnp_lan_enabled": map[string]any{
+				"port_security_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"usergroup_id": map[string]any{
-					"type": "string",
+				"port_security_mac_address": map[string]any{
+					"type":    "array",
+					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
+					"items":   map[string]any{"type": "string"},
 				},
-				"vlan": map[string]any{
+				"priority_queue1_level": map[string]any{
 					"type":    "integer",
-					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|400[0-9]|401[0-8]|^$",
-				},
-				"vlan_enabled": map[string]any{
-					"type": "boolean",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"vpn_client_configuration_remote_ip_override": map[string]any{
-					"type": "string",
+				"priority_queue2_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"vpn_client_configuration_remote_ip_override_enabled": map[string]any{
-					"type": "boolean",
+				"priority_queue3_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"vpn_client_default_route": map[string]any{
-					"type": "boolean",
+				"priority_queue4_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"vpn_client_pull_dns": map[string]any{
-					"type": "boolean",
+				"qos_profile": map[string]any{
+					"type": "object",
 				},
-				"vpn_protocol": map[string]any{
+				"setting_preference": map[string]any{
 					"type":        "string",
-					"description": "One of: TCP|UDP",
-					"enum":        []any{"TCP", "UDP"},
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
 				},
-				"vpn_type": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|ipsec-vpn|openvpn-client|openvpn-server|openvpn-vpn|pptp-client|l2tp-server|pptp-server|sdwan-hub-spoke-tunnel|sdwan-mesh-tunnel|uid-server|wireguard-server|wireguard-client",
-					"enum":        []any{"auto", "ipsec-vpn", "openvpn-client", "openvpn-server", "openvpn-vpn", "pptp-client", "l2tp-server", "pptp-server", "sdwan-hub-spoke-tunnel", "sdwan-mesh-tunnel", "uid-server", "wireguard-server", "wireguard-client"},
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"vrrp_ip_subnet_gw1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				"speed": map[string]any{
+					"type":        "integer",
+					"description": "One of: 10|100|1000|2500|5000|10000|20000|25000|40000|50000|100000",
+					"enum":        []any{"10", "100", "1000", "2500", "5000", "10000", "20000", "25000", "40000", "50000", "100000"},
 				},
-				"vrrp_ip_subnet_gw2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				"stormctrl_bcast_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"vrrp_vrid": map[string]any{
+				"stormctrl_bcast_level": map[string]any{
 					"type":    "integer",
-					"pattern": "[1-9]|[1-9][0-9]",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"wan_dhcp_cos": map[string]any{
+				"stormctrl_bcast_rate": map[string]any{
 					"type":    "integer",
-					"pattern": "[0-7]|^$",
+					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
 				},
-				"wan_dhcp_options": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"stormctrl_mcast_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"wan_dhcpv6_pd_size": map[string]any{
+				"stormctrl_mcast_level": map[string]any{
 					"type":    "integer",
-					"pattern": "^(4[89]|5[0-9]|6[0-4])$|^$",
-				},
-				"wan_dns1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"wan_dns2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"wan_dns3": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"wan_dns4": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"stormctrl_mcast_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
 				},
-				"wan_dns_preference": map[string]any{
+				"stormctrl_type": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+					"description": "One of: level|rate",
+					"enum":        []any{"level", "rate"},
 				},
-				"wan_dslite_remote_host": map[string]any{
-					"type": "string",
+				"stormctrl_ucast_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"wan_egress_qos": map[string]any{
+				"stormctrl_ucast_level": map[string]any{
 					"type":    "integer",
-					"pattern": "[1-7]|^$",
+					"pattern": "[0-9]|[1-9][0-9]|100",
+				},
+				"stormctrl_ucast_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
+				},
+				"stp_port_mode": map[string]any{
+					"type": "boolean",
 				},
-				"wan_gateway": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"tagged_vlan_mgmt": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|block_all|custom",
+					"enum":        []any{"auto", "block_all", "custom"},
 				},
-				"wan_gateway_v6": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				"voice_networkconf_id": map[string]any{
+					"type": "string",
 				},
-				"wan_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"voice_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for voice_networkconf_id, in place of its ID",
 				},
-				"wan_ip_aliases": map[string]any{
-					"type":    "array",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([8-9]|[1-2][0-9]|3[0-2])$|^$",
-					"items":   map[string]any{"type": "string"},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"wan_ipv6": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
 				},
-				"wan_ipv6_dns1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"wan_ipv6_dns2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"wan_ipv6_dns_preference": map[string]any{
+			},
+		},
+		NameInputs: map[string]string{
+			"excluded_networkconf_names":         "excluded_networkconf_ids",
+			"multicast_router_networkconf_names": "multicast_router_networkconf_ids",
+			"native_networkconf_name":            "native_networkconf_id",
+			"voice_networkconf_name":             "voice_networkconf_id",
+		},
+	},
+	{
+		Name:        "update_port_profile",
+		Description: "Update PortProfile by ID",
+		Category:    "update",
+		Resource:    "PortProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+					"description": "UniFi site name (default: 'default')",
 				},
-				"wan_load_balance_type": map[string]any{
+				"id": map[string]any{
 					"type":        "string",
-					"description": "One of: failover-only|weighted",
-					"enum":        []any{"failover-only", "weighted"},
+					"description": "Resource ID (omit to select by name)",
 				},
-				"wan_load_balance_weight": map[string]any{
-					"type":    "integer",
-					"pattern": "^$|[1-9]|[1-9][0-9]",
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"wan_netmask": map[string]any{
-					"type":    "string",
-					"pattern": "^((128|192|224|240|248|252|254)\\.0\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0)|255\\.(0|128|192|224|240|248|252|254)))))$",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"wan_networkgroup": map[string]any{
-					"type":    "string",
-					"pattern": "WAN[2]?|WAN_LTE_FAILOVER",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-				"wan_pppoe_password_enabled": map[string]any{
+				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"wan_pppoe_username_enabled": map[string]any{
+				"autoneg": map[string]any{
 					"type": "boolean",
 				},
-				"wan_prefixlen": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])$|^$",
+				"dot1x_ctrl": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|force_authorized|force_unauthorized|mac_based|multi_host",
+					"enum":        []any{"auto", "force_authorized", "force_unauthorized", "mac_based", "multi_host"},
 				},
-				"wan_provider_capabilities": map[string]any{
-					"type": "object",
+				"dot1x_idle_timeout": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]",
 				},
-				"wan_smartq_down_rate": map[string]any{
+				"egress_rate_limit_kbps": map[string]any{
 					"type":    "integer",
-					"pattern": "[0-9]{1,6}|1000000",
+					"pattern": "6[4-9]|[7-9][0-9]|[1-9][0-9]{2,6}",
 				},
-				"wan_smartq_enabled": map[string]any{
+				"egress_rate_limit_kbps_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"wan_smartq_up_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]{1,6}|1000000",
+				"excluded_networkconf_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
 				},
-				"wan_type": map[string]any{
+				"excluded_networkconf_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for excluded_networkconf_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"fec_mode": map[string]any{
 					"type":        "string",
-					"description": "One of: disabled|dhcp|static|pppoe|dslite",
-					"enum":        []any{"disabled", "dhcp", "static", "pppoe", "dslite"},
+					"description": "One of: rs-fec|fc-fec|default|disabled",
+					"enum":        []any{"rs-fec", "fc-fec", "default", "disabled"},
 				},
-				"wan_type_v6": map[string]any{
+				"forward": map[string]any{
 					"type":        "string",
-					"description": "One of: disabled|slaac|dhcpv6|static",
-					"enum":        []any{"disabled", "slaac", "dhcpv6", "static"},
+					"description": "One of: all|native|customize|disabled",
+					"enum":        []any{"all", "native", "customize", "disabled"},
 				},
-				"wan_username": map[string]any{
-					"type":    "string",
-					"pattern": "[^\"' ]+|^$",
+				"full_duplex": map[string]any{
+					"type": "boolean",
 				},
-				"wan_vlan": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|40[0-8][0-9]|409[0-4]|^$",
+				"isolation": map[string]any{
+					"type": "boolean",
 				},
-				"wan_vlan_enabled": map[string]any{
+				"lldpmed_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"wireguard_client_configuration_file": map[string]any{
+				"lldpmed_notify_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"multicast_router_networkconf_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"multicast_router_networkconf_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for multicast_router_networkconf_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"name": map[string]any{
 					"type": "string",
 				},
-				"wireguard_client_configuration_filename": map[string]any{
+				"native_networkconf_id": map[string]any{
 					"type": "string",
 				},
-				"wireguard_client_mode": map[string]any{
+				"native_networkconf_name": map[string]any{
 					"type":        "string",
-					"description": "One of: file|manual",
-					"enum":        []any{"file", "manual"},
+					"description": "Name of the Network for native_networkconf_id, in place of its ID",
 				},
-				"wireguard_client_peer_ip": map[string]any{
-					"type": "string",
+				"op_mode": map[string]any{
+					"type":    "string",
+					"pattern": "switch",
 				},
-				"wireguard_client_peer_port": map[string]any{
+				"poe_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|off",
+					"enum":        []any{"auto", "off"},
+				},
+				"port_keepalive_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"port_security_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"port_security_mac_address": map[string]any{
+					"type":    "array",
+					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"priority_queue1_level": map[string]any{
 					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"wireguard_client_peer_public_key": map[string]any{
-					"type": "string",
+				"priority_queue2_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"wireguard_client_preshared_key": map[string]any{
-					"type": "string",
+				"priority_queue3_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"wireguard_client_preshared_key_enabled": map[string]any{
-					"type": "boolean",
+				"priority_queue4_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"wireguard_interface": map[string]any{
-					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
+				"qos_profile": map[string]any{
+					"type": "object",
 				},
-				"wireguard_local_wan_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
 				},
-				"wireguard_public_key": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"x_auth_key": map[string]any{
-					"type": "string",
+				"speed": map[string]any{
+					"type":        "integer",
+					"description": "One of: 10|100|1000|2500|5000|10000|20000|25000|40000|50000|100000",
+					"enum":        []any{"10", "100", "1000", "2500", "5000", "10000", "20000", "25000", "40000", "50000", "100000"},
 				},
-				"x_ca_crt": map[string]any{
-					"type": "string",
+				"stormctrl_bcast_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"x_ca_key": map[string]any{
-					"type": "string",
+				"stormctrl_bcast_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"x_dh_key": map[string]any{
-					"type": "string",
+				"stormctrl_bcast_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
 				},
-				"x_ipsec_pre_shared_key": map[string]any{
-					"type":    "string",
-					"pattern": "[^\\\"\\' ]+",
+				"stormctrl_mcast_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"x_openvpn_password": map[string]any{
-					"type": "string",
+				"stormctrl_mcast_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"x_openvpn_shared_secret_key": map[string]any{
-					"type":    "string",
-					"pattern": "[0-9A-Fa-f]{512}",
+				"stormctrl_mcast_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
 				},
-				"x_pptpc_password": map[string]any{
-					"type":    "string",
-					"pattern": "[^\\\"\\' ]+",
+				"stormctrl_type": map[string]any{
+					"type":        "string",
+					"description": "One of: level|rate",
+					"enum":        []any{"level", "rate"},
 				},
-				"x_server_crt": map[string]any{
-					"type": "string",
+				"stormctrl_ucast_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"stormctrl_ucast_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
+				},
+				"stormctrl_ucast_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
 				},
-				"x_server_key": map[string]any{
-					"type": "string",
+				"stp_port_mode": map[string]any{
+					"type": "boolean",
 				},
-				"x_shared_client_crt": map[string]any{
-					"type": "string",
+				"tagged_vlan_mgmt": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|block_all|custom",
+					"enum":        []any{"auto", "block_all", "custom"},
 				},
-				"x_shared_client_key": map[string]any{
+				"voice_networkconf_id": map[string]any{
 					"type": "string",
 				},
-				"x_wan_password": map[string]any{
-					"type":    "string",
-					"pattern": "[^\"' ]+|^$",
+				"voice_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for voice_networkconf_id, in place of its ID",
 				},
-				"x_wireguard_private_key": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -5403,12 +12191,18 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"excluded_networkconf_names":         "excluded_networkconf_ids",
+			"multicast_router_networkconf_names": "multicast_router_networkconf_ids",
+			"native_networkconf_name":            "native_networkconf_id",
+			"voice_networkconf_name":             "voice_networkconf_id",
+		},
 	},
 	{
-		Name:        "update_network",
-		Description: "Update Network by ID",
-		Category:    "update",
-		Resource:    "Network",
+		Name:        "upsert_port_profile",
+		Description: "Create or update PortProfile matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "PortProfile",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -5416,9 +12210,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing PortProfile (default: [\"name\"]). One match is updated, none creates a new PortProfile, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -5432,894 +12227,1091 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"auto_scale_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"dhcp_relay_enabled": map[string]any{
+				"autoneg": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpd_boot_enabled": map[string]any{
-					"type": "boolean",
+				"dot1x_ctrl": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|force_authorized|force_unauthorized|mac_based|multi_host",
+					"enum":        []any{"auto", "force_authorized", "force_unauthorized", "mac_based", "multi_host"},
 				},
-				"dhcpd_boot_filename": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,256}",
+				"dot1x_idle_timeout": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]",
 				},
-				"dhcpd_boot_server": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|[a-zA-Z0-9-]{1,63}|^$",
+				"egress_rate_limit_kbps": map[string]any{
+					"type":    "integer",
+					"pattern": "6[4-9]|[7-9][0-9]|[1-9][0-9]{2,6}",
 				},
-				"dhcpd_conflict_checking": map[string]any{
+				"egress_rate_limit_kbps_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpd_dns_1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"excluded_networkconf_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
 				},
-				"dhcpd_dns_2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"excluded_networkconf_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for excluded_networkconf_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
 				},
-				"dhcpd_dns_3": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"fec_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: rs-fec|fc-fec|default|disabled",
+					"enum":        []any{"rs-fec", "fc-fec", "default", "disabled"},
 				},
-				"dhcpd_dns_4": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"forward": map[string]any{
+					"type":        "string",
+					"description": "One of: all|native|customize|disabled",
+					"enum":        []any{"all", "native", "customize", "disabled"},
 				},
-				"dhcpd_dns_enabled": map[string]any{
+				"full_duplex": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpd_enabled": map[string]any{
+				"isolation": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpd_gateway": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_gateway_enabled": map[string]any{
+				"lldpmed_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpd_ip_1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_ip_2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_ip_3": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_leasetime": map[string]any{
-					"type": "integer",
-				},
-				"dhcpd_mac_1": map[string]any{
-					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
-				},
-				"dhcpd_mac_2": map[string]any{
-					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
-				},
-				"dhcpd_mac_3": map[string]any{
-					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
-				},
-				"dhcpd_ntp_1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_ntp_2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_ntp_enabled": map[string]any{
+				"lldpmed_notify_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpd_start": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"multicast_router_networkconf_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
 				},
-				"dhcpd_stop": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"multicast_router_networkconf_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for multicast_router_networkconf_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
 				},
-				"dhcpd_tftp_server": map[string]any{
+				"name": map[string]any{
 					"type": "string",
 				},
-				"dhcpd_time_offset": map[string]any{
-					"type":    "integer",
-					"pattern": "^0$|^-?([1-9]([0-9]{1,3})?|[1-7][0-9]{4}|[8][0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
-				},
-				"dhcpd_time_offset_enabled": map[string]any{
-					"type": "boolean",
+				"native_networkconf_id": map[string]any{
+					"type": "string",
 				},
-				"dhcpd_unifi_controller": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"native_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for native_networkconf_id, in place of its ID",
 				},
-				"dhcpd_wins_1": map[string]any{
+				"op_mode": map[string]any{
 					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+					"pattern": "switch",
 				},
-				"dhcpd_wins_2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"poe_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|off",
+					"enum":        []any{"auto", "off"},
 				},
-				"dhcpd_wins_enabled": map[string]any{
+				"port_keepalive_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpd_wpad_url": map[string]any{
-					"type": "string",
-				},
-				"dhcpdv6_allow_slaac": map[string]any{
+				"port_security_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"dhcpdv6_dns_1": map[string]any{
-					"type": "string",
-				},
-				"dhcpdv6_dns_2": map[string]any{
-					"type": "string",
+				"port_security_mac_address": map[string]any{
+					"type":    "array",
+					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
+					"items":   map[string]any{"type": "string"},
 				},
-				"dhcpdv6_dns_3": map[string]any{
-					"type": "string",
+				"priority_queue1_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"dhcpdv6_dns_4": map[string]any{
-					"type": "string",
+				"priority_queue2_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"dhcpdv6_dns_auto": map[string]any{
-					"type": "boolean",
+				"priority_queue3_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"dhcpdv6_enabled": map[string]any{
-					"type": "boolean",
+				"priority_queue4_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"dhcpdv6_leasetime": map[string]any{
-					"type": "integer",
+				"qos_profile": map[string]any{
+					"type": "object",
 				},
-				"dhcpdv6_start": map[string]any{
-					"type": "string",
+				"setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
 				},
-				"dhcpdv6_stop": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"dhcpguard_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"domain_name": map[string]any{
-					"type":    "string",
-					"pattern": "(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^$|[a-zA-Z0-9-]{1,63}",
+				"speed": map[string]any{
+					"type":        "integer",
+					"description": "One of: 10|100|1000|2500|5000|10000|20000|25000|40000|50000|100000",
+					"enum":        []any{"10", "100", "1000", "2500", "5000", "10000", "20000", "25000", "40000", "50000", "100000"},
 				},
-				"dpi_enabled": map[string]any{
+				"stormctrl_bcast_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"dpigroup_id": map[string]any{
-					"type":    "string",
-					"pattern": "[\\d\\w]+|^$",
+				"stormctrl_bcast_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"enabled": map[string]any{
-					"type": "boolean",
+				"stormctrl_bcast_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
 				},
-				"exposed_to_site_vpn": map[string]any{
+				"stormctrl_mcast_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"firewall_zone_id": map[string]any{
-					"type": "string",
+				"stormctrl_mcast_level": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"gateway_device": map[string]any{
-					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				"stormctrl_mcast_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
 				},
-				"gateway_type": map[string]any{
+				"stormctrl_type": map[string]any{
 					"type":        "string",
-					"description": "One of: default|switch",
-					"enum":        []any{"default", "switch"},
-				},
-				"igmp_fastleave": map[string]any{
-					"type": "boolean",
+					"description": "One of: level|rate",
+					"enum":        []any{"level", "rate"},
 				},
-				"igmp_forward_unknown_multicast": map[string]any{
+				"stormctrl_ucast_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"igmp_groupmembership": map[string]any{
+				"stormctrl_ucast_level": map[string]any{
 					"type":    "integer",
-					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+					"pattern": "[0-9]|[1-9][0-9]|100",
 				},
-				"igmp_maxresponse": map[string]any{
+				"stormctrl_ucast_rate": map[string]any{
 					"type":    "integer",
-					"pattern": "[1-9]|1[0-9]|2[0-5]|^$",
+					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
 				},
-				"igmp_mcrtrexpiretime": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-2][0-9]{3}|3[0-5][0-9]{2}|3600|^$",
+				"stp_port_mode": map[string]any{
+					"type": "boolean",
 				},
-				"igmp_proxy_downstream_networkconf_ids": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
+				"tagged_vlan_mgmt": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|block_all|custom",
+					"enum":        []any{"auto", "block_all", "custom"},
 				},
-				"igmp_proxy_for": map[string]any{
+				"voice_networkconf_id": map[string]any{
+					"type": "string",
+				},
+				"voice_networkconf_name": map[string]any{
 					"type":        "string",
-					"description": "One of: all|some|none",
-					"enum":        []any{"all", "some", "none"},
+					"description": "Name of the Network for voice_networkconf_id, in place of its ID",
 				},
-				"igmp_proxy_upstream": map[string]any{
-					"type": "boolean",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"igmp_querier_switches": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"igmp_snooping": map[string]any{
-					"type": "boolean",
+			},
+		},
+		NameInputs: map[string]string{
+			"excluded_networkconf_names":         "excluded_networkconf_ids",
+			"multicast_router_networkconf_names": "multicast_router_networkconf_ids",
+			"native_networkconf_name":            "native_networkconf_id",
+			"voice_networkconf_name":             "voice_networkconf_id",
+		},
+	},
+	{
+		Name:        "delete_port_profile",
+		Description: "Delete PortProfile by ID",
+		Category:    "delete",
+		Resource:    "PortProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"igmp_supression": map[string]any{
-					"type": "boolean",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name)",
 				},
-				"interface_mtu": map[string]any{
-					"type":    "integer",
-					"pattern": "^(6[89]|[7-9][0-9]|[1-9][0-9]{2,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|65500)$",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the PortProfile by name instead of id",
 				},
-				"interface_mtu_enabled": map[string]any{
-					"type": "boolean",
+			},
+		},
+	},
+	{
+		Name:        "list_radius_profile",
+		Description: "List all RADIUSProfile resources",
+		Category:    "list",
+		Resource:    "RADIUSProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"internet_access_enabled": map[string]any{
-					"type": "boolean",
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
 				},
-				"ip_subnet": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"ipsec_dh_group": map[string]any{
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
 					"type":        "integer",
-					"description": "One of: 2|5|14|15|16|19|20|21|25|26",
-					"enum":        []any{"2", "5", "14", "15", "16", "19", "20", "21", "25", "26"},
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
 				},
-				"ipsec_dynamic_routing": map[string]any{
-					"type": "boolean",
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
 				},
-				"ipsec_encryption": map[string]any{
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "One of: aes128|aes192|aes256|3des",
-					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"ipsec_esp_dh_group": map[string]any{
+				"max_tokens": map[string]any{
 					"type":        "integer",
-					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
-					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
 				},
-				"ipsec_esp_encryption": map[string]any{
-					"type":        "string",
-					"description": "One of: aes128|aes192|aes256|3des",
-					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
 				},
-				"ipsec_esp_hash": map[string]any{
+				"format": map[string]any{
 					"type":        "string",
-					"description": "One of: sha1|md5|sha256|sha384|sha512",
-					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"ipsec_esp_lifetime": map[string]any{
-					"type":    "string",
-					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"ipsec_hash": map[string]any{
-					"type":        "string",
-					"description": "One of: sha1|md5|sha256|sha384|sha512",
-					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"ipsec_ike_dh_group": map[string]any{
-					"type":        "integer",
-					"description": "One of: 1|2|5|14|15|16|17|18|19|20|21|22|23|24|25|26|27|28|29|30|31|32",
-					"enum":        []any{"1", "2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32"},
+			},
+		},
+	},
+	{
+		Name:        "get_radius_profile",
+		Description: "Get RADIUSProfile by ID",
+		Category:    "get",
+		Resource:    "RADIUSProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"ipsec_ike_encryption": map[string]any{
+				"id": map[string]any{
 					"type":        "string",
-					"description": "One of: aes128|aes192|aes256|3des",
-					"enum":        []any{"aes128", "aes192", "aes256", "3des"},
+					"description": "Resource ID (omit to select by name)",
 				},
-				"ipsec_ike_hash": map[string]any{
+				"name": map[string]any{
 					"type":        "string",
-					"description": "One of: sha1|md5|sha256|sha384|sha512",
-					"enum":        []any{"sha1", "md5", "sha256", "sha384", "sha512"},
+					"description": "Select the RADIUSProfile by name instead of id",
 				},
-				"ipsec_ike_lifetime": map[string]any{
-					"type":    "string",
-					"pattern": "^(?:3[0-9]|[4-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9]{2}|86400)$",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"ipsec_interface": map[string]any{
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"ipsec_key_exchange": map[string]any{
+				"format": map[string]any{
 					"type":        "string",
-					"description": "One of: ikev1|ikev2",
-					"enum":        []any{"ikev1", "ikev2"},
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"ipsec_local_identifier": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"ipsec_local_identifier_enabled": map[string]any{
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "create_radius_profile",
+		Description: "Create new RADIUSProfile",
+		Category:    "create",
+		Resource:    "RADIUSProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"accounting_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"ipsec_local_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"acct_servers": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"ipsec_peer_ip": map[string]any{
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"ipsec_pfs": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"ipsec_profile": map[string]any{
-					"type":        "string",
-					"description": "One of: customized|azure_dynamic|azure_static",
-					"enum":        []any{"customized", "azure_dynamic", "azure_static"},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"ipsec_remote_identifier": map[string]any{
-					"type": "string",
+				"auth_servers": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"ipsec_remote_identifier_enabled": map[string]any{
+				"interim_update_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"ipsec_separate_ikev2_networks": map[string]any{
-					"type": "boolean",
+				"interim_update_interval": map[string]any{
+					"type":    "integer",
+					"pattern": "^([6-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9][0-9]|86400)$",
 				},
-				"ipsec_tunnel_ip": map[string]any{
+				"name": map[string]any{
 					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
+					"pattern": ".{1,128}",
 				},
-				"ipsec_tunnel_ip_enabled": map[string]any{
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"tls_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"ipv6_client_address_assignment": map[string]any{
-					"type":        "string",
-					"description": "One of: slaac|dhcpv6",
-					"enum":        []any{"slaac", "dhcpv6"},
+				"use_usg_acct_server": map[string]any{
+					"type": "boolean",
 				},
-				"ipv6_interface_type": map[string]any{
-					"type":        "string",
-					"description": "One of: static|pd|single_network|none",
-					"enum":        []any{"static", "pd", "single_network", "none"},
+				"use_usg_auth_server": map[string]any{
+					"type": "boolean",
 				},
-				"ipv6_pd_auto_prefixid_enabled": map[string]any{
+				"vlan_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"ipv6_pd_interface": map[string]any{
+				"vlan_wlan_mode": map[string]any{
 					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
+					"description": "One of: disabled|optional|required",
+					"enum":        []any{"disabled", "optional", "required"},
+				},
+				"x_ca_crts": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"ipv6_pd_prefixid": map[string]any{
-					"type":    "string",
-					"pattern": "^$|[a-fA-F0-9]{1,4}",
+				"x_client_crt": map[string]any{
+					"type": "string",
 				},
-				"ipv6_pd_start": map[string]any{
+				"x_client_crt_filename": map[string]any{
 					"type": "string",
 				},
-				"ipv6_pd_stop": map[string]any{
+				"x_client_private_key": map[string]any{
 					"type": "string",
 				},
-				"ipv6_ra_enabled": map[string]any{
-					"type": "boolean",
+				"x_client_private_key_filename": map[string]any{
+					"type": "string",
 				},
-				"ipv6_ra_preferred_lifetime": map[string]any{
-					"type":    "integer",
-					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+				"x_client_private_key_password": map[string]any{
+					"type": "string",
 				},
-				"ipv6_ra_priority": map[string]any{
+				"idempotency_key": map[string]any{
 					"type":        "string",
-					"description": "One of: high|medium|low",
-					"enum":        []any{"high", "medium", "low"},
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"ipv6_ra_valid_lifetime": map[string]any{
-					"type":    "integer",
-					"pattern": "^([0-9]|[1-8][0-9]|9[0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|[1-8][0-9]{3}|9[0-8][0-9]{2}|99[0-8][0-9]|999[0-9]|[1-8][0-9]{4}|9[0-8][0-9]{3}|99[0-8][0-9]{2}|999[0-8][0-9]|9999[0-9]|[1-8][0-9]{5}|9[0-8][0-9]{4}|99[0-8][0-9]{3}|999[0-8][0-9]{2}|9999[0-8][0-9]|99999[0-9]|[1-8][0-9]{6}|9[0-8][0-9]{5}|99[0-8][0-9]{4}|999[0-8][0-9]{3}|9999[0-8][0-9]{2}|99999[0-8][0-9]|999999[0-9]|[12][0-9]{7}|30[0-9]{6}|31[0-4][0-9]{5}|315[0-2][0-9]{4}|3153[0-5][0-9]{3}|31536000)$|^$",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
 				},
-				"ipv6_setting_preference": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"ipv6_single_network_interface": map[string]any{
-					"type": "string",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"ipv6_subnet": map[string]any{
-					"type": "string",
+			},
+		},
+	},
+	{
+		Name:        "update_radius_profile",
+		Description: "Update RADIUSProfile by ID",
+		Category:    "update",
+		Resource:    "RADIUSProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"ipv6_wan_delegation_type": map[string]any{
+				"id": map[string]any{
 					"type":        "string",
-					"description": "One of: pd|single_network|none",
-					"enum":        []any{"pd", "single_network", "none"},
+					"description": "Resource ID (omit to select by name)",
 				},
-				"is_nat": map[string]any{
+				"accounting_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"l2tp_allow_weak_ciphers": map[string]any{
-					"type": "boolean",
+				"acct_servers": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"l2tp_interface": map[string]any{
-					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"l2tp_local_wan_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"local_port": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-				"lte_lan_enabled": map[string]any{
+				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"mac_override": map[string]any{
-					"type":    "string",
-					"pattern": "(^$|^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$)",
+				"auth_servers": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"mac_override_enabled": map[string]any{
+				"interim_update_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"mdns_enabled": map[string]any{
-					"type": "boolean",
+				"interim_update_interval": map[string]any{
+					"type":    "integer",
+					"pattern": "^([6-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9][0-9]|86400)$",
 				},
 				"name": map[string]any{
 					"type":    "string",
 					"pattern": ".{1,128}",
 				},
-				"nat_outbound_ip_addresses": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"network_isolation_enabled": map[string]any{
+				"tls_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"networkgroup": map[string]any{
-					"type":    "string",
-					"pattern": "LAN[2-8]?",
+				"use_usg_acct_server": map[string]any{
+					"type": "boolean",
 				},
-				"openvpn_configuration": map[string]any{
+				"use_usg_auth_server": map[string]any{
+					"type": "boolean",
+				},
+				"vlan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"vlan_wlan_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|optional|required",
+					"enum":        []any{"disabled", "optional", "required"},
+				},
+				"x_ca_crts": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"x_client_crt": map[string]any{
 					"type": "string",
 				},
-				"openvpn_configuration_filename": map[string]any{
+				"x_client_crt_filename": map[string]any{
 					"type": "string",
 				},
-				"openvpn_encryption_cipher": map[string]any{
-					"type":        "string",
-					"description": "One of: AES_256_GCM|AES_256_CBC|BF_CBC",
-					"enum":        []any{"AES_256_GCM", "AES_256_CBC", "BF_CBC"},
+				"x_client_private_key": map[string]any{
+					"type": "string",
 				},
-				"openvpn_interface": map[string]any{
-					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
+				"x_client_private_key_filename": map[string]any{
+					"type": "string",
 				},
-				"openvpn_local_address": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"x_client_private_key_password": map[string]any{
+					"type": "string",
 				},
-				"openvpn_local_port": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"openvpn_local_wan_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"openvpn_mode": map[string]any{
+			},
+		},
+	},
+	{
+		Name:        "upsert_radius_profile",
+		Description: "Create or update RADIUSProfile matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "RADIUSProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: site-to-site|client|server",
-					"enum":        []any{"site-to-site", "client", "server"},
+					"description": "UniFi site name (default: 'default')",
 				},
-				"openvpn_remote_address": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing RADIUSProfile (default: [\"name\"]). One match is updated, none creates a new RADIUSProfile, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
-				"openvpn_remote_host": map[string]any{
-					"type":    "string",
-					"pattern": "[^\\\"\\' ]+|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"accounting_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"openvpn_remote_port": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+				"acct_servers": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"openvpn_username": map[string]any{
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"pptpc_require_mppe": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"pptpc_route_distance": map[string]any{
-					"type":    "integer",
-					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"pptpc_server_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|(?=^.{3,253}$)(^((?!-)[a-zA-Z0-9-]{1,63}(?<!-)\\.)+[a-zA-Z]{2,63}$)|^[a-zA-Z0-9-]{1,63}$",
+				"auth_servers": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"pptpc_username": map[string]any{
-					"type":    "string",
-					"pattern": "[^\\\"\\' ]+",
+				"interim_update_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"priority": map[string]any{
+				"interim_update_interval": map[string]any{
 					"type":    "integer",
-					"pattern": "[1-4]",
-				},
-				"purpose": map[string]any{
-					"type":        "string",
-					"description": "One of: corporate|guest|remote-user-vpn|site-vpn|vlan-only|vpn-client|wan",
-					"enum":        []any{"corporate", "guest", "remote-user-vpn", "site-vpn", "vlan-only", "vpn-client", "wan"},
+					"pattern": "^([6-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9][0-9]|86400)$",
 				},
-				"radiusprofile_id": map[string]any{
-					"type": "string",
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
 				},
-				"remote_site_id": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"remote_site_subnets": map[string]any{
-					"type":    "array",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$|^$",
-					"items":   map[string]any{"type": "string"},
-				},
-				"remote_vpn_dynamic_subnets_enabled": map[string]any{
+				"tls_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"remote_vpn_subnets": map[string]any{
-					"type":    "array",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^$",
-					"items":   map[string]any{"type": "string"},
-				},
-				"report_wan_event": map[string]any{
+				"use_usg_acct_server": map[string]any{
 					"type": "boolean",
 				},
-				"require_mschapv2": map[string]any{
+				"use_usg_auth_server": map[string]any{
 					"type": "boolean",
 				},
-				"route_distance": map[string]any{
-					"type":    "integer",
-					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
-				},
-				"sdwan_remote_site_id": map[string]any{
-					"type": "string",
+				"vlan_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"setting_preference": map[string]any{
+				"vlan_wlan_mode": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+					"description": "One of: disabled|optional|required",
+					"enum":        []any{"disabled", "optional", "required"},
+				},
+				"x_ca_crts": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"single_network_lan": map[string]any{
+				"x_client_crt": map[string]any{
 					"type": "string",
 				},
-				"site_id": map[string]any{
+				"x_client_crt_filename": map[string]any{
 					"type": "string",
 				},
-				"uid_policy_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"uid_policy_name": map[string]any{
+				"x_client_private_key": map[string]any{
 					"type": "string",
 				},
-				"uid_public_gateway_port": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
-				},
-				"uid_traffic_rules_allowed_ips_and_hostnames": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
+				"x_client_private_key_filename": map[string]any{
+					"type": "string",
 				},
-				"uid_traffic_rules_enabled": map[string]any{
-					"type": "boolean",
+				"x_client_private_key_password": map[string]any{
+					"type": "string",
 				},
-				"uid_vpn_custom_routing": map[string]any{
-					"type":    "array",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$",
-					"items":   map[string]any{"type": "string"},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"uid_vpn_default_dns_suffix": map[string]any{
-					"type": "string",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"uid_vpn_masquerade_enabled": map[string]any{
-					"type": "boolean",
+			},
+		},
+	},
+	{
+		Name:        "delete_radius_profile",
+		Description: "Delete RADIUSProfile by ID",
+		Category:    "delete",
+		Resource:    "RADIUSProfile",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"uid_vpn_max_connection_time_seconds": map[string]any{
-					"type":    "integer",
-					"pattern": "^[1-9][0-9]*$",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name)",
 				},
-				"uid_vpn_sync_public_ip": map[string]any{
-					"type": "boolean",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the RADIUSProfile by name instead of id",
 				},
-				"uid_vpn_type": map[string]any{
+			},
+		},
+	},
+	{
+		Name:        "list_routing",
+		Description: "List all Routing resources",
+		Category:    "list",
+		Resource:    "Routing",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: openvpn|wireguard",
-					"enum":        []any{"openvpn", "wireguard"},
+					"description": "UniFi site name (default: 'default')",
 				},
-				"uid_workspace_url": map[string]any{
-					"type": "string",
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
 				},
-				"upnp_lan_enabled": map[string]any{
-					"type": "boolean",
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each Routing (_id, name, enabled, type, static-route_network, static-route_nexthop), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
 				},
-				"usergroup_id": map[string]any{
-					"type": "string",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"vlan": map[string]any{
-					"type":    "integer",
-					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|400[0-9]|401[0-8]|^$",
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
 				},
-				"vlan_enabled": map[string]any{
-					"type": "boolean",
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"vpn_client_configuration_remote_ip_override": map[string]any{
-					"type": "string",
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
 				},
-				"vpn_client_configuration_remote_ip_override_enabled": map[string]any{
-					"type": "boolean",
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
 				},
-				"vpn_client_default_route": map[string]any{
-					"type": "boolean",
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"vpn_client_pull_dns": map[string]any{
-					"type": "boolean",
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"vpn_protocol": map[string]any{
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "One of: TCP|UDP",
-					"enum":        []any{"TCP", "UDP"},
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"vpn_type": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|ipsec-vpn|openvpn-client|openvpn-server|openvpn-vpn|pptp-client|l2tp-server|pptp-server|sdwan-hub-spoke-tunnel|sdwan-mesh-tunnel|uid-server|wireguard-server|wireguard-client",
-					"enum":        []any{"auto", "ipsec-vpn", "openvpn-client", "openvpn-server", "openvpn-vpn", "pptp-client", "l2tp-server", "pptp-server", "sdwan-hub-spoke-tunnel", "sdwan-mesh-tunnel", "uid-server", "wireguard-server", "wireguard-client"},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
 				},
-				"vrrp_ip_subnet_gw1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
 				},
-				"vrrp_ip_subnet_gw2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|30)$",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"vrrp_vrid": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9]|[1-9][0-9]",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"wan_dhcp_cos": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-7]|^$",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"wan_dhcp_options": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+			},
+		},
+		SummaryFields: []string{"_id", "name", "enabled", "type", "static-route_network", "static-route_nexthop"},
+	},
+	{
+		Name:        "get_routing",
+		Description: "Get Routing by ID",
+		Category:    "get",
+		Resource:    "Routing",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"wan_dhcpv6_pd_size": map[string]any{
-					"type":    "integer",
-					"pattern": "^(4[89]|5[0-9]|6[0-4])$|^$",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
 				},
-				"wan_dns1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Routing by name instead of id",
 				},
-				"wan_dns2": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the Routing (_id, name, enabled, type, static-route_network, static-route_nexthop), full (default) for all of them. Ignored when fields is given.",
 				},
-				"wan_dns3": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"wan_dns4": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"wan_dns_preference": map[string]any{
+				"format": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"wan_dslite_remote_host": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"wan_egress_qos": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-7]|^$",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"wan_gateway": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+			},
+		},
+		SummaryFields: []string{"_id", "name", "enabled", "type", "static-route_network", "static-route_nexthop"},
+	},
+	{
+		Name:        "create_routing",
+		Description: "Create new Routing",
+		Category:    "create",
+		Resource:    "Routing",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"wan_gateway_v6": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"wan_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"wan_ip_aliases": map[string]any{
-					"type":    "array",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([8-9]|[1-2][0-9]|3[0-2])$|^$",
-					"items":   map[string]any{"type": "string"},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-				"wan_ipv6": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"wan_ipv6_dns1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+				"enabled": map[string]any{
+					"type": "boolean",
 				},
-				"wan_ipv6_dns2": map[string]any{
+				"gateway_device": map[string]any{
 					"type":    "string",
-					"pattern": "^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$|^$",
+					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
 				},
-				"wan_ipv6_dns_preference": map[string]any{
+				"gateway_type": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+					"description": "One of: default|switch",
+					"enum":        []any{"default", "switch"},
 				},
-				"wan_load_balance_type": map[string]any{
-					"type":        "string",
-					"description": "One of: failover-only|weighted",
-					"enum":        []any{"failover-only", "weighted"},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
 				},
-				"wan_load_balance_weight": map[string]any{
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"static-route_distance": map[string]any{
 					"type":    "integer",
-					"pattern": "^$|[1-9]|[1-9][0-9]",
+					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
 				},
-				"wan_netmask": map[string]any{
+				"static-route_interface": map[string]any{
 					"type":    "string",
-					"pattern": "^((128|192|224|240|248|252|254)\\.0\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0\\.0)|(255\\.(((0|128|192|224|240|248|252|254)\\.0)|255\\.(0|128|192|224|240|248|252|254)))))$",
+					"pattern": "WAN1|WAN2|[\\d\\w]+|^$",
 				},
-				"wan_networkgroup": map[string]any{
+				"static-route_network": map[string]any{
 					"type":    "string",
-					"pattern": "WAN[2]?|WAN_LTE_FAILOVER",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^([a-fA-F0-9:]+\\/(([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])))$",
 				},
-				"wan_pppoe_password_enabled": map[string]any{
-					"type": "boolean",
+				"static-route_nexthop": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^([a-fA-F0-9:]+)$|^$",
 				},
-				"wan_pppoe_username_enabled": map[string]any{
-					"type": "boolean",
+				"static-route_type": map[string]any{
+					"type":        "string",
+					"description": "One of: nexthop-route|interface-route|blackhole",
+					"enum":        []any{"nexthop-route", "interface-route", "blackhole"},
 				},
-				"wan_prefixlen": map[string]any{
-					"type":    "integer",
-					"pattern": "^([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])$|^$",
+				"type": map[string]any{
+					"type":    "string",
+					"pattern": "static-route",
 				},
-				"wan_provider_capabilities": map[string]any{
-					"type": "object",
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"wan_smartq_down_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]{1,6}|1000000",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
 				},
-				"wan_smartq_enabled": map[string]any{
-					"type": "boolean",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"wan_smartq_up_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]{1,6}|1000000",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"wan_type": map[string]any{
+			},
+		},
+	},
+	{
+		Name:        "update_routing",
+		Description: "Update Routing by ID",
+		Category:    "update",
+		Resource:    "Routing",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: disabled|dhcp|static|pppoe|dslite",
-					"enum":        []any{"disabled", "dhcp", "static", "pppoe", "dslite"},
+					"description": "UniFi site name (default: 'default')",
 				},
-				"wan_type_v6": map[string]any{
+				"id": map[string]any{
 					"type":        "string",
-					"description": "One of: disabled|slaac|dhcpv6|static",
-					"enum":        []any{"disabled", "slaac", "dhcpv6", "static"},
+					"description": "Resource ID (omit to select by name)",
 				},
-				"wan_username": map[string]any{
-					"type":    "string",
-					"pattern": "[^\"' ]+|^$",
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"wan_vlan": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|40[0-8][0-9]|409[0-4]|^$",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"wan_vlan_enabled": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"wireguard_client_configuration_file": map[string]any{
-					"type": "string",
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"wireguard_client_configuration_filename": map[string]any{
-					"type": "string",
+				"enabled": map[string]any{
+					"type": "boolean",
 				},
-				"wireguard_client_mode": map[string]any{
+				"gateway_device": map[string]any{
+					"type":    "string",
+					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
+				},
+				"gateway_type": map[string]any{
 					"type":        "string",
-					"description": "One of: file|manual",
-					"enum":        []any{"file", "manual"},
+					"description": "One of: default|switch",
+					"enum":        []any{"default", "switch"},
 				},
-				"wireguard_client_peer_ip": map[string]any{
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
+				},
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"wireguard_client_peer_port": map[string]any{
+				"static-route_distance": map[string]any{
 					"type":    "integer",
-					"pattern": "^([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])$",
+					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
 				},
-				"wireguard_client_peer_public_key": map[string]any{
-					"type": "string",
+				"static-route_interface": map[string]any{
+					"type":    "string",
+					"pattern": "WAN1|WAN2|[\\d\\w]+|^$",
 				},
-				"wireguard_client_preshared_key": map[string]any{
-					"type": "string",
+				"static-route_network": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^([a-fA-F0-9:]+\\/(([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])))$",
 				},
-				"wireguard_client_preshared_key_enabled": map[string]any{
-					"type": "boolean",
+				"static-route_nexthop": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^([a-fA-F0-9:]+)$|^$",
 				},
-				"wireguard_interface": map[string]any{
+				"static-route_type": map[string]any{
 					"type":        "string",
-					"description": "One of: wan|wan2",
-					"enum":        []any{"wan", "wan2"},
+					"description": "One of: nexthop-route|interface-route|blackhole",
+					"enum":        []any{"nexthop-route", "interface-route", "blackhole"},
 				},
-				"wireguard_local_wan_ip": map[string]any{
+				"type": map[string]any{
 					"type":    "string",
-					"pattern": "^any$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+					"pattern": "static-route",
 				},
-				"wireguard_public_key": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"x_auth_key": map[string]any{
-					"type": "string",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"x_ca_crt": map[string]any{
-					"type": "string",
+			},
+		},
+	},
+	{
+		Name:        "upsert_routing",
+		Description: "Create or update Routing matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "Routing",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"x_ca_key": map[string]any{
-					"type": "string",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing Routing (default: [\"name\"]). One match is updated, none creates a new Routing, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
-				"x_dh_key": map[string]any{
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"x_ipsec_pre_shared_key": map[string]any{
-					"type":    "string",
-					"pattern": "[^\\\"\\' ]+",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-				"x_openvpn_password": map[string]any{
-					"type": "string",
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"x_openvpn_shared_secret_key": map[string]any{
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"gateway_device": map[string]any{
 					"type":    "string",
-					"pattern": "[0-9A-Fa-f]{512}",
+					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
 				},
-				"x_pptpc_password": map[string]any{
+				"gateway_type": map[string]any{
+					"type":        "string",
+					"description": "One of: default|switch",
+					"enum":        []any{"default", "switch"},
+				},
+				"name": map[string]any{
 					"type":    "string",
-					"pattern": "[^\\\"\\' ]+",
+					"pattern": ".{1,128}",
 				},
-				"x_server_crt": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"x_server_key": map[string]any{
-					"type": "string",
+				"static-route_distance": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
 				},
-				"x_shared_client_crt": map[string]any{
-					"type": "string",
+				"static-route_interface": map[string]any{
+					"type":    "string",
+					"pattern": "WAN1|WAN2|[\\d\\w]+|^$",
 				},
-				"x_shared_client_key": map[string]any{
-					"type": "string",
+				"static-route_network": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^([a-fA-F0-9:]+\\/(([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])))$",
 				},
-				"x_wan_password": map[string]any{
+				"static-route_nexthop": map[string]any{
 					"type":    "string",
-					"pattern": "[^\"' ]+|^$",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^([a-fA-F0-9:]+)$|^$",
 				},
-				"x_wireguard_private_key": map[string]any{
-					"type": "string",
+				"static-route_type": map[string]any{
+					"type":        "string",
+					"description": "One of: nexthop-route|interface-route|blackhole",
+					"enum":        []any{"nexthop-route", "interface-route", "blackhole"},
+				},
+				"type": map[string]any{
+					"type":    "string",
+					"pattern": "static-route",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_network",
-		Description: "Delete Network by ID",
+		Name:        "delete_routing",
+		Description: "Delete Routing by ID",
 		Category:    "delete",
-		Resource:    "Network",
+		Resource:    "Routing",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6329,17 +13321,20 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Routing by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "list_port_forward",
-		Description: "List all PortForward resources",
+		Name:        "list_schedule_task",
+		Description: "List all ScheduleTask resources",
 		Category:    "list",
-		Resource:    "PortForward",
+		Resource:    "ScheduleTask",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6349,17 +13344,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -6369,10 +13421,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_port_forward",
-		Description: "Get PortForward by ID",
+		Name:        "get_schedule_task",
+		Description: "Get ScheduleTask by ID",
 		Category:    "get",
-		Resource:    "PortForward",
+		Resource:    "ScheduleTask",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6382,21 +13434,42 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the ScheduleTask by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "create_port_forward",
-		Description: "Create new PortForward",
+		Name:        "create_schedule_task",
+		Description: "Create new ScheduleTask",
 		Category:    "create",
-		Resource:    "PortForward",
+		Resource:    "ScheduleTask",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6404,6 +13477,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"action": map[string]any{
+					"type":    "string",
+					"pattern": "upgrade",
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -6416,63 +13493,93 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"destination_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^any$",
+				"cron_expr": map[string]any{
+					"type": "string",
 				},
-				"destination_ips": map[string]any{
+				"execute_only_once": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"upgrade_targets": map[string]any{
 					"type":  "array",
 					"items": map[string]any{"type": "object"},
 				},
-				"dst_port": map[string]any{
-					"type":    "string",
-					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"enabled": map[string]any{
-					"type": "boolean",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
 				},
-				"fwd": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"fwd_port": map[string]any{
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "update_schedule_task",
+		Description: "Update ScheduleTask by ID",
+		Category:    "update",
+		Resource:    "ScheduleTask",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"action": map[string]any{
 					"type":    "string",
-					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
+					"pattern": "upgrade",
 				},
-				"log": map[string]any{
+				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
-				"name": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,128}",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"pfwd_interface": map[string]any{
-					"type":        "string",
-					"description": "One of: wan|wan2|both|all",
-					"enum":        []any{"wan", "wan2", "both", "all"},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-				"proto": map[string]any{
-					"type":        "string",
-					"description": "One of: tcp_udp|tcp|udp",
-					"enum":        []any{"tcp_udp", "tcp", "udp"},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"site_id": map[string]any{
+				"cron_expr": map[string]any{
 					"type": "string",
 				},
-				"src": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^any$",
+				"execute_only_once": map[string]any{
+					"type": "boolean",
 				},
-				"src_firewall_group_id": map[string]any{
+				"name": map[string]any{
 					"type": "string",
 				},
-				"src_limiting_enabled": map[string]any{
-					"type": "boolean",
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"src_limiting_type": map[string]any{
-					"type":        "string",
-					"description": "One of: ip|firewall_group",
-					"enum":        []any{"ip", "firewall_group"},
+				"upgrade_targets": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -6482,10 +13589,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_port_forward",
-		Description: "Update PortForward by ID",
-		Category:    "update",
-		Resource:    "PortForward",
+		Name:        "upsert_schedule_task",
+		Description: "Create or update ScheduleTask matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "ScheduleTask",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6493,9 +13600,14 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing ScheduleTask (default: [\"name\"]). One match is updated, none creates a new ScheduleTask, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"action": map[string]any{
+					"type":    "string",
+					"pattern": "upgrade",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -6509,77 +13621,148 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"destination_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^any$",
+				"cron_expr": map[string]any{
+					"type": "string",
 				},
-				"destination_ips": map[string]any{
+				"execute_only_once": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"upgrade_targets": map[string]any{
 					"type":  "array",
 					"items": map[string]any{"type": "object"},
 				},
-				"dst_port": map[string]any{
-					"type":    "string",
-					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
-				},
-				"enabled": map[string]any{
-					"type": "boolean",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"fwd": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"fwd_port": map[string]any{
-					"type":    "string",
-					"pattern": "(([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]))+(,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])|,([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])-([1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5])){0,14}",
+			},
+		},
+	},
+	{
+		Name:        "delete_schedule_task",
+		Description: "Delete ScheduleTask by ID",
+		Category:    "delete",
+		Resource:    "ScheduleTask",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"log": map[string]any{
-					"type": "boolean",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name)",
 				},
 				"name": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,128}",
+					"type":        "string",
+					"description": "Select the ScheduleTask by name instead of id",
 				},
-				"pfwd_interface": map[string]any{
+			},
+		},
+	},
+	{
+		Name:        "get_setting_auto_speedtest",
+		Description: "Get SettingAutoSpeedtest",
+		Category:    "get",
+		Resource:    "SettingAutoSpeedtest",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: wan|wan2|both|all",
-					"enum":        []any{"wan", "wan2", "both", "all"},
+					"description": "UniFi site name (default: 'default')",
 				},
-				"proto": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "One of: tcp_udp|tcp|udp",
-					"enum":        []any{"tcp_udp", "tcp", "udp"},
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"site_id": map[string]any{
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "update_setting_auto_speedtest",
+		Description: "Update SettingAutoSpeedtest",
+		Category:    "update",
+		Resource:    "SettingAutoSpeedtest",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"src": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])-(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^!(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/([0-9]|[1-2][0-9]|3[0-2])$|^any$",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-				"src_firewall_group_id": map[string]any{
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"cron_expr": map[string]any{
 					"type": "string",
 				},
-				"src_limiting_enabled": map[string]any{
+				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"src_limiting_type": map[string]any{
-					"type":        "string",
-					"description": "One of: ip|firewall_group",
-					"enum":        []any{"ip", "firewall_group"},
+				"key": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_port_forward",
-		Description: "Delete PortForward by ID",
-		Category:    "delete",
-		Resource:    "PortForward",
+		Name:        "get_setting_baresip",
+		Description: "Get SettingBaresip",
+		Category:    "get",
+		Resource:    "SettingBaresip",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6587,19 +13770,37 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "list_port_profile",
-		Description: "List all PortProfile resources",
-		Category:    "list",
-		Resource:    "PortProfile",
+		Name:        "update_setting_baresip",
+		Description: "Update SettingBaresip",
+		Category:    "update",
+		Resource:    "SettingBaresip",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6607,19 +13808,39 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"fields": map[string]any{
-					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
-					"items":       map[string]any{"type": "string"},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"search": map[string]any{
-					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"key": map[string]any{
+					"type": "string",
+				},
+				"outbound_proxy": map[string]any{
+					"type": "string",
+				},
+				"package_url": map[string]any{
+					"type": "string",
+				},
+				"server": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -6629,10 +13850,11 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_port_profile",
-		Description: "Get PortProfile by ID",
+		Name:        "get_setting_broadcast",
+		Description: "Get SettingBroadcast",
 		Category:    "get",
-		Resource:    "PortProfile",
+		Resource:    "SettingBroadcast",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6640,23 +13862,37 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "create_port_profile",
-		Description: "Create new PortProfile",
-		Category:    "create",
-		Resource:    "PortProfile",
+		Name:        "update_setting_broadcast",
+		Description: "Update SettingBroadcast",
+		Category:    "update",
+		Resource:    "SettingBroadcast",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6676,161 +13912,75 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"autoneg": map[string]any{
-					"type": "boolean",
-				},
-				"dot1x_ctrl": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|force_authorized|force_unauthorized|mac_based|multi_host",
-					"enum":        []any{"auto", "force_authorized", "force_unauthorized", "mac_based", "multi_host"},
-				},
-				"dot1x_idle_timeout": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]",
-				},
-				"egress_rate_limit_kbps": map[string]any{
-					"type":    "integer",
-					"pattern": "6[4-9]|[7-9][0-9]|[1-9][0-9]{2,6}",
-				},
-				"egress_rate_limit_kbps_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"excluded_networkconf_ids": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
-				},
-				"fec_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: rs-fec|fc-fec|default|disabled",
-					"enum":        []any{"rs-fec", "fc-fec", "default", "disabled"},
-				},
-				"forward": map[string]any{
-					"type":        "string",
-					"description": "One of: all|native|customize|disabled",
-					"enum":        []any{"all", "native", "customize", "disabled"},
-				},
-				"full_duplex": map[string]any{
-					"type": "boolean",
-				},
-				"isolation": map[string]any{
-					"type": "boolean",
-				},
-				"lldpmed_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"lldpmed_notify_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"multicast_router_networkconf_ids": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
-				},
-				"name": map[string]any{
+				"key": map[string]any{
 					"type": "string",
 				},
-				"native_networkconf_id": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"op_mode": map[string]any{
-					"type":    "string",
-					"pattern": "switch",
-				},
-				"poe_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|off",
-					"enum":        []any{"auto", "off"},
-				},
-				"port_keepalive_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"port_security_enabled": map[string]any{
+				"sound_after_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"port_security_mac_address": map[string]any{
-					"type":    "array",
-					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
-					"items":   map[string]any{"type": "string"},
-				},
-				"priority_queue1_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"priority_queue2_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"priority_queue3_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"priority_queue4_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"qos_profile": map[string]any{
-					"type": "object",
-				},
-				"setting_preference": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
-				},
-				"site_id": map[string]any{
+				"sound_after_resource": map[string]any{
 					"type": "string",
 				},
-				"speed": map[string]any{
-					"type":        "integer",
-					"description": "One of: 10|100|1000|2500|5000|10000|20000|25000|40000|50000|100000",
-					"enum":        []any{"10", "100", "1000", "2500", "5000", "10000", "20000", "25000", "40000", "50000", "100000"},
+				"sound_after_type": map[string]any{
+					"type":        "string",
+					"description": "One of: sample|media",
+					"enum":        []any{"sample", "media"},
 				},
-				"stormctrl_bcast_enabled": map[string]any{
+				"sound_before_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"stormctrl_bcast_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"stormctrl_bcast_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
+				"sound_before_resource": map[string]any{
+					"type": "string",
 				},
-				"stormctrl_mcast_enabled": map[string]any{
-					"type": "boolean",
+				"sound_before_type": map[string]any{
+					"type":        "string",
+					"description": "One of: sample|media",
+					"enum":        []any{"sample", "media"},
 				},
-				"stormctrl_mcast_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"stormctrl_mcast_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"stormctrl_type": map[string]any{
+			},
+		},
+	},
+	{
+		Name:        "get_setting_connectivity",
+		Description: "Get SettingConnectivity",
+		Category:    "get",
+		Resource:    "SettingConnectivity",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: level|rate",
-					"enum":        []any{"level", "rate"},
-				},
-				"stormctrl_ucast_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"stormctrl_ucast_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"stormctrl_ucast_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"stp_port_mode": map[string]any{
-					"type": "boolean",
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"tagged_vlan_mgmt": map[string]any{
+				"format": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|block_all|custom",
-					"enum":        []any{"auto", "block_all", "custom"},
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"voice_networkconf_id": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -6840,10 +13990,11 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_port_profile",
-		Description: "Update PortProfile by ID",
+		Name:        "update_setting_connectivity",
+		Description: "Update SettingConnectivity",
 		Category:    "update",
-		Resource:    "PortProfile",
+		Resource:    "SettingConnectivity",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -6851,10 +14002,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -6867,175 +14014,47 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"autoneg": map[string]any{
-					"type": "boolean",
-				},
-				"dot1x_ctrl": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|force_authorized|force_unauthorized|mac_based|multi_host",
-					"enum":        []any{"auto", "force_authorized", "force_unauthorized", "mac_based", "multi_host"},
-				},
-				"dot1x_idle_timeout": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,3}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]",
-				},
-				"egress_rate_limit_kbps": map[string]any{
-					"type":    "integer",
-					"pattern": "6[4-9]|[7-9][0-9]|[1-9][0-9]{2,6}",
-				},
-				"egress_rate_limit_kbps_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"excluded_networkconf_ids": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
-				},
-				"fec_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: rs-fec|fc-fec|default|disabled",
-					"enum":        []any{"rs-fec", "fc-fec", "default", "disabled"},
-				},
-				"forward": map[string]any{
-					"type":        "string",
-					"description": "One of: all|native|customize|disabled",
-					"enum":        []any{"all", "native", "customize", "disabled"},
-				},
-				"full_duplex": map[string]any{
-					"type": "boolean",
-				},
-				"isolation": map[string]any{
-					"type": "boolean",
-				},
-				"lldpmed_enabled": map[string]any{
+				"enable_isolated_wlan": map[string]any{
 					"type": "boolean",
 				},
-				"lldpmed_notify_enabled": map[string]any{
+				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"multicast_router_networkconf_ids": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
-				},
-				"name": map[string]any{
-					"type": "string",
-				},
-				"native_networkconf_id": map[string]any{
+				"key": map[string]any{
 					"type": "string",
 				},
-				"op_mode": map[string]any{
-					"type":    "string",
-					"pattern": "switch",
-				},
-				"poe_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|off",
-					"enum":        []any{"auto", "off"},
-				},
-				"port_keepalive_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"port_security_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"port_security_mac_address": map[string]any{
-					"type":    "array",
-					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
-					"items":   map[string]any{"type": "string"},
-				},
-				"priority_queue1_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"priority_queue2_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"priority_queue3_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"priority_queue4_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"qos_profile": map[string]any{
-					"type": "object",
-				},
-				"setting_preference": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
-				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"speed": map[string]any{
-					"type":        "integer",
-					"description": "One of: 10|100|1000|2500|5000|10000|20000|25000|40000|50000|100000",
-					"enum":        []any{"10", "100", "1000", "2500", "5000", "10000", "20000", "25000", "40000", "50000", "100000"},
-				},
-				"stormctrl_bcast_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"stormctrl_bcast_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"stormctrl_bcast_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
-				},
-				"stormctrl_mcast_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"stormctrl_mcast_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"stormctrl_mcast_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
-				},
-				"stormctrl_type": map[string]any{
-					"type":        "string",
-					"description": "One of: level|rate",
-					"enum":        []any{"level", "rate"},
-				},
-				"stormctrl_ucast_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"stormctrl_ucast_level": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]|100",
-				},
-				"stormctrl_ucast_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-9][0-9]{1,6}|1[0-3][0-9]{6}|14[0-7][0-9]{5}|148[0-7][0-9]{4}|14880000",
+				"uplink_host": map[string]any{
+					"type": "string",
 				},
-				"stp_port_mode": map[string]any{
-					"type": "boolean",
+				"uplink_type": map[string]any{
+					"type": "string",
 				},
-				"tagged_vlan_mgmt": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|block_all|custom",
-					"enum":        []any{"auto", "block_all", "custom"},
+				"x_mesh_essid": map[string]any{
+					"type": "string",
 				},
-				"voice_networkconf_id": map[string]any{
+				"x_mesh_psk": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_port_profile",
-		Description: "Delete PortProfile by ID",
-		Category:    "delete",
-		Resource:    "PortProfile",
+		Name:        "get_setting_country",
+		Description: "Get SettingCountry",
+		Category:    "get",
+		Resource:    "SettingCountry",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7043,19 +14062,37 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "list_radius_profile",
-		Description: "List all RADIUSProfile resources",
-		Category:    "list",
-		Resource:    "RADIUSProfile",
+		Name:        "update_setting_country",
+		Description: "Update SettingCountry",
+		Category:    "update",
+		Resource:    "SettingCountry",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7063,19 +14100,30 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"fields": map[string]any{
-					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
-					"items":       map[string]any{"type": "string"},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"search": map[string]any{
-					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"code": map[string]any{
+					"type": "integer",
+				},
+				"key": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -7085,10 +14133,11 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_radius_profile",
-		Description: "Get RADIUSProfile by ID",
+		Name:        "get_setting_dashboard",
+		Description: "Get SettingDashboard",
 		Category:    "get",
-		Resource:    "RADIUSProfile",
+		Resource:    "SettingDashboard",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7096,23 +14145,37 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "create_radius_profile",
-		Description: "Create new RADIUSProfile",
-		Category:    "create",
-		Resource:    "RADIUSProfile",
+		Name:        "update_setting_dashboard",
+		Description: "Update SettingDashboard",
+		Category:    "update",
+		Resource:    "SettingDashboard",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7120,13 +14183,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"accounting_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"acct_servers": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -7139,59 +14195,62 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"auth_servers": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
-				"interim_update_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"interim_update_interval": map[string]any{
-					"type":    "integer",
-					"pattern": "^([6-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9][0-9]|86400)$",
+				"key": map[string]any{
+					"type": "string",
 				},
-				"name": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,128}",
+				"layout_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"tls_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"use_usg_acct_server": map[string]any{
-					"type": "boolean",
+				"widgets": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"use_usg_auth_server": map[string]any{
-					"type": "boolean",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"vlan_enabled": map[string]any{
-					"type": "boolean",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"vlan_wlan_mode": map[string]any{
+			},
+		},
+	},
+	{
+		Name:        "get_setting_doh",
+		Description: "Get SettingDoh",
+		Category:    "get",
+		Resource:    "SettingDoh",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: disabled|optional|required",
-					"enum":        []any{"disabled", "optional", "required"},
-				},
-				"x_ca_crts": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
-				"x_client_crt": map[string]any{
-					"type": "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"x_client_crt_filename": map[string]any{
-					"type": "string",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"x_client_private_key": map[string]any{
-					"type": "string",
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"x_client_private_key_filename": map[string]any{
-					"type": "string",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"x_client_private_key_password": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -7201,10 +14260,11 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_radius_profile",
-		Description: "Update RADIUSProfile by ID",
+		Name:        "update_setting_doh",
+		Description: "Update SettingDoh",
 		Category:    "update",
-		Resource:    "RADIUSProfile",
+		Resource:    "SettingDoh",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7212,17 +14272,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
-				},
-				"accounting_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"acct_servers": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -7235,93 +14284,42 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"auth_servers": map[string]any{
+				"custom_servers": map[string]any{
 					"type":  "array",
 					"items": map[string]any{"type": "object"},
 				},
-				"interim_update_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"interim_update_interval": map[string]any{
-					"type":    "integer",
-					"pattern": "^([6-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9][0-9]|86400)$",
-				},
-				"name": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,128}",
-				},
-				"site_id": map[string]any{
+				"key": map[string]any{
 					"type": "string",
 				},
-				"tls_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"use_usg_acct_server": map[string]any{
-					"type": "boolean",
-				},
-				"use_usg_auth_server": map[string]any{
-					"type": "boolean",
-				},
-				"vlan_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"vlan_wlan_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: disabled|optional|required",
-					"enum":        []any{"disabled", "optional", "required"},
-				},
-				"x_ca_crts": map[string]any{
+				"server_names": map[string]any{
 					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
-				"x_client_crt": map[string]any{
-					"type": "string",
-				},
-				"x_client_crt_filename": map[string]any{
-					"type": "string",
+					"items": map[string]any{"type": "string"},
 				},
-				"x_client_private_key": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"x_client_private_key_filename": map[string]any{
-					"type": "string",
+				"state": map[string]any{
+					"type":        "string",
+					"description": "One of: off|auto|manual|custom",
+					"enum":        []any{"off", "auto", "manual", "custom"},
 				},
-				"x_client_private_key_password": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "delete_radius_profile",
-		Description: "Delete RADIUSProfile by ID",
-		Category:    "delete",
-		Resource:    "RADIUSProfile",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
-				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID to delete",
-				},
-			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "list_routing",
-		Description: "List all Routing resources",
-		Category:    "list",
-		Resource:    "Routing",
+		Name:        "get_setting_dpi",
+		Description: "Get SettingDpi",
+		Category:    "get",
+		Resource:    "SettingDpi",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7329,56 +14327,37 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
-				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
 					"items":       map[string]any{"type": "string"},
 				},
-				"search": map[string]any{
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
-				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_routing",
-		Description: "Get Routing by ID",
-		Category:    "get",
-		Resource:    "Routing",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"format": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "create_routing",
-		Description: "Create new Routing",
-		Category:    "create",
-		Resource:    "Routing",
+		Name:        "update_setting_dpi",
+		Description: "Update SettingDpi",
+		Category:    "update",
+		Resource:    "SettingDpi",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7401,46 +14380,56 @@ var AllToolMetadata = []ToolMetadata{
 				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"gateway_device": map[string]any{
-					"type":    "string",
-					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
-				},
-				"gateway_type": map[string]any{
-					"type":        "string",
-					"description": "One of: default|switch",
-					"enum":        []any{"default", "switch"},
-				},
-				"name": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,128}",
+				"fingerprintingEnabled": map[string]any{
+					"type": "boolean",
+				},
+				"key": map[string]any{
+					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"static-route_distance": map[string]any{
-					"type":    "integer",
-					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"static-route_interface": map[string]any{
-					"type":    "string",
-					"pattern": "WAN1|WAN2|[\\d\\w]+|^$",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"static-route_network": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^([a-fA-F0-9:]+\\/(([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])))$",
+			},
+		},
+	},
+	{
+		Name:        "get_setting_element_adopt",
+		Description: "Get SettingElementAdopt",
+		Category:    "get",
+		Resource:    "SettingElementAdopt",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"static-route_nexthop": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^([a-fA-F0-9:]+)$|^$",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"static-route_type": map[string]any{
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "One of: nexthop-route|interface-route|blackhole",
-					"enum":        []any{"nexthop-route", "interface-route", "blackhole"},
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"type": map[string]any{
-					"type":    "string",
-					"pattern": "static-route",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -7450,10 +14439,11 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_routing",
-		Description: "Update Routing by ID",
+		Name:        "update_setting_element_adopt",
+		Description: "Update SettingElementAdopt",
 		Category:    "update",
-		Resource:    "Routing",
+		Resource:    "SettingElementAdopt",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7461,10 +14451,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -7480,60 +14466,35 @@ var AllToolMetadata = []ToolMetadata{
 				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"gateway_device": map[string]any{
-					"type":    "string",
-					"pattern": "^([0-9A-Fa-f]{2}[:]){5}([0-9A-Fa-f]{2})$",
-				},
-				"gateway_type": map[string]any{
-					"type":        "string",
-					"description": "One of: default|switch",
-					"enum":        []any{"default", "switch"},
-				},
-				"name": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,128}",
+				"key": map[string]any{
+					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"static-route_distance": map[string]any{
-					"type":    "integer",
-					"pattern": "^[1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]$|^$",
-				},
-				"static-route_interface": map[string]any{
-					"type":    "string",
-					"pattern": "WAN1|WAN2|[\\d\\w]+|^$",
-				},
-				"static-route_network": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([1-9]|[1-2][0-9]|3[0-2])$|^([a-fA-F0-9:]+\\/(([1-9]|[1-8][0-9]|9[0-9]|1[01][0-9]|12[0-8])))$",
-				},
-				"static-route_nexthop": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^([a-fA-F0-9:]+)$|^$",
+				"x_element_essid": map[string]any{
+					"type": "string",
 				},
-				"static-route_type": map[string]any{
-					"type":        "string",
-					"description": "One of: nexthop-route|interface-route|blackhole",
-					"enum":        []any{"nexthop-route", "interface-route", "blackhole"},
+				"x_element_psk": map[string]any{
+					"type": "string",
 				},
-				"type": map[string]any{
-					"type":    "string",
-					"pattern": "static-route",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_routing",
-		Description: "Delete Routing by ID",
-		Category:    "delete",
-		Resource:    "Routing",
+		Name:        "get_setting_ether_lighting",
+		Description: "Get SettingEtherLighting",
+		Category:    "get",
+		Resource:    "SettingEtherLighting",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7541,19 +14502,37 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "list_schedule_task",
-		Description: "List all ScheduleTask resources",
-		Category:    "list",
-		Resource:    "ScheduleTask",
+		Name:        "update_setting_ether_lighting",
+		Description: "Update SettingEtherLighting",
+		Category:    "update",
+		Resource:    "SettingEtherLighting",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7561,19 +14540,35 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"fields": map[string]any{
-					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
-					"items":       map[string]any{"type": "string"},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"search": map[string]any{
-					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"key": map[string]any{
+					"type": "string",
+				},
+				"network_overrides": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"speed_overrides": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -7583,10 +14578,11 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_schedule_task",
-		Description: "Get ScheduleTask by ID",
+		Name:        "get_setting_evaluation_score",
+		Description: "Get SettingEvaluationScore",
 		Category:    "get",
-		Resource:    "ScheduleTask",
+		Resource:    "SettingEvaluationScore",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7594,23 +14590,37 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "create_schedule_task",
-		Description: "Create new ScheduleTask",
-		Category:    "create",
-		Resource:    "ScheduleTask",
+		Name:        "update_setting_evaluation_score",
+		Description: "Update SettingEvaluationScore",
+		Category:    "update",
+		Resource:    "SettingEvaluationScore",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7618,10 +14628,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"action": map[string]any{
-					"type":    "string",
-					"pattern": "upgrade",
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -7634,21 +14640,20 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"cron_expr": map[string]any{
-					"type": "string",
-				},
-				"execute_only_once": map[string]any{
-					"type": "boolean",
+				"dismissed_ids": map[string]any{
+					"type":    "array",
+					"pattern": "^[a-zA-Z]{2}[0-9]{2,3}$|^$",
+					"items":   map[string]any{"type": "string"},
 				},
-				"name": map[string]any{
+				"key": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"upgrade_targets": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -7658,10 +14663,49 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_schedule_task",
-		Description: "Update ScheduleTask by ID",
+		Name:        "get_setting_global_ap",
+		Description: "Get SettingGlobalAp",
+		Category:    "get",
+		Resource:    "SettingGlobalAp",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "update_setting_global_ap",
+		Description: "Update SettingGlobalAp",
 		Category:    "update",
-		Resource:    "ScheduleTask",
+		Resource:    "SettingGlobalAp",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7669,13 +14713,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"6e_channel_size": map[string]any{
+					"type":        "integer",
+					"description": "One of: 20|40|80|160",
+					"enum":        []any{"20", "40", "80", "160"},
+				},
+				"6e_tx_power": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-4][0-9]",
+				},
+				"6e_tx_power_mode": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "One of: auto|medium|high|low|custom",
+					"enum":        []any{"auto", "medium", "high", "low", "custom"},
 				},
-				"action": map[string]any{
-					"type":    "string",
-					"pattern": "upgrade",
+				"ap_exclusions": map[string]any{
+					"type":    "array",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
+					"items":   map[string]any{"type": "string"},
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -7689,35 +14744,57 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"cron_expr": map[string]any{
+				"key": map[string]any{
 					"type": "string",
 				},
-				"execute_only_once": map[string]any{
-					"type": "boolean",
+				"na_channel_size": map[string]any{
+					"type":        "integer",
+					"description": "One of: 20|40|80|160",
+					"enum":        []any{"20", "40", "80", "160"},
 				},
-				"name": map[string]any{
-					"type": "string",
+				"na_tx_power": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-4][0-9]",
+				},
+				"na_tx_power_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|medium|high|low|custom",
+					"enum":        []any{"auto", "medium", "high", "low", "custom"},
+				},
+				"ng_channel_size": map[string]any{
+					"type":        "integer",
+					"description": "One of: 20|40",
+					"enum":        []any{"20", "40"},
+				},
+				"ng_tx_power": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-4][0-9]",
+				},
+				"ng_tx_power_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|medium|high|low|custom",
+					"enum":        []any{"auto", "medium", "high", "low", "custom"},
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"upgrade_targets": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_schedule_task",
-		Description: "Delete ScheduleTask by ID",
-		Category:    "delete",
-		Resource:    "ScheduleTask",
+		Name:        "get_setting_global_nat",
+		Description: "Get SettingGlobalNat",
+		Category:    "get",
+		Resource:    "SettingGlobalNat",
+		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -7725,26 +14802,23 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "get_setting_auto_speedtest",
-		Description: "Get SettingAutoSpeedtest",
-		Category:    "get",
-		Resource:    "SettingAutoSpeedtest",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"format": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -7754,10 +14828,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_auto_speedtest",
-		Description: "Update SettingAutoSpeedtest",
+		Name:        "update_setting_global_nat",
+		Description: "Update SettingGlobalNat",
 		Category:    "update",
-		Resource:    "SettingAutoSpeedtest",
+		Resource:    "SettingGlobalNat",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -7778,30 +14852,45 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"cron_expr": map[string]any{
-					"type": "string",
+				"excluded_network_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
 				},
-				"enabled": map[string]any{
-					"type": "boolean",
+				"excluded_network_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for excluded_network_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
 				},
 				"key": map[string]any{
 					"type": "string",
 				},
+				"mode": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|custom|off",
+					"enum":        []any{"auto", "custom", "off"},
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"excluded_network_names": "excluded_network_ids",
+		},
 	},
 	{
-		Name:        "get_setting_baresip",
-		Description: "Get SettingBaresip",
+		Name:        "get_setting_global_switch",
+		Description: "Get SettingGlobalSwitch",
 		Category:    "get",
-		Resource:    "SettingBaresip",
+		Resource:    "SettingGlobalSwitch",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -7810,6 +14899,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -7818,10 +14925,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_baresip",
-		Description: "Update SettingBaresip",
+		Name:        "update_setting_global_switch",
+		Description: "Update SettingGlobalSwitch",
 		Category:    "update",
-		Resource:    "SettingBaresip",
+		Resource:    "SettingGlobalSwitch",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -7830,6 +14937,14 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"acl_device_isolation": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"acl_l3_isolation": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -7842,36 +14957,69 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"enabled": map[string]any{
+				"dhcp_snoop": map[string]any{
 					"type": "boolean",
 				},
-				"key": map[string]any{
-					"type": "string",
+				"dot1x_fallback_networkconf_id": map[string]any{
+					"type":    "string",
+					"pattern": "[\\d\\w]+|",
 				},
-				"outbound_proxy": map[string]any{
-					"type": "string",
+				"dot1x_fallback_networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for dot1x_fallback_networkconf_id, in place of its ID",
 				},
-				"package_url": map[string]any{
+				"dot1x_portctrl_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"flowctrl_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"jumboframe_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"key": map[string]any{
 					"type": "string",
 				},
-				"server": map[string]any{
+				"radiusprofile_id": map[string]any{
 					"type": "string",
 				},
+				"radiusprofile_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the RADIUSProfile for radiusprofile_id, in place of its ID",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"stp_version": map[string]any{
+					"type":        "string",
+					"description": "One of: stp|rstp|disabled",
+					"enum":        []any{"stp", "rstp", "disabled"},
+				},
+				"switch_exclusions": map[string]any{
+					"type":    "array",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"dot1x_fallback_networkconf_name": "dot1x_fallback_networkconf_id",
+			"radiusprofile_name":              "radiusprofile_id",
+		},
 	},
 	{
-		Name:        "get_setting_broadcast",
-		Description: "Get SettingBroadcast",
+		Name:        "get_setting_guest_access",
+		Description: "Get SettingGuestAccess",
 		Category:    "get",
-		Resource:    "SettingBroadcast",
+		Resource:    "SettingGuestAccess",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -7880,6 +15028,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -7888,332 +15054,370 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_broadcast",
-		Description: "Update SettingBroadcast",
+		Name:        "update_setting_guest_access",
+		Description: "Update SettingGuestAccess",
 		Category:    "update",
-		Resource:    "SettingBroadcast",
+		Resource:    "SettingGuestAccess",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
 				"site": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"allowed_subnet": map[string]any{
+					"type": "string",
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"auth": map[string]any{
+					"type":        "string",
+					"description": "One of: none|hotspot|facebook_wifi|custom",
+					"enum":        []any{"none", "hotspot", "facebook_wifi", "custom"},
+				},
+				"auth_url": map[string]any{
+					"type": "string",
+				},
+				"authorize_use_sandbox": map[string]any{
+					"type": "boolean",
+				},
+				"custom_ip": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"ec_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"expire": map[string]any{
+					"type":    "integer",
+					"pattern": "[\\d]+|custom",
+				},
+				"expire_number": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9][0-9]{0,5}|1000000$",
+				},
+				"expire_unit": map[string]any{
+					"type":        "integer",
+					"description": "One of: 1|60|1440",
+					"enum":        []any{"1", "60", "1440"},
+				},
+				"facebook_app_id": map[string]any{
+					"type": "string",
+				},
+				"facebook_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"facebook_scope_email": map[string]any{
+					"type": "boolean",
+				},
+				"facebook_wifi_block_https": map[string]any{
+					"type": "boolean",
+				},
+				"facebook_wifi_gw_id": map[string]any{
+					"type": "string",
+				},
+				"facebook_wifi_gw_name": map[string]any{
+					"type": "string",
+				},
+				"gateway": map[string]any{
+					"type":        "string",
+					"description": "One of: paypal|stripe|authorize|quickpay|merchantwarrior|ippay",
+					"enum":        []any{"paypal", "stripe", "authorize", "quickpay", "merchantwarrior", "ippay"},
 				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
+				"google_client_id": map[string]any{
+					"type": "string",
 				},
-				"attr_hidden_id": map[string]any{
+				"google_domain": map[string]any{
 					"type": "string",
 				},
-				"attr_no_delete": map[string]any{
+				"google_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"attr_no_edit": map[string]any{
+				"google_scope_email": map[string]any{
+					"type": "boolean",
+				},
+				"ippay_use_sandbox": map[string]any{
 					"type": "boolean",
 				},
 				"key": map[string]any{
 					"type": "string",
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"merchantwarrior_use_sandbox": map[string]any{
+					"type": "boolean",
 				},
-				"sound_after_enabled": map[string]any{
+				"password_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"sound_after_resource": map[string]any{
+				"payment_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"paypal_use_sandbox": map[string]any{
+					"type": "boolean",
+				},
+				"portal_customized": map[string]any{
+					"type": "boolean",
+				},
+				"portal_customized_authentication_text": map[string]any{
 					"type": "string",
 				},
-				"sound_after_type": map[string]any{
-					"type":        "string",
-					"description": "One of: sample|media",
-					"enum":        []any{"sample", "media"},
+				"portal_customized_bg_color": map[string]any{
+					"type":    "string",
+					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
 				},
-				"sound_before_enabled": map[string]any{
+				"portal_customized_bg_image_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"sound_before_resource": map[string]any{
+				"portal_customized_bg_image_filename": map[string]any{
 					"type": "string",
 				},
-				"sound_before_type": map[string]any{
+				"portal_customized_bg_image_tile": map[string]any{
+					"type": "boolean",
+				},
+				"portal_customized_bg_type": map[string]any{
 					"type":        "string",
-					"description": "One of: sample|media",
-					"enum":        []any{"sample", "media"},
+					"description": "One of: color|image|gallery",
+					"enum":        []any{"color", "image", "gallery"},
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"portal_customized_box_color": map[string]any{
+					"type":    "string",
+					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_setting_connectivity",
-		Description: "Get SettingConnectivity",
-		Category:    "get",
-		Resource:    "SettingConnectivity",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"portal_customized_box_link_color": map[string]any{
+					"type":    "string",
+					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"portal_customized_box_opacity": map[string]any{
+					"type":    "integer",
+					"pattern": "^[1-9][0-9]?$|^100$|^$",
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_setting_connectivity",
-		Description: "Update SettingConnectivity",
-		Category:    "update",
-		Resource:    "SettingConnectivity",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"portal_customized_box_radius": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-4][0-9]|50",
 				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
+				"portal_customized_box_text_color": map[string]any{
+					"type":    "string",
+					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
 				},
-				"attr_hidden_id": map[string]any{
+				"portal_customized_button_color": map[string]any{
+					"type":    "string",
+					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
+				},
+				"portal_customized_button_text": map[string]any{
 					"type": "string",
 				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
+				"portal_customized_button_text_color": map[string]any{
+					"type":    "string",
+					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
 				},
-				"attr_no_edit": map[string]any{
-					"type": "boolean",
+				"portal_customized_languages": map[string]any{
+					"type":    "array",
+					"pattern": "^[a-z]{2}([_-][a-zA-Z]{2,4})*$",
+					"items":   map[string]any{"type": "string"},
 				},
-				"enable_isolated_wlan": map[string]any{
-					"type": "boolean",
+				"portal_customized_link_color": map[string]any{
+					"type":    "string",
+					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
 				},
-				"enabled": map[string]any{
+				"portal_customized_logo_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"key": map[string]any{
+				"portal_customized_logo_filename": map[string]any{
 					"type": "string",
 				},
-				"site_id": map[string]any{
+				"portal_customized_logo_position": map[string]any{
+					"type":        "string",
+					"description": "One of: left|center|right",
+					"enum":        []any{"left", "center", "right"},
+				},
+				"portal_customized_logo_size": map[string]any{
+					"type":    "integer",
+					"pattern": "6[4-9]|[7-9][0-9]|1[0-8][0-9]|19[0-2]",
+				},
+				"portal_customized_success_text": map[string]any{
 					"type": "string",
 				},
-				"uplink_host": map[string]any{
+				"portal_customized_text_color": map[string]any{
+					"type":    "string",
+					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
+				},
+				"portal_customized_title": map[string]any{
 					"type": "string",
 				},
-				"uplink_type": map[string]any{
+				"portal_customized_tos": map[string]any{
 					"type": "string",
 				},
-				"x_mesh_essid": map[string]any{
+				"portal_customized_tos_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"portal_customized_unsplash_author_name": map[string]any{
 					"type": "string",
 				},
-				"x_mesh_psk": map[string]any{
+				"portal_customized_unsplash_author_username": map[string]any{
 					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"portal_customized_welcome_text": map[string]any{
+					"type": "string",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_setting_country",
-		Description: "Get SettingCountry",
-		Category:    "get",
-		Resource:    "SettingCountry",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"portal_customized_welcome_text_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"portal_customized_welcome_text_position": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "One of: under_logo|above_boxes",
+					"enum":        []any{"under_logo", "above_boxes"},
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"portal_enabled": map[string]any{
+					"type": "boolean",
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_setting_country",
-		Description: "Update SettingCountry",
-		Category:    "update",
-		Resource:    "SettingCountry",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"portal_hostname": map[string]any{
+					"type":    "string",
+					"pattern": "^[a-zA-Z0-9.-]+$|^$",
+				},
+				"portal_use_hostname": map[string]any{
+					"type": "boolean",
+				},
+				"quickpay_testmode": map[string]any{
+					"type": "boolean",
+				},
+				"radius_auth_type": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "One of: chap|mschapv2",
+					"enum":        []any{"chap", "mschapv2"},
 				},
-				"attr_hidden": map[string]any{
+				"radius_disconnect_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"attr_hidden_id": map[string]any{
+				"radius_disconnect_port": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
+				},
+				"radius_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"radiusprofile_id": map[string]any{
 					"type": "string",
 				},
-				"attr_no_delete": map[string]any{
+				"radiusprofile_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the RADIUSProfile for radiusprofile_id, in place of its ID",
+				},
+				"redirect_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"attr_no_edit": map[string]any{
+				"redirect_https": map[string]any{
 					"type": "boolean",
 				},
-				"code": map[string]any{
-					"type": "integer",
+				"redirect_to_https": map[string]any{
+					"type": "boolean",
 				},
-				"key": map[string]any{
+				"redirect_url": map[string]any{
+					"type": "string",
+				},
+				"restricted_dns_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"restricted_dns_servers": map[string]any{
+					"type":    "array",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"restricted_subnet": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
-				},
-			},
-		},
-	},
-	{
-		Name:        "get_setting_dashboard",
-		Description: "Get SettingDashboard",
-		Category:    "get",
-		Resource:    "SettingDashboard",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"template_engine": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
-				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+					"description": "One of: jsp|angular",
+					"enum":        []any{"jsp", "angular"},
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_setting_dashboard",
-		Description: "Update SettingDashboard",
-		Category:    "update",
-		Resource:    "SettingDashboard",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"voucher_customized": map[string]any{
+					"type": "boolean",
 				},
-				"attr_hidden": map[string]any{
+				"voucher_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"attr_hidden_id": map[string]any{
+				"wechat_app_id": map[string]any{
 					"type": "string",
 				},
-				"attr_no_delete": map[string]any{
+				"wechat_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"attr_no_edit": map[string]any{
-					"type": "boolean",
+				"wechat_shop_id": map[string]any{
+					"type": "string",
 				},
-				"key": map[string]any{
+				"x_authorize_loginid": map[string]any{
 					"type": "string",
 				},
-				"layout_preference": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+				"x_authorize_transactionkey": map[string]any{
+					"type": "string",
 				},
-				"site_id": map[string]any{
+				"x_facebook_app_secret": map[string]any{
 					"type": "string",
 				},
-				"widgets": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"x_facebook_wifi_gw_secret": map[string]any{
+					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"x_google_client_secret": map[string]any{
+					"type": "string",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_setting_doh",
-		Description: "Get SettingDoh",
-		Category:    "get",
-		Resource:    "SettingDoh",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"x_ippay_terminalid": map[string]any{
+					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"x_merchantwarrior_apikey": map[string]any{
+					"type": "string",
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_setting_doh",
-		Description: "Update SettingDoh",
-		Category:    "update",
-		Resource:    "SettingDoh",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"x_merchantwarrior_apipassphrase": map[string]any{
+					"type": "string",
 				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
+				"x_merchantwarrior_merchantuuid": map[string]any{
+					"type": "string",
 				},
-				"attr_hidden_id": map[string]any{
+				"x_password": map[string]any{
 					"type": "string",
 				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
+				"x_paypal_password": map[string]any{
+					"type": "string",
 				},
-				"attr_no_edit": map[string]any{
-					"type": "boolean",
+				"x_paypal_signature": map[string]any{
+					"type": "string",
 				},
-				"custom_servers": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"x_paypal_username": map[string]any{
+					"type": "string",
 				},
-				"key": map[string]any{
+				"x_quickpay_agreementid": map[string]any{
 					"type": "string",
 				},
-				"server_names": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
+				"x_quickpay_apikey": map[string]any{
+					"type": "string",
 				},
-				"site_id": map[string]any{
+				"x_quickpay_merchantid": map[string]any{
 					"type": "string",
 				},
-				"state": map[string]any{
-					"type":        "string",
-					"description": "One of: off|auto|manual|custom",
-					"enum":        []any{"off", "auto", "manual", "custom"},
+				"x_stripe_api_key": map[string]any{
+					"type": "string",
+				},
+				"x_wechat_app_secret": map[string]any{
+					"type": "string",
+				},
+				"x_wechat_secret_key": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -8221,12 +15425,15 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"radiusprofile_name": "radiusprofile_id",
+		},
 	},
 	{
-		Name:        "get_setting_dpi",
-		Description: "Get SettingDpi",
+		Name:        "get_setting_ips",
+		Description: "Get SettingIps",
 		Category:    "get",
-		Resource:    "SettingDpi",
+		Resource:    "SettingIps",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8235,6 +15442,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8243,10 +15468,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_dpi",
-		Description: "Update SettingDpi",
+		Name:        "update_setting_ips",
+		Description: "Update SettingIps",
 		Category:    "update",
-		Resource:    "SettingDpi",
+		Resource:    "SettingIps",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8255,6 +15480,18 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"ad_blocking_configurations": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"ad_blocking_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"advanced_filtering_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: |manual|disabled",
+					"enum":        []any{"manual", "disabled"},
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -8267,18 +15504,54 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"enabled": map[string]any{
+				"dns_filtering": map[string]any{
 					"type": "boolean",
 				},
-				"fingerprintingEnabled": map[string]any{
+				"dns_filters": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"enabled_categories": map[string]any{
+					"type":        "array",
+					"description": "One of: emerging-activex|emerging-attackresponse|botcc|emerging-chat|ciarmy|compromised|emerging-dns|emerging-dos|dshield|emerging-exploit|emerging-ftp|emerging-games|emerging-icmp|emerging-icmpinfo|emerging-imap|emerging-inappropriate|emerging-info|emerging-malware|emerging-misc|emerging-mobile|emerging-netbios|emerging-p2p|emerging-policy|emerging-pop3|emerging-rpc|emerging-scada|emerging-scan|emerging-shellcode|emerging-smtp|emerging-snmp|emerging-sql|emerging-telnet|emerging-tftp|tor|emerging-useragent|emerging-voip|emerging-webapps|emerging-webclient|emerging-webserver|emerging-worm|exploit-kit|adware-pup|botcc-portgrouped|phishing|threatview-cs-c2|3coresec|chat|coinminer|current-events|drop|hunting|icmp-info|inappropriate|info|ja3|policy|scada|dark-web-blocker-list|malicious-hosts",
+					"enum":        []any{"emerging-activex", "emerging-attackresponse", "botcc", "emerging-chat", "ciarmy", "compromised", "emerging-dns", "emerging-dos", "dshield", "emerging-exploit", "emerging-ftp", "emerging-games", "emerging-icmp", "emerging-icmpinfo", "emerging-imap", "emerging-inappropriate", "emerging-info", "emerging-malware", "emerging-misc", "emerging-mobile", "emerging-netbios", "emerging-p2p", "emerging-policy", "emerging-pop3", "emerging-rpc", "emerging-scada", "emerging-scan", "emerging-shellcode", "emerging-smtp", "emerging-snmp", "emerging-sql", "emerging-telnet", "emerging-tftp", "tor", "emerging-useragent", "emerging-voip", "emerging-webapps", "emerging-webclient", "emerging-webserver", "emerging-worm", "exploit-kit", "adware-pup", "botcc-portgrouped", "phishing", "threatview-cs-c2", "3coresec", "chat", "coinminer", "current-events", "drop", "hunting", "icmp-info", "inappropriate", "info", "ja3", "policy", "scada", "dark-web-blocker-list", "malicious-hosts"},
+					"items":       map[string]any{"type": "string"},
+				},
+				"enabled_networks": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"honeypot": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"honeypot_enabled": map[string]any{
 					"type": "boolean",
 				},
+				"ips_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: ids|ips|ipsInline|disabled",
+					"enum":        []any{"ids", "ips", "ipsInline", "disabled"},
+				},
 				"key": map[string]any{
 					"type": "string",
 				},
+				"memory_optimized": map[string]any{
+					"type": "boolean",
+				},
+				"restrict_torrents": map[string]any{
+					"type": "boolean",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"suppression": map[string]any{
+					"type": "object",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8287,10 +15560,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_element_adopt",
-		Description: "Get SettingElementAdopt",
+		Name:        "get_setting_lcm",
+		Description: "Get SettingLcm",
 		Category:    "get",
-		Resource:    "SettingElementAdopt",
+		Resource:    "SettingLcm",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8299,6 +15572,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8307,10 +15598,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_element_adopt",
-		Description: "Update SettingElementAdopt",
+		Name:        "update_setting_lcm",
+		Description: "Update SettingLcm",
 		Category:    "update",
-		Resource:    "SettingElementAdopt",
+		Resource:    "SettingLcm",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8331,20 +15622,32 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
+				"brightness": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9]|[1-9][0-9]|100",
+				},
 				"enabled": map[string]any{
 					"type": "boolean",
 				},
+				"idle_timeout": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9][0-9]|[1-9][0-9][0-9]|[1-2][0-9][0-9][0-9]|3[0-5][0-9][0-9]|3600",
+				},
 				"key": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"x_element_essid": map[string]any{
-					"type": "string",
+				"sync": map[string]any{
+					"type": "boolean",
 				},
-				"x_element_psk": map[string]any{
-					"type": "string",
+				"touch_event": map[string]any{
+					"type": "boolean",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -8354,10 +15657,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_ether_lighting",
-		Description: "Get SettingEtherLighting",
+		Name:        "get_setting_locale",
+		Description: "Get SettingLocale",
 		Category:    "get",
-		Resource:    "SettingEtherLighting",
+		Resource:    "SettingLocale",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8366,6 +15669,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8374,10 +15695,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_ether_lighting",
-		Description: "Update SettingEtherLighting",
+		Name:        "update_setting_locale",
+		Description: "Update SettingLocale",
 		Category:    "update",
-		Resource:    "SettingEtherLighting",
+		Resource:    "SettingLocale",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8401,16 +15722,15 @@ var AllToolMetadata = []ToolMetadata{
 				"key": map[string]any{
 					"type": "string",
 				},
-				"network_overrides": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"speed_overrides": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"timezone": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -8420,10 +15740,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_evaluation_score",
-		Description: "Get SettingEvaluationScore",
+		Name:        "get_setting_magic_site_to_site_vpn",
+		Description: "Get SettingMagicSiteToSiteVpn",
 		Category:    "get",
-		Resource:    "SettingEvaluationScore",
+		Resource:    "SettingMagicSiteToSiteVpn",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8432,6 +15752,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8440,10 +15778,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_evaluation_score",
-		Description: "Update SettingEvaluationScore",
+		Name:        "update_setting_magic_site_to_site_vpn",
+		Description: "Update SettingMagicSiteToSiteVpn",
 		Category:    "update",
-		Resource:    "SettingEvaluationScore",
+		Resource:    "SettingMagicSiteToSiteVpn",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8464,10 +15802,8 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"dismissed_ids": map[string]any{
-					"type":    "array",
-					"pattern": "^[a-zA-Z]{2}[0-9]{2,3}$|^$",
-					"items":   map[string]any{"type": "string"},
+				"enabled": map[string]any{
+					"type": "boolean",
 				},
 				"key": map[string]any{
 					"type": "string",
@@ -8475,6 +15811,10 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8483,10 +15823,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_global_ap",
-		Description: "Get SettingGlobalAp",
+		Name:        "get_setting_mgmt",
+		Description: "Get SettingMgmt",
 		Category:    "get",
-		Resource:    "SettingGlobalAp",
+		Resource:    "SettingMgmt",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8495,6 +15835,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8503,10 +15861,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_global_ap",
-		Description: "Update SettingGlobalAp",
+		Name:        "update_setting_mgmt",
+		Description: "Update SettingMgmt",
 		Category:    "update",
-		Resource:    "SettingGlobalAp",
+		Resource:    "SettingMgmt",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8515,24 +15873,11 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"6e_channel_size": map[string]any{
-					"type":        "integer",
-					"description": "One of: 20|40|80|160",
-					"enum":        []any{"20", "40", "80", "160"},
-				},
-				"6e_tx_power": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-4][0-9]",
-				},
-				"6e_tx_power_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|medium|high|low|custom",
-					"enum":        []any{"auto", "medium", "high", "low", "custom"},
+				"advanced_feature_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"ap_exclusions": map[string]any{
-					"type":    "array",
-					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
-					"items":   map[string]any{"type": "string"},
+				"alert_enabled": map[string]any{
+					"type": "boolean",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -8546,40 +15891,75 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
+				"auto_upgrade": map[string]any{
+					"type": "boolean",
+				},
+				"auto_upgrade_hour": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|1[0-9]|2[0-3]|^$",
+				},
+				"boot_sound": map[string]any{
+					"type": "boolean",
+				},
+				"debug_tools_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"direct_connect_enabled": map[string]any{
+					"type": "boolean",
+				},
 				"key": map[string]any{
 					"type": "string",
 				},
-				"na_channel_size": map[string]any{
-					"type":        "integer",
-					"description": "One of: 20|40|80|160",
-					"enum":        []any{"20", "40", "80", "160"},
+				"led_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"na_tx_power": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-4][0-9]",
+				"outdoor_mode_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"na_tx_power_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|medium|high|low|custom",
-					"enum":        []any{"auto", "medium", "high", "low", "custom"},
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"ng_channel_size": map[string]any{
-					"type":        "integer",
-					"description": "One of: 20|40",
-					"enum":        []any{"20", "40"},
+				"unifi_idp_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"ng_tx_power": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-4][0-9]",
+				"wifiman_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"ng_tx_power_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|medium|high|low|custom",
-					"enum":        []any{"auto", "medium", "high", "low", "custom"},
+				"x_mgmt_key": map[string]any{
+					"type":    "string",
+					"pattern": "[0-9a-f]{32}",
 				},
-				"site_id": map[string]any{
+				"x_ssh_auth_password_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"x_ssh_bind_wildcard": map[string]any{
+					"type": "boolean",
+				},
+				"x_ssh_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"x_ssh_keys": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"x_ssh_md5passwd": map[string]any{
+					"type": "string",
+				},
+				"x_ssh_password": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
+				},
+				"x_ssh_sha512passwd": map[string]any{
 					"type": "string",
 				},
+				"x_ssh_username": map[string]any{
+					"type":    "string",
+					"pattern": "^[_A-Za-z0-9][-_.A-Za-z0-9]{0,29}$",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8588,10 +15968,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_global_nat",
-		Description: "Get SettingGlobalNat",
+		Name:        "get_setting_netflow",
+		Description: "Get SettingNetflow",
 		Category:    "get",
-		Resource:    "SettingGlobalNat",
+		Resource:    "SettingNetflow",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8600,6 +15980,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8608,10 +16006,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_global_nat",
-		Description: "Update SettingGlobalNat",
+		Name:        "update_setting_netflow",
+		Description: "Update SettingNetflow",
 		Category:    "update",
-		Resource:    "SettingGlobalNat",
+		Resource:    "SettingNetflow",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8632,33 +16030,78 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"excluded_network_ids": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
+				"auto_engine_id_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"engine_id": map[string]any{
+					"type":    "integer",
+					"pattern": "^$|[1-9][0-9]*",
+				},
+				"export_frequency": map[string]any{
+					"type": "integer",
 				},
 				"key": map[string]any{
 					"type": "string",
 				},
-				"mode": map[string]any{
+				"network_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"network_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the Network objects for network_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"port": map[string]any{
+					"type":    "integer",
+					"pattern": "102[4-9]|10[3-9][0-9]|1[1-9][0-9]{2}|[2-9][0-9]{3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
+				},
+				"refresh_rate": map[string]any{
+					"type": "integer",
+				},
+				"sampling_mode": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|custom|off",
-					"enum":        []any{"auto", "custom", "off"},
+					"description": "One of: off|hash|random|deterministic",
+					"enum":        []any{"off", "hash", "random", "deterministic"},
+				},
+				"sampling_rate": map[string]any{
+					"type":    "integer",
+					"pattern": "[2-9]|[1-9][0-9]{1,3}|1[0-5][0-9]{3}|16[0-2][0-9]{2}|163[0-7][0-9]|1638[0-3]|^$",
+				},
+				"server": map[string]any{
+					"type":    "string",
+					"pattern": ".{0,252}[^\\.]$",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"version": map[string]any{
+					"type":        "integer",
+					"description": "One of: 5|9|10",
+					"enum":        []any{"5", "9", "10"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"network_names": "network_ids",
+		},
 	},
 	{
-		Name:        "get_setting_global_switch",
-		Description: "Get SettingGlobalSwitch",
+		Name:        "get_setting_network_optimization",
+		Description: "Get SettingNetworkOptimization",
 		Category:    "get",
-		Resource:    "SettingGlobalSwitch",
+		Resource:    "SettingNetworkOptimization",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8667,6 +16110,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8675,10 +16136,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_global_switch",
-		Description: "Update SettingGlobalSwitch",
+		Name:        "update_setting_network_optimization",
+		Description: "Update SettingNetworkOptimization",
 		Category:    "update",
-		Resource:    "SettingGlobalSwitch",
+		Resource:    "SettingNetworkOptimization",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8687,14 +16148,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"acl_device_isolation": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
-				},
-				"acl_l3_isolation": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -8707,40 +16160,18 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"dhcp_snoop": map[string]any{
-					"type": "boolean",
-				},
-				"dot1x_fallback_networkconf_id": map[string]any{
-					"type":    "string",
-					"pattern": "[\\d\\w]+|",
-				},
-				"dot1x_portctrl_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"flowctrl_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"jumboframe_enabled": map[string]any{
+				"enabled": map[string]any{
 					"type": "boolean",
 				},
 				"key": map[string]any{
 					"type": "string",
 				},
-				"radiusprofile_id": map[string]any{
-					"type": "string",
-				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"stp_version": map[string]any{
-					"type":        "string",
-					"description": "One of: stp|rstp|disabled",
-					"enum":        []any{"stp", "rstp", "disabled"},
-				},
-				"switch_exclusions": map[string]any{
-					"type":    "array",
-					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
-					"items":   map[string]any{"type": "string"},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -8750,10 +16181,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_guest_access",
-		Description: "Get SettingGuestAccess",
+		Name:        "get_setting_ntp",
+		Description: "Get SettingNtp",
 		Category:    "get",
-		Resource:    "SettingGuestAccess",
+		Resource:    "SettingNtp",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8762,6 +16193,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -8770,10 +16219,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_guest_access",
-		Description: "Update SettingGuestAccess",
+		Name:        "update_setting_ntp",
+		Description: "Update SettingNtp",
 		Category:    "update",
-		Resource:    "SettingGuestAccess",
+		Resource:    "SettingNtp",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -8782,351 +16231,276 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"allowed_subnet": map[string]any{
-					"type": "string",
-				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
-				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
-				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
-				},
-				"attr_no_edit": map[string]any{
-					"type": "boolean",
-				},
-				"auth": map[string]any{
-					"type":        "string",
-					"description": "One of: none|hotspot|facebook_wifi|custom",
-					"enum":        []any{"none", "hotspot", "facebook_wifi", "custom"},
-				},
-				"auth_url": map[string]any{
-					"type": "string",
-				},
-				"authorize_use_sandbox": map[string]any{
-					"type": "boolean",
-				},
-				"custom_ip": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"ec_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"expire": map[string]any{
-					"type":    "integer",
-					"pattern": "[\\d]+|custom",
-				},
-				"expire_number": map[string]any{
-					"type":    "integer",
-					"pattern": "^[1-9][0-9]{0,5}|1000000$",
-				},
-				"expire_unit": map[string]any{
-					"type":        "integer",
-					"description": "One of: 1|60|1440",
-					"enum":        []any{"1", "60", "1440"},
-				},
-				"facebook_app_id": map[string]any{
-					"type": "string",
-				},
-				"facebook_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"facebook_scope_email": map[string]any{
-					"type": "boolean",
-				},
-				"facebook_wifi_block_https": map[string]any{
-					"type": "boolean",
-				},
-				"facebook_wifi_gw_id": map[string]any{
-					"type": "string",
-				},
-				"facebook_wifi_gw_name": map[string]any{
-					"type": "string",
-				},
-				"gateway": map[string]any{
-					"type":        "string",
-					"description": "One of: paypal|stripe|authorize|quickpay|merchantwarrior|ippay",
-					"enum":        []any{"paypal", "stripe", "authorize", "quickpay", "merchantwarrior", "ippay"},
-				},
-				"google_client_id": map[string]any{
-					"type": "string",
-				},
-				"google_domain": map[string]any{
-					"type": "string",
-				},
-				"google_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"google_scope_email": map[string]any{
-					"type": "boolean",
-				},
-				"ippay_use_sandbox": map[string]any{
-					"type": "boolean",
-				},
-				"key": map[string]any{
-					"type": "string",
-				},
-				"merchantwarrior_use_sandbox": map[string]any{
-					"type": "boolean",
-				},
-				"password_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"payment_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"paypal_use_sandbox": map[string]any{
-					"type": "boolean",
-				},
-				"portal_customized": map[string]any{
-					"type": "boolean",
-				},
-				"portal_customized_authentication_text": map[string]any{
-					"type": "string",
-				},
-				"portal_customized_bg_color": map[string]any{
-					"type":    "string",
-					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
-				},
-				"portal_customized_bg_image_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"portal_customized_bg_image_filename": map[string]any{
-					"type": "string",
-				},
-				"portal_customized_bg_image_tile": map[string]any{
-					"type": "boolean",
-				},
-				"portal_customized_bg_type": map[string]any{
-					"type":        "string",
-					"description": "One of: color|image|gallery",
-					"enum":        []any{"color", "image", "gallery"},
-				},
-				"portal_customized_box_color": map[string]any{
-					"type":    "string",
-					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
-				},
-				"portal_customized_box_link_color": map[string]any{
-					"type":    "string",
-					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
-				},
-				"portal_customized_box_opacity": map[string]any{
-					"type":    "integer",
-					"pattern": "^[1-9][0-9]?$|^100$|^$",
-				},
-				"portal_customized_box_radius": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|[1-4][0-9]|50",
-				},
-				"portal_customized_box_text_color": map[string]any{
-					"type":    "string",
-					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
-				},
-				"portal_customized_button_color": map[string]any{
-					"type":    "string",
-					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
-				},
-				"portal_customized_button_text": map[string]any{
-					"type": "string",
-				},
-				"portal_customized_button_text_color": map[string]any{
-					"type":    "string",
-					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
-				},
-				"portal_customized_languages": map[string]any{
-					"type":    "array",
-					"pattern": "^[a-z]{2}([_-][a-zA-Z]{2,4})*$",
-					"items":   map[string]any{"type": "string"},
-				},
-				"portal_customized_link_color": map[string]any{
-					"type":    "string",
-					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
-				},
-				"portal_customized_logo_enabled": map[string]any{
+				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
-				"portal_customized_logo_filename": map[string]any{
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"portal_customized_logo_position": map[string]any{
-					"type":        "string",
-					"description": "One of: left|center|right",
-					"enum":        []any{"left", "center", "right"},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-				"portal_customized_logo_size": map[string]any{
-					"type":    "integer",
-					"pattern": "6[4-9]|[7-9][0-9]|1[0-8][0-9]|19[0-2]",
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"portal_customized_success_text": map[string]any{
+				"key": map[string]any{
 					"type": "string",
 				},
-				"portal_customized_text_color": map[string]any{
-					"type":    "string",
-					"pattern": "^#[a-zA-Z0-9]{6}$|^#[a-zA-Z0-9]{3}$|^$",
-				},
-				"portal_customized_title": map[string]any{
+				"ntp_server_1": map[string]any{
 					"type": "string",
 				},
-				"portal_customized_tos": map[string]any{
+				"ntp_server_2": map[string]any{
 					"type": "string",
 				},
-				"portal_customized_tos_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"portal_customized_unsplash_author_name": map[string]any{
+				"ntp_server_3": map[string]any{
 					"type": "string",
 				},
-				"portal_customized_unsplash_author_username": map[string]any{
+				"ntp_server_4": map[string]any{
 					"type": "string",
 				},
-				"portal_customized_welcome_text": map[string]any{
-					"type": "string",
+				"setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
 				},
-				"portal_customized_welcome_text_enabled": map[string]any{
-					"type": "boolean",
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"portal_customized_welcome_text_position": map[string]any{
-					"type":        "string",
-					"description": "One of: under_logo|above_boxes",
-					"enum":        []any{"under_logo", "above_boxes"},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"portal_enabled": map[string]any{
-					"type": "boolean",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"portal_hostname": map[string]any{
-					"type":    "string",
-					"pattern": "^[a-zA-Z0-9.-]+$|^$",
+			},
+		},
+	},
+	{
+		Name:        "get_setting_porta",
+		Description: "Get SettingPorta",
+		Category:    "get",
+		Resource:    "SettingPorta",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"portal_use_hostname": map[string]any{
-					"type": "boolean",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"quickpay_testmode": map[string]any{
-					"type": "boolean",
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"radius_auth_type": map[string]any{
+				"format": map[string]any{
 					"type":        "string",
-					"description": "One of: chap|mschapv2",
-					"enum":        []any{"chap", "mschapv2"},
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"radius_disconnect_enabled": map[string]any{
-					"type": "boolean",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"radius_disconnect_port": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"radius_enabled": map[string]any{
+			},
+		},
+	},
+	{
+		Name:        "update_setting_porta",
+		Description: "Update SettingPorta",
+		Category:    "update",
+		Resource:    "SettingPorta",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
-				"radiusprofile_id": map[string]any{
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"redirect_enabled": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"redirect_https": map[string]any{
+				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"redirect_to_https": map[string]any{
-					"type": "boolean",
+				"key": map[string]any{
+					"type": "string",
 				},
-				"redirect_url": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"restricted_dns_enabled": map[string]any{
+				"ugw3_wan2_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"restricted_dns_servers": map[string]any{
-					"type":    "array",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-					"items":   map[string]any{"type": "string"},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"restricted_subnet": map[string]any{
-					"type": "string",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"site_id": map[string]any{
-					"type": "string",
+			},
+		},
+	},
+	{
+		Name:        "get_setting_radio_ai",
+		Description: "Get SettingRadioAi",
+		Category:    "get",
+		Resource:    "SettingRadioAi",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"template_engine": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "One of: jsp|angular",
-					"enum":        []any{"jsp", "angular"},
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"voucher_customized": map[string]any{
-					"type": "boolean",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"voucher_enabled": map[string]any{
-					"type": "boolean",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"wechat_app_id": map[string]any{
-					"type": "string",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"wechat_enabled": map[string]any{
-					"type": "boolean",
+			},
+		},
+	},
+	{
+		Name:        "update_setting_radio_ai",
+		Description: "Update SettingRadioAi",
+		Category:    "update",
+		Resource:    "SettingRadioAi",
+		IsSetting:   true,
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"wechat_shop_id": map[string]any{
-					"type": "string",
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"x_authorize_loginid": map[string]any{
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"x_authorize_transactionkey": map[string]any{
-					"type": "string",
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
 				},
-				"x_facebook_app_secret": map[string]any{
-					"type": "string",
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"x_facebook_wifi_gw_secret": map[string]any{
-					"type": "string",
+				"auto_adjust_channels_to_country": map[string]any{
+					"type": "boolean",
 				},
-				"x_google_client_secret": map[string]any{
-					"type": "string",
+				"channels_6e": map[string]any{
+					"type":    "array",
+					"pattern": "[1-9]|[1-2][0-9]|3[3-9]|[4-5][0-9]|6[0-1]|6[5-9]|[7-8][0-9]|9[0-3]|9[7-9]|1[0-1][0-9]|12[0-5]|129|1[3-4][0-9]|15[0-7]|16[1-9]|1[7-8][0-9]|19[3-9]|2[0-1][0-9]|22[0-1]|22[5-9]|233",
+					"items":   map[string]any{"type": "integer"},
 				},
-				"x_ippay_terminalid": map[string]any{
-					"type": "string",
+				"channels_blacklist": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"x_merchantwarrior_apikey": map[string]any{
-					"type": "string",
+				"channels_na": map[string]any{
+					"type":        "array",
+					"description": "One of: 34|36|38|40|42|44|46|48|52|56|60|64|100|104|108|112|116|120|124|128|132|136|140|144|149|153|157|161|165|169",
+					"enum":        []any{"34", "36", "38", "40", "42", "44", "46", "48", "52", "56", "60", "64", "100", "104", "108", "112", "116", "120", "124", "128", "132", "136", "140", "144", "149", "153", "157", "161", "165", "169"},
+					"items":       map[string]any{"type": "integer"},
 				},
-				"x_merchantwarrior_apipassphrase": map[string]any{
-					"type": "string",
+				"channels_ng": map[string]any{
+					"type":        "array",
+					"description": "One of: 1|2|3|4|5|6|7|8|9|10|11|12|13|14",
+					"enum":        []any{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14"},
+					"items":       map[string]any{"type": "integer"},
 				},
-				"x_merchantwarrior_merchantuuid": map[string]any{
+				"cron_expr": map[string]any{
 					"type": "string",
 				},
-				"x_password": map[string]any{
-					"type": "string",
+				"default": map[string]any{
+					"type": "boolean",
 				},
-				"x_paypal_password": map[string]any{
-					"type": "string",
+				"enabled": map[string]any{
+					"type": "boolean",
 				},
-				"x_paypal_signature": map[string]any{
-					"type": "string",
+				"exclude_devices": map[string]any{
+					"type":    "array",
+					"pattern": "([0-9a-z]{2}:){5}[0-9a-z]{2}",
+					"items":   map[string]any{"type": "string"},
 				},
-				"x_paypal_username": map[string]any{
-					"type": "string",
+				"ht_modes_na": map[string]any{
+					"type":    "array",
+					"pattern": "^(20|40|80|160)$",
+					"items":   map[string]any{"type": "integer"},
 				},
-				"x_quickpay_agreementid": map[string]any{
-					"type": "string",
+				"ht_modes_ng": map[string]any{
+					"type":    "array",
+					"pattern": "^(20|40)$",
+					"items":   map[string]any{"type": "integer"},
 				},
-				"x_quickpay_apikey": map[string]any{
+				"key": map[string]any{
 					"type": "string",
 				},
-				"x_quickpay_merchantid": map[string]any{
-					"type": "string",
+				"optimize": map[string]any{
+					"type":        "array",
+					"description": "One of: channel|power",
+					"enum":        []any{"channel", "power"},
+					"items":       map[string]any{"type": "string"},
 				},
-				"x_stripe_api_key": map[string]any{
-					"type": "string",
+				"radios": map[string]any{
+					"type":        "array",
+					"description": "One of: na|ng",
+					"enum":        []any{"na", "ng"},
+					"items":       map[string]any{"type": "string"},
 				},
-				"x_wechat_app_secret": map[string]any{
-					"type": "string",
+				"setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
 				},
-				"x_wechat_secret_key": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
+				"useXY": map[string]any{
+					"type": "boolean",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9135,10 +16509,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_ips",
-		Description: "Get SettingIps",
+		Name:        "get_setting_radius",
+		Description: "Get SettingRadius",
 		Category:    "get",
-		Resource:    "SettingIps",
+		Resource:    "SettingRadius",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9147,6 +16521,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9155,10 +16547,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_ips",
-		Description: "Update SettingIps",
+		Name:        "update_setting_radius",
+		Description: "Update SettingRadius",
 		Category:    "update",
-		Resource:    "SettingIps",
+		Resource:    "SettingRadius",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9167,17 +16559,12 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"ad_blocking_configurations": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
-				"ad_blocking_enabled": map[string]any{
+				"accounting_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"advanced_filtering_preference": map[string]any{
-					"type":        "string",
-					"description": "One of: |manual|disabled",
-					"enum":        []any{"manual", "disabled"},
+				"acct_port": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -9191,49 +16578,36 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"dns_filtering": map[string]any{
-					"type": "boolean",
-				},
-				"dns_filters": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
-				"enabled_categories": map[string]any{
-					"type":        "array",
-					"description": "One of: emerging-activex|emerging-attackresponse|botcc|emerging-chat|ciarmy|compromised|emerging-dns|emerging-dos|dshield|emerging-exploit|emerging-ftp|emerging-games|emerging-icmp|emerging-icmpinfo|emerging-imap|emerging-inappropriate|emerging-info|emerging-malware|emerging-misc|emerging-mobile|emerging-netbios|emerging-p2p|emerging-policy|emerging-pop3|emerging-rpc|emerging-scada|emerging-scan|emerging-shellcode|emerging-smtp|emerging-snmp|emerging-sql|emerging-telnet|emerging-tftp|tor|emerging-useragent|emerging-voip|emerging-webapps|emerging-webclient|emerging-webserver|emerging-worm|exploit-kit|adware-pup|botcc-portgrouped|phishing|threatview-cs-c2|3coresec|chat|coinminer|current-events|drop|hunting|icmp-info|inappropriate|info|ja3|policy|scada|dark-web-blocker-list|malicious-hosts",
-					"enum":        []any{"emerging-activex", "emerging-attackresponse", "botcc", "emerging-chat", "ciarmy", "compromised", "emerging-dns", "emerging-dos", "dshield", "emerging-exploit", "emerging-ftp", "emerging-games", "emerging-icmp", "emerging-icmpinfo", "emerging-imap", "emerging-inappropriate", "emerging-info", "emerging-malware", "emerging-misc", "emerging-mobile", "emerging-netbios", "emerging-p2p", "emerging-policy", "emerging-pop3", "emerging-rpc", "emerging-scada", "emerging-scan", "emerging-shellcode", "emerging-smtp", "emerging-snmp", "emerging-sql", "emerging-telnet", "emerging-tftp", "tor", "emerging-useragent", "emerging-voip", "emerging-webapps", "emerging-webclient", "emerging-webserver", "emerging-worm", "exploit-kit", "adware-pup", "botcc-portgrouped", "phishing", "threatview-cs-c2", "3coresec", "chat", "coinminer", "current-events", "drop", "hunting", "icmp-info", "inappropriate", "info", "ja3", "policy", "scada", "dark-web-blocker-list", "malicious-hosts"},
-					"items":       map[string]any{"type": "string"},
-				},
-				"enabled_networks": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
+				"auth_port": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
 				},
-				"honeypot": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"configure_whole_network": map[string]any{
+					"type": "boolean",
 				},
-				"honeypot_enabled": map[string]any{
+				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"ips_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: ids|ips|ipsInline|disabled",
-					"enum":        []any{"ids", "ips", "ipsInline", "disabled"},
+				"interim_update_interval": map[string]any{
+					"type":    "integer",
+					"pattern": "^([6-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9][0-9]|86400)$",
 				},
 				"key": map[string]any{
 					"type": "string",
 				},
-				"memory_optimized": map[string]any{
-					"type": "boolean",
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"restrict_torrents": map[string]any{
+				"tunneled_reply": map[string]any{
 					"type": "boolean",
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"x_secret": map[string]any{
+					"type":    "string",
+					"pattern": "^[^\\\\\"' ]{1,48}$",
 				},
-				"suppression": map[string]any{
-					"type": "object",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -9243,10 +16617,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_lcm",
-		Description: "Get SettingLcm",
+		Name:        "get_setting_rsyslogd",
+		Description: "Get SettingRsyslogd",
 		Category:    "get",
-		Resource:    "SettingLcm",
+		Resource:    "SettingRsyslogd",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9255,6 +16629,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9263,10 +16655,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_lcm",
-		Description: "Update SettingLcm",
+		Name:        "update_setting_rsyslogd",
+		Description: "Update SettingRsyslogd",
 		Category:    "update",
-		Resource:    "SettingLcm",
+		Resource:    "SettingRsyslogd",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9287,29 +16679,54 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"brightness": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9]|[1-9][0-9]|100",
+				"contents": map[string]any{
+					"type":        "array",
+					"description": "One of: device|client|firewall_default_policy|triggers|updates|admin_activity|critical|security_detections|vpn",
+					"enum":        []any{"device", "client", "firewall_default_policy", "triggers", "updates", "admin_activity", "critical", "security_detections", "vpn"},
+					"items":       map[string]any{"type": "string"},
+				},
+				"debug": map[string]any{
+					"type": "boolean",
 				},
 				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"idle_timeout": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9][0-9]|[1-9][0-9][0-9]|[1-2][0-9][0-9][0-9]|3[0-5][0-9][0-9]|3600",
+				"ip": map[string]any{
+					"type": "string",
 				},
 				"key": map[string]any{
 					"type": "string",
 				},
+				"log_all_contents": map[string]any{
+					"type": "boolean",
+				},
+				"netconsole_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"netconsole_host": map[string]any{
+					"type": "string",
+				},
+				"netconsole_port": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
+				},
+				"port": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"sync": map[string]any{
+				"this_controller": map[string]any{
 					"type": "boolean",
 				},
-				"touch_event": map[string]any{
+				"this_controller_encrypted_only": map[string]any{
 					"type": "boolean",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9318,10 +16735,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_locale",
-		Description: "Get SettingLocale",
+		Name:        "get_setting_snmp",
+		Description: "Get SettingSnmp",
 		Category:    "get",
-		Resource:    "SettingLocale",
+		Resource:    "SettingSnmp",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9330,6 +16747,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9338,10 +16773,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_locale",
-		Description: "Update SettingLocale",
+		Name:        "update_setting_snmp",
+		Description: "Update SettingSnmp",
 		Category:    "update",
-		Resource:    "SettingLocale",
+		Resource:    "SettingSnmp",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9362,14 +16797,33 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
+				"community": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,256}",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"enabledV3": map[string]any{
+					"type": "boolean",
+				},
 				"key": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"timezone": map[string]any{
-					"type": "string",
+				"username": map[string]any{
+					"type":    "string",
+					"pattern": "[a-zA-Z0-9_-]{1,30}",
+				},
+				"x_password": map[string]any{
+					"type":    "string",
+					"pattern": "[^'\"]{8,32}",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -9379,10 +16833,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_magic_site_to_site_vpn",
-		Description: "Get SettingMagicSiteToSiteVpn",
+		Name:        "get_setting_ssl_inspection",
+		Description: "Get SettingSslInspection",
 		Category:    "get",
-		Resource:    "SettingMagicSiteToSiteVpn",
+		Resource:    "SettingSslInspection",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9391,6 +16845,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9399,10 +16871,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_magic_site_to_site_vpn",
-		Description: "Update SettingMagicSiteToSiteVpn",
+		Name:        "update_setting_ssl_inspection",
+		Description: "Update SettingSslInspection",
 		Category:    "update",
-		Resource:    "SettingMagicSiteToSiteVpn",
+		Resource:    "SettingSslInspection",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9423,15 +16895,21 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"enabled": map[string]any{
-					"type": "boolean",
-				},
 				"key": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"state": map[string]any{
+					"type":        "string",
+					"description": "One of: off|simple|advanced",
+					"enum":        []any{"off", "simple", "advanced"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9440,17 +16918,35 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_mgmt",
-		Description: "Get SettingMgmt",
+		Name:        "get_setting_super_cloudaccess",
+		Description: "Get SettingSuperCloudaccess",
 		Category:    "get",
-		Resource:    "SettingMgmt",
+		Resource:    "SettingSuperCloudaccess",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
 				"site": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -9460,10 +16956,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_mgmt",
-		Description: "Update SettingMgmt",
+		Name:        "update_setting_super_cloudaccess",
+		Description: "Update SettingSuperCloudaccess",
 		Category:    "update",
-		Resource:    "SettingMgmt",
+		Resource:    "SettingSuperCloudaccess",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9472,12 +16968,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"advanced_feature_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"alert_enabled": map[string]any{
-					"type": "boolean",
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -9490,70 +16980,40 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"auto_upgrade": map[string]any{
-					"type": "boolean",
-				},
-				"auto_upgrade_hour": map[string]any{
-					"type":    "integer",
-					"pattern": "[0-9]|1[0-9]|2[0-3]|^$",
+				"device_auth": map[string]any{
+					"type": "string",
 				},
-				"boot_sound": map[string]any{
-					"type": "boolean",
+				"device_id": map[string]any{
+					"type": "string",
 				},
-				"debug_tools_enabled": map[string]any{
-					"type": "boolean",
+				"device_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Device for device_id, in place of its ID",
 				},
-				"direct_connect_enabled": map[string]any{
+				"enabled": map[string]any{
 					"type": "boolean",
 				},
 				"key": map[string]any{
 					"type": "string",
 				},
-				"led_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"outdoor_mode_enabled": map[string]any{
-					"type": "boolean",
-				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"unifi_idp_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"wifiman_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"x_mgmt_key": map[string]any{
-					"type":    "string",
-					"pattern": "[0-9a-f]{32}",
-				},
-				"x_ssh_auth_password_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"x_ssh_bind_wildcard": map[string]any{
-					"type": "boolean",
-				},
-				"x_ssh_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"x_ssh_keys": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"ubic_uuid": map[string]any{
+					"type": "string",
 				},
-				"x_ssh_md5passwd": map[string]any{
+				"x_certificate_arn": map[string]any{
 					"type": "string",
 				},
-				"x_ssh_password": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,128}",
+				"x_certificate_pem": map[string]any{
+					"type": "string",
 				},
-				"x_ssh_sha512passwd": map[string]any{
+				"x_private_key": map[string]any{
 					"type": "string",
 				},
-				"x_ssh_username": map[string]any{
-					"type":    "string",
-					"pattern": "^[_A-Za-z0-9][-_.A-Za-z0-9]{0,29}$",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -9561,12 +17021,15 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"device_name": "device_id",
+		},
 	},
 	{
-		Name:        "get_setting_netflow",
-		Description: "Get SettingNetflow",
+		Name:        "get_setting_super_events",
+		Description: "Get SettingSuperEvents",
 		Category:    "get",
-		Resource:    "SettingNetflow",
+		Resource:    "SettingSuperEvents",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9575,6 +17038,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9583,10 +17064,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_netflow",
-		Description: "Update SettingNetflow",
+		Name:        "update_setting_super_events",
+		Description: "Update SettingSuperEvents",
 		Category:    "update",
-		Resource:    "SettingNetflow",
+		Resource:    "SettingSuperEvents",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9595,6 +17076,9 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"_ignored": map[string]any{
+					"type": "string",
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -9607,53 +17091,15 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"auto_engine_id_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"enabled": map[string]any{
-					"type": "boolean",
-				},
-				"engine_id": map[string]any{
-					"type":    "integer",
-					"pattern": "^$|[1-9][0-9]*",
-				},
-				"export_frequency": map[string]any{
-					"type": "integer",
-				},
 				"key": map[string]any{
 					"type": "string",
 				},
-				"network_ids": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
-				},
-				"port": map[string]any{
-					"type":    "integer",
-					"pattern": "102[4-9]|10[3-9][0-9]|1[1-9][0-9]{2}|[2-9][0-9]{3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
-				},
-				"refresh_rate": map[string]any{
-					"type": "integer",
-				},
-				"sampling_mode": map[string]any{
-					"type":        "string",
-					"description": "One of: off|hash|random|deterministic",
-					"enum":        []any{"off", "hash", "random", "deterministic"},
-				},
-				"sampling_rate": map[string]any{
-					"type":    "integer",
-					"pattern": "[2-9]|[1-9][0-9]{1,3}|1[0-5][0-9]{3}|16[0-2][0-9]{2}|163[0-7][0-9]|1638[0-3]|^$",
-				},
-				"server": map[string]any{
-					"type":    "string",
-					"pattern": ".{0,252}[^\\.]$",
-				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"version": map[string]any{
-					"type":        "integer",
-					"description": "One of: 5|9|10",
-					"enum":        []any{"5", "9", "10"},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -9663,10 +17109,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_network_optimization",
-		Description: "Get SettingNetworkOptimization",
+		Name:        "get_setting_super_fwupdate",
+		Description: "Get SettingSuperFwupdate",
 		Category:    "get",
-		Resource:    "SettingNetworkOptimization",
+		Resource:    "SettingSuperFwupdate",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9675,6 +17121,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9683,10 +17147,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_network_optimization",
-		Description: "Update SettingNetworkOptimization",
+		Name:        "update_setting_super_fwupdate",
+		Description: "Update SettingSuperFwupdate",
 		Category:    "update",
-		Resource:    "SettingNetworkOptimization",
+		Resource:    "SettingSuperFwupdate",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9707,8 +17171,15 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"enabled": map[string]any{
-					"type": "boolean",
+				"controller_channel": map[string]any{
+					"type":        "string",
+					"description": "One of: internal|alpha|beta|release-candidate|release",
+					"enum":        []any{"internal", "alpha", "beta", "release-candidate", "release"},
+				},
+				"firmware_channel": map[string]any{
+					"type":        "string",
+					"description": "One of: internal|alpha|beta|release-candidate|release",
+					"enum":        []any{"internal", "alpha", "beta", "release-candidate", "release"},
 				},
 				"key": map[string]any{
 					"type": "string",
@@ -9716,6 +17187,13 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"sso_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9724,10 +17202,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_ntp",
-		Description: "Get SettingNtp",
+		Name:        "get_setting_super_identity",
+		Description: "Get SettingSuperIdentity",
 		Category:    "get",
-		Resource:    "SettingNtp",
+		Resource:    "SettingSuperIdentity",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9736,6 +17214,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9744,10 +17240,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_ntp",
-		Description: "Update SettingNtp",
+		Name:        "update_setting_super_identity",
+		Description: "Update SettingSuperIdentity",
 		Category:    "update",
-		Resource:    "SettingNtp",
+		Resource:    "SettingSuperIdentity",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9768,29 +17264,22 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"key": map[string]any{
-					"type": "string",
-				},
-				"ntp_server_1": map[string]any{
-					"type": "string",
-				},
-				"ntp_server_2": map[string]any{
+				"hostname": map[string]any{
 					"type": "string",
 				},
-				"ntp_server_3": map[string]any{
+				"key": map[string]any{
 					"type": "string",
 				},
-				"ntp_server_4": map[string]any{
+				"name": map[string]any{
 					"type": "string",
 				},
-				"setting_preference": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
-				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9799,10 +17288,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_porta",
-		Description: "Get SettingPorta",
+		Name:        "get_setting_super_mail",
+		Description: "Get SettingSuperMail",
 		Category:    "get",
-		Resource:    "SettingPorta",
+		Resource:    "SettingSuperMail",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9811,6 +17300,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9819,10 +17326,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_porta",
-		Description: "Update SettingPorta",
+		Name:        "update_setting_super_mail",
+		Description: "Update SettingSuperMail",
 		Category:    "update",
-		Resource:    "SettingPorta",
+		Resource:    "SettingSuperMail",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9846,12 +17353,18 @@ var AllToolMetadata = []ToolMetadata{
 				"key": map[string]any{
 					"type": "string",
 				},
+				"provider": map[string]any{
+					"type":        "string",
+					"description": "One of: smtp|cloud|disabled",
+					"enum":        []any{"smtp", "cloud", "disabled"},
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"ugw3_wan2_enabled": map[string]any{
-					"type": "boolean",
-				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9860,10 +17373,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_radio_ai",
-		Description: "Get SettingRadioAi",
+		Name:        "get_setting_super_mgmt",
+		Description: "Get SettingSuperMgmt",
 		Category:    "get",
-		Resource:    "SettingRadioAi",
+		Resource:    "SettingSuperMgmt",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9872,6 +17385,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9880,10 +17411,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_radio_ai",
-		Description: "Update SettingRadioAi",
+		Name:        "update_setting_super_mgmt",
+		Description: "Update SettingSuperMgmt",
 		Category:    "update",
-		Resource:    "SettingRadioAi",
+		Resource:    "SettingSuperMgmt",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9892,6 +17423,9 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"analytics_disapproved_for": map[string]any{
+					"type": "string",
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -9904,80 +17438,166 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"auto_adjust_channels_to_country": map[string]any{
+				"auto_upgrade": map[string]any{
 					"type": "boolean",
 				},
-				"channels_6e": map[string]any{
-					"type":    "array",
-					"pattern": "[1-9]|[1-2][0-9]|3[3-9]|[4-5][0-9]|6[0-1]|6[5-9]|[7-8][0-9]|9[0-3]|9[7-9]|1[0-1][0-9]|12[0-5]|129|1[3-4][0-9]|15[0-7]|16[1-9]|1[7-8][0-9]|19[3-9]|2[0-1][0-9]|22[0-1]|22[5-9]|233",
-					"items":   map[string]any{"type": "integer"},
+				"autobackup_cron_expr": map[string]any{
+					"type": "string",
 				},
-				"channels_blacklist": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"autobackup_days": map[string]any{
+					"type": "integer",
 				},
-				"channels_na": map[string]any{
-					"type":        "array",
-					"description": "One of: 34|36|38|40|42|44|46|48|52|56|60|64|100|104|108|112|116|120|124|128|132|136|140|144|149|153|157|161|165|169",
-					"enum":        []any{"34", "36", "38", "40", "42", "44", "46", "48", "52", "56", "60", "64", "100", "104", "108", "112", "116", "120", "124", "128", "132", "136", "140", "144", "149", "153", "157", "161", "165", "169"},
-					"items":       map[string]any{"type": "integer"},
+				"autobackup_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"channels_ng": map[string]any{
+				"autobackup_gcs_bucket": map[string]any{
+					"type": "string",
+				},
+				"autobackup_gcs_certificate_path": map[string]any{
+					"type": "string",
+				},
+				"autobackup_local_path": map[string]any{
+					"type": "string",
+				},
+				"autobackup_max_files": map[string]any{
+					"type": "integer",
+				},
+				"autobackup_post_actions": map[string]any{
 					"type":        "array",
-					"description": "One of: 1|2|3|4|5|6|7|8|9|10|11|12|13|14",
-					"enum":        []any{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14"},
-					"items":       map[string]any{"type": "integer"},
+					"description": "One of: copy_local|copy_s3|copy_gcs|copy_cloud",
+					"enum":        []any{"copy_local", "copy_s3", "copy_gcs", "copy_cloud"},
+					"items":       map[string]any{"type": "string"},
 				},
-				"cron_expr": map[string]any{
+				"autobackup_s3_access_key": map[string]any{
 					"type": "string",
 				},
-				"default": map[string]any{
-					"type": "boolean",
+				"autobackup_s3_access_secret": map[string]any{
+					"type": "string",
 				},
-				"enabled": map[string]any{
+				"autobackup_s3_bucket": map[string]any{
+					"type": "string",
+				},
+				"autobackup_timezone": map[string]any{
+					"type": "string",
+				},
+				"backup_to_cloud_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"exclude_devices": map[string]any{
-					"type":    "array",
-					"pattern": "([0-9a-z]{2}:){5}[0-9a-z]{2}",
-					"items":   map[string]any{"type": "string"},
+				"contact_info_city": map[string]any{
+					"type": "string",
 				},
-				"ht_modes_na": map[string]any{
-					"type":    "array",
-					"pattern": "^(20|40|80|160)$",
-					"items":   map[string]any{"type": "integer"},
+				"contact_info_company_name": map[string]any{
+					"type": "string",
 				},
-				"ht_modes_ng": map[string]any{
-					"type":    "array",
-					"pattern": "^(20|40)$",
-					"items":   map[string]any{"type": "integer"},
+				"contact_info_country": map[string]any{
+					"type": "string",
 				},
-				"key": map[string]any{
+				"contact_info_full_name": map[string]any{
 					"type": "string",
 				},
-				"optimize": map[string]any{
-					"type":        "array",
-					"description": "One of: channel|power",
-					"enum":        []any{"channel", "power"},
-					"items":       map[string]any{"type": "string"},
+				"contact_info_phone_number": map[string]any{
+					"type": "string",
 				},
-				"radios": map[string]any{
-					"type":        "array",
-					"description": "One of: na|ng",
-					"enum":        []any{"na", "ng"},
-					"items":       map[string]any{"type": "string"},
+				"contact_info_shipping_address_1": map[string]any{
+					"type": "string",
 				},
-				"setting_preference": map[string]any{
+				"contact_info_shipping_address_2": map[string]any{
+					"type": "string",
+				},
+				"contact_info_state": map[string]any{
+					"type": "string",
+				},
+				"contact_info_zip": map[string]any{
+					"type": "string",
+				},
+				"data_retention_setting_preference": map[string]any{
 					"type":        "string",
 					"description": "One of: auto|manual",
 					"enum":        []any{"auto", "manual"},
 				},
+				"data_retention_time_in_hours_for_5minutes_scale": map[string]any{
+					"type": "integer",
+				},
+				"data_retention_time_in_hours_for_daily_scale": map[string]any{
+					"type": "integer",
+				},
+				"data_retention_time_in_hours_for_hourly_scale": map[string]any{
+					"type": "integer",
+				},
+				"data_retention_time_in_hours_for_monthly_scale": map[string]any{
+					"type": "integer",
+				},
+				"data_retention_time_in_hours_for_others": map[string]any{
+					"type": "integer",
+				},
+				"default_site_device_auth_password_alert": map[string]any{
+					"type":    "string",
+					"pattern": "false",
+				},
+				"discoverable": map[string]any{
+					"type": "boolean",
+				},
+				"enable_analytics": map[string]any{
+					"type": "boolean",
+				},
+				"google_maps_api_key": map[string]any{
+					"type": "string",
+				},
+				"image_maps_use_google_engine": map[string]any{
+					"type": "boolean",
+				},
+				"key": map[string]any{
+					"type": "string",
+				},
+				"led_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"live_chat": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|super-only|everyone",
+					"enum":        []any{"disabled", "super-only", "everyone"},
+				},
+				"live_updates": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|live|auto",
+					"enum":        []any{"disabled", "live", "auto"},
+				},
+				"minimum_usable_hd_space": map[string]any{
+					"type": "integer",
+				},
+				"minimum_usable_sd_space": map[string]any{
+					"type": "integer",
+				},
+				"multiple_sites_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"override_inform_host": map[string]any{
+					"type": "boolean",
+				},
+				"override_inform_host_location": map[string]any{
+					"type": "string",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"useXY": map[string]any{
+				"store_enabled": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|super-only|everyone",
+					"enum":        []any{"disabled", "super-only", "everyone"},
+				},
+				"time_series_per_client_stats_enabled": map[string]any{
 					"type": "boolean",
 				},
+				"x_ssh_password": map[string]any{
+					"type": "string",
+				},
+				"x_ssh_username": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -9986,10 +17606,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_radius",
-		Description: "Get SettingRadius",
+		Name:        "get_setting_super_sdn",
+		Description: "Get SettingSuperSdn",
 		Category:    "get",
-		Resource:    "SettingRadius",
+		Resource:    "SettingSuperSdn",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -9998,6 +17618,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10006,10 +17644,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_radius",
-		Description: "Update SettingRadius",
+		Name:        "update_setting_super_sdn",
+		Description: "Update SettingSuperSdn",
 		Category:    "update",
-		Resource:    "SettingRadius",
+		Resource:    "SettingSuperSdn",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -10018,13 +17656,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"accounting_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"acct_port": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -10037,32 +17668,37 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"auth_port": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
+				"auth_token": map[string]any{
+					"type": "string",
 				},
-				"configure_whole_network": map[string]any{
-					"type": "boolean",
+				"device_id": map[string]any{
+					"type": "string",
+				},
+				"device_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Device for device_id, in place of its ID",
 				},
 				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"interim_update_interval": map[string]any{
-					"type":    "integer",
-					"pattern": "^([6-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9][0-9]|86400)$",
-				},
 				"key": map[string]any{
 					"type": "string",
 				},
-				"site_id": map[string]any{
+				"migrated": map[string]any{
+					"type": "boolean",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"sso_login_enabled": map[string]any{
+					"type": "string",
+				},
+				"ubic_uuid": map[string]any{
 					"type": "string",
 				},
-				"tunneled_reply": map[string]any{
-					"type": "boolean",
-				},
-				"x_secret": map[string]any{
-					"type":    "string",
-					"pattern": "^[^\\\\\"' ]{1,48}$",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -10070,12 +17706,15 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"device_name": "device_id",
+		},
 	},
 	{
-		Name:        "get_setting_rsyslogd",
-		Description: "Get SettingRsyslogd",
+		Name:        "get_setting_super_smtp",
+		Description: "Get SettingSuperSmtp",
 		Category:    "get",
-		Resource:    "SettingRsyslogd",
+		Resource:    "SettingSuperSmtp",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -10084,6 +17723,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10092,10 +17749,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_rsyslogd",
-		Description: "Update SettingRsyslogd",
+		Name:        "update_setting_super_smtp",
+		Description: "Update SettingSuperSmtp",
 		Category:    "update",
-		Resource:    "SettingRsyslogd",
+		Resource:    "SettingSuperSmtp",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -10116,50 +17773,44 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"contents": map[string]any{
-					"type":        "array",
-					"description": "One of: device|client|firewall_default_policy|triggers|updates|admin_activity|critical|security_detections|vpn",
-					"enum":        []any{"device", "client", "firewall_default_policy", "triggers", "updates", "admin_activity", "critical", "security_detections", "vpn"},
-					"items":       map[string]any{"type": "string"},
-				},
-				"debug": map[string]any{
-					"type": "boolean",
-				},
 				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"ip": map[string]any{
+				"host": map[string]any{
 					"type": "string",
 				},
 				"key": map[string]any{
 					"type": "string",
 				},
-				"log_all_contents": map[string]any{
-					"type": "boolean",
-				},
-				"netconsole_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"netconsole_host": map[string]any{
-					"type": "string",
-				},
-				"netconsole_port": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
-				},
 				"port": map[string]any{
 					"type":    "integer",
-					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]",
+					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]|^$",
+				},
+				"sender": map[string]any{
+					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"this_controller": map[string]any{
+				"use_auth": map[string]any{
 					"type": "boolean",
 				},
-				"this_controller_encrypted_only": map[string]any{
+				"use_sender": map[string]any{
+					"type": "boolean",
+				},
+				"use_ssl": map[string]any{
 					"type": "boolean",
 				},
+				"username": map[string]any{
+					"type": "string",
+				},
+				"x_password": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10168,10 +17819,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_snmp",
-		Description: "Get SettingSnmp",
+		Name:        "get_setting_teleport",
+		Description: "Get SettingTeleport",
 		Category:    "get",
-		Resource:    "SettingSnmp",
+		Resource:    "SettingTeleport",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -10180,6 +17831,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10188,10 +17857,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_snmp",
-		Description: "Update SettingSnmp",
+		Name:        "update_setting_teleport",
+		Description: "Update SettingTeleport",
 		Category:    "update",
-		Resource:    "SettingSnmp",
+		Resource:    "SettingTeleport",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -10212,29 +17881,22 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"community": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,256}",
-				},
 				"enabled": map[string]any{
 					"type": "boolean",
 				},
-				"enabledV3": map[string]any{
-					"type": "boolean",
-				},
 				"key": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"username": map[string]any{
+				"subnet_cidr": map[string]any{
 					"type":    "string",
-					"pattern": "[a-zA-Z0-9_-]{1,30}",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([8-9]|[1-2][0-9]|3[0-2])$|^$",
 				},
-				"x_password": map[string]any{
-					"type":    "string",
-					"pattern": "[^'\"]{8,32}",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -10244,10 +17906,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_ssl_inspection",
-		Description: "Get SettingSslInspection",
+		Name:        "get_setting_usg",
+		Description: "Get SettingUsg",
 		Category:    "get",
-		Resource:    "SettingSslInspection",
+		Resource:    "SettingUsg",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -10256,6 +17918,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10264,10 +17944,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_ssl_inspection",
-		Description: "Update SettingSslInspection",
+		Name:        "update_setting_usg",
+		Description: "Update SettingUsg",
 		Category:    "update",
-		Resource:    "SettingSslInspection",
+		Resource:    "SettingUsg",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -10276,107 +17956,216 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"attr_hidden": map[string]any{
+				"arp_cache_base_reachable": map[string]any{
+					"type":    "integer",
+					"pattern": "^$|^[1-9]{1}[0-9]{0,4}$",
+				},
+				"arp_cache_timeout": map[string]any{
+					"type":        "string",
+					"description": "One of: normal|min-dhcp-lease|custom",
+					"enum":        []any{"normal", "min-dhcp-lease", "custom"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"broadcast_ping": map[string]any{
+					"type": "boolean",
+				},
+				"dhcp_relay_agents_packets": map[string]any{
+					"type":    "string",
+					"pattern": "append|discard|forward|replace|^$",
+				},
+				"dhcp_relay_hop_count": map[string]any{
+					"type":    "integer",
+					"pattern": "([1-9]|[1-8][0-9]|9[0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])|^$",
+				},
+				"dhcp_relay_max_size": map[string]any{
+					"type":    "integer",
+					"pattern": "(6[4-9]|[7-9][0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|1[0-3][0-9]{2}|1400)|^$",
+				},
+				"dhcp_relay_port": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]|^$",
+				},
+				"dhcp_relay_server_1": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcp_relay_server_2": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcp_relay_server_3": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcp_relay_server_4": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcp_relay_server_5": map[string]any{
+					"type":    "string",
+					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"dhcpd_hostfile_update": map[string]any{
+					"type": "boolean",
+				},
+				"dhcpd_use_dnsmasq": map[string]any{
+					"type": "boolean",
+				},
+				"dns_verification": map[string]any{
+					"type": "object",
+				},
+				"dnsmasq_all_servers": map[string]any{
+					"type": "boolean",
+				},
+				"echo_server": map[string]any{
+					"type":    "string",
+					"pattern": "[^\\\"\\' ]{1,255}",
+				},
+				"ftp_module": map[string]any{
+					"type": "boolean",
+				},
+				"geo_ip_filtering_block": map[string]any{
+					"type":        "string",
+					"description": "One of: block|allow",
+					"enum":        []any{"block", "allow"},
+				},
+				"geo_ip_filtering_countries": map[string]any{
+					"type":    "string",
+					"pattern": "^([A-Z]{2})?(,[A-Z]{2}){0,149}$",
+				},
+				"geo_ip_filtering_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"geo_ip_filtering_traffic_direction": map[string]any{
+					"type":    "string",
+					"pattern": "^(both|ingress|egress)$",
+				},
+				"gre_module": map[string]any{
+					"type": "boolean",
+				},
+				"h323_module": map[string]any{
+					"type": "boolean",
+				},
+				"icmp_timeout": map[string]any{
+					"type": "integer",
+				},
+				"key": map[string]any{
+					"type": "string",
+				},
+				"lldp_enable_all": map[string]any{
+					"type": "boolean",
+				},
+				"mdns_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"mss_clamp": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|custom|disabled",
+					"enum":        []any{"auto", "custom", "disabled"},
+				},
+				"mss_clamp_mss": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-9][0-9]{2,3}",
+				},
+				"offload_accounting": map[string]any{
 					"type": "boolean",
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
+				"offload_l2_blocking": map[string]any{
+					"type": "boolean",
 				},
-				"attr_no_delete": map[string]any{
+				"offload_sch": map[string]any{
 					"type": "boolean",
 				},
-				"attr_no_edit": map[string]any{
+				"other_timeout": map[string]any{
+					"type": "integer",
+				},
+				"pptp_module": map[string]any{
 					"type": "boolean",
 				},
-				"key": map[string]any{
-					"type": "string",
+				"receive_redirects": map[string]any{
+					"type": "boolean",
+				},
+				"send_redirects": map[string]any{
+					"type": "boolean",
+				},
+				"sip_module": map[string]any{
+					"type": "boolean",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"state": map[string]any{
-					"type":        "string",
-					"description": "One of: off|simple|advanced",
-					"enum":        []any{"off", "simple", "advanced"},
+				"syn_cookies": map[string]any{
+					"type": "boolean",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"tcp_close_timeout": map[string]any{
+					"type": "integer",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_setting_super_cloudaccess",
-		Description: "Get SettingSuperCloudaccess",
-		Category:    "get",
-		Resource:    "SettingSuperCloudaccess",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"tcp_close_wait_timeout": map[string]any{
+					"type": "integer",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"tcp_established_timeout": map[string]any{
+					"type": "integer",
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_setting_super_cloudaccess",
-		Description: "Update SettingSuperCloudaccess",
-		Category:    "update",
-		Resource:    "SettingSuperCloudaccess",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"tcp_fin_wait_timeout": map[string]any{
+					"type": "integer",
 				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
+				"tcp_last_ack_timeout": map[string]any{
+					"type": "integer",
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
+				"tcp_syn_recv_timeout": map[string]any{
+					"type": "integer",
 				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
+				"tcp_syn_sent_timeout": map[string]any{
+					"type": "integer",
 				},
-				"attr_no_edit": map[string]any{
+				"tcp_time_wait_timeout": map[string]any{
+					"type": "integer",
+				},
+				"tftp_module": map[string]any{
 					"type": "boolean",
 				},
-				"device_auth": map[string]any{
-					"type": "string",
+				"timeout_setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
 				},
-				"device_id": map[string]any{
-					"type": "string",
+				"udp_other_timeout": map[string]any{
+					"type": "integer",
 				},
-				"enabled": map[string]any{
-					"type": "boolean",
+				"udp_stream_timeout": map[string]any{
+					"type": "integer",
 				},
-				"key": map[string]any{
-					"type": "string",
+				"unbind_wan_monitors": map[string]any{
+					"type": "boolean",
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"upnp_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"ubic_uuid": map[string]any{
-					"type": "string",
+				"upnp_nat_pmp_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"x_certificate_arn": map[string]any{
-					"type": "string",
+				"upnp_secure_mode": map[string]any{
+					"type": "boolean",
 				},
-				"x_certificate_pem": map[string]any{
-					"type": "string",
+				"upnp_wan_interface": map[string]any{
+					"type":        "string",
+					"description": "One of: WAN|WAN2",
+					"enum":        []any{"WAN", "WAN2"},
 				},
-				"x_private_key": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -10386,10 +18175,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_super_events",
-		Description: "Get SettingSuperEvents",
+		Name:        "get_setting_usw",
+		Description: "Get SettingUsw",
 		Category:    "get",
-		Resource:    "SettingSuperEvents",
+		Resource:    "SettingUsw",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -10398,6 +18187,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10406,10 +18213,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_super_events",
-		Description: "Update SettingSuperEvents",
+		Name:        "update_setting_usw",
+		Description: "Update SettingUsw",
 		Category:    "update",
-		Resource:    "SettingSuperEvents",
+		Resource:    "SettingUsw",
 		IsSetting:   true,
 		InputSchema: map[string]any{
 			"type": "object",
@@ -10418,9 +18225,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"_ignored": map[string]any{
-					"type": "string",
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -10433,12 +18237,19 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
+				"dhcp_snoop": map[string]any{
+					"type": "boolean",
+				},
 				"key": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10447,11 +18258,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_super_fwupdate",
-		Description: "Get SettingSuperFwupdate",
-		Category:    "get",
-		Resource:    "SettingSuperFwupdate",
-		IsSetting:   true,
+		Name:        "list_spatial_record",
+		Description: "List all SpatialRecord resources",
+		Category:    "list",
+		Resource:    "SpatialRecord",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -10459,56 +18269,76 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_setting_super_fwupdate",
-		Description: "Update SettingSuperFwupdate",
-		Category:    "update",
-		Resource:    "SettingSuperFwupdate",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"search": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "Case-insensitive text search across string field values, including nested ones",
 				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
 				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
 				},
-				"attr_no_edit": map[string]any{
-					"type": "boolean",
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"controller_channel": map[string]any{
-					"type":        "string",
-					"description": "One of: internal|alpha|beta|release-candidate|release",
-					"enum":        []any{"internal", "alpha", "beta", "release-candidate", "release"},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"firmware_channel": map[string]any{
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "One of: internal|alpha|beta|release-candidate|release",
-					"enum":        []any{"internal", "alpha", "beta", "release-candidate", "release"},
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"key": map[string]any{
-					"type": "string",
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
 				},
-				"sso_enabled": map[string]any{
-					"type": "boolean",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -10518,11 +18348,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_super_identity",
-		Description: "Get SettingSuperIdentity",
+		Name:        "get_spatial_record",
+		Description: "Get SpatialRecord by ID",
 		Category:    "get",
-		Resource:    "SettingSuperIdentity",
-		IsSetting:   true,
+		Resource:    "SpatialRecord",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -10530,6 +18359,32 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the SpatialRecord by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10538,11 +18393,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_super_identity",
-		Description: "Update SettingSuperIdentity",
-		Category:    "update",
-		Resource:    "SettingSuperIdentity",
-		IsSetting:   true,
+		Name:        "create_spatial_record",
+		Description: "Create new SpatialRecord",
+		Category:    "create",
+		Resource:    "SpatialRecord",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -10562,37 +18416,29 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"hostname": map[string]any{
-					"type": "string",
-				},
-				"key": map[string]any{
-					"type": "string",
+				"devices": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
 				"name": map[string]any{
-					"type": "string",
+					"type":    "string",
+					"pattern": ".{1,128}",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
-				},
-			},
-		},
-	},
-	{
-		Name:        "get_setting_super_mail",
-		Description: "Get SettingSuperMail",
-		Category:    "get",
-		Resource:    "SettingSuperMail",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"idempotency_key": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -10602,11 +18448,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_super_mail",
-		Description: "Update SettingSuperMail",
+		Name:        "update_spatial_record",
+		Description: "Update SpatialRecord by ID",
 		Category:    "update",
-		Resource:    "SettingSuperMail",
-		IsSetting:   true,
+		Resource:    "SpatialRecord",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -10614,6 +18459,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -10626,36 +18475,20 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"key": map[string]any{
-					"type": "string",
+				"devices": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"provider": map[string]any{
-					"type":        "string",
-					"description": "One of: smtp|cloud|disabled",
-					"enum":        []any{"smtp", "cloud", "disabled"},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"resolve": map[string]any{
+				"humanize": map[string]any{
 					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
-				},
-			},
-		},
-	},
-	{
-		Name:        "get_setting_super_mgmt",
-		Description: "Get SettingSuperMgmt",
-		Category:    "get",
-		Resource:    "SettingSuperMgmt",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -10665,11 +18498,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_super_mgmt",
-		Description: "Update SettingSuperMgmt",
-		Category:    "update",
-		Resource:    "SettingSuperMgmt",
-		IsSetting:   true,
+		Name:        "upsert_spatial_record",
+		Description: "Create or update SpatialRecord matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "SpatialRecord",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -10677,8 +18509,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"analytics_disapproved_for": map[string]any{
-					"type": "string",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing SpatialRecord (default: [\"name\"]). One match is updated, none creates a new SpatialRecord, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -10692,161 +18526,133 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"auto_upgrade": map[string]any{
-					"type": "boolean",
-				},
-				"autobackup_cron_expr": map[string]any{
-					"type": "string",
-				},
-				"autobackup_days": map[string]any{
-					"type": "integer",
-				},
-				"autobackup_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"autobackup_gcs_bucket": map[string]any{
-					"type": "string",
-				},
-				"autobackup_gcs_certificate_path": map[string]any{
-					"type": "string",
-				},
-				"autobackup_local_path": map[string]any{
-					"type": "string",
-				},
-				"autobackup_max_files": map[string]any{
-					"type": "integer",
-				},
-				"autobackup_post_actions": map[string]any{
-					"type":        "array",
-					"description": "One of: copy_local|copy_s3|copy_gcs|copy_cloud",
-					"enum":        []any{"copy_local", "copy_s3", "copy_gcs", "copy_cloud"},
-					"items":       map[string]any{"type": "string"},
-				},
-				"autobackup_s3_access_key": map[string]any{
-					"type": "string",
-				},
-				"autobackup_s3_access_secret": map[string]any{
-					"type": "string",
-				},
-				"autobackup_s3_bucket": map[string]any{
-					"type": "string",
-				},
-				"autobackup_timezone": map[string]any{
-					"type": "string",
-				},
-				"backup_to_cloud_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"contact_info_city": map[string]any{
-					"type": "string",
-				},
-				"contact_info_company_name": map[string]any{
-					"type": "string",
-				},
-				"contact_info_country": map[string]any{
-					"type": "string",
-				},
-				"contact_info_full_name": map[string]any{
-					"type": "string",
-				},
-				"contact_info_phone_number": map[string]any{
-					"type": "string",
+				"devices": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"contact_info_shipping_address_1": map[string]any{
-					"type": "string",
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
 				},
-				"contact_info_shipping_address_2": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"contact_info_state": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"contact_info_zip": map[string]any{
-					"type": "string",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"data_retention_setting_preference": map[string]any{
+			},
+		},
+	},
+	{
+		Name:        "delete_spatial_record",
+		Description: "Delete SpatialRecord by ID",
+		Category:    "delete",
+		Resource:    "SpatialRecord",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
-				},
-				"data_retention_time_in_hours_for_5minutes_scale": map[string]any{
-					"type": "integer",
-				},
-				"data_retention_time_in_hours_for_daily_scale": map[string]any{
-					"type": "integer",
-				},
-				"data_retention_time_in_hours_for_hourly_scale": map[string]any{
-					"type": "integer",
-				},
-				"data_retention_time_in_hours_for_monthly_scale": map[string]any{
-					"type": "integer",
-				},
-				"data_retention_time_in_hours_for_others": map[string]any{
-					"type": "integer",
-				},
-				"default_site_device_auth_password_alert": map[string]any{
-					"type":    "string",
-					"pattern": "false",
-				},
-				"discoverable": map[string]any{
-					"type": "boolean",
-				},
-				"enable_analytics": map[string]any{
-					"type": "boolean",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"google_maps_api_key": map[string]any{
-					"type": "string",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name)",
 				},
-				"image_maps_use_google_engine": map[string]any{
-					"type": "boolean",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the SpatialRecord by name instead of id",
 				},
-				"key": map[string]any{
-					"type": "string",
+			},
+		},
+	},
+	{
+		Name:        "list_tag",
+		Description: "List all Tag resources",
+		Category:    "list",
+		Resource:    "Tag",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"led_enabled": map[string]any{
-					"type": "boolean",
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
 				},
-				"live_chat": map[string]any{
-					"type":        "string",
-					"description": "One of: disabled|super-only|everyone",
-					"enum":        []any{"disabled", "super-only", "everyone"},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"live_updates": map[string]any{
+				"search": map[string]any{
 					"type":        "string",
-					"description": "One of: disabled|live|auto",
-					"enum":        []any{"disabled", "live", "auto"},
-				},
-				"minimum_usable_hd_space": map[string]any{
-					"type": "integer",
+					"description": "Case-insensitive text search across string field values, including nested ones",
 				},
-				"minimum_usable_sd_space": map[string]any{
-					"type": "integer",
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"multiple_sites_enabled": map[string]any{
-					"type": "boolean",
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
 				},
-				"override_inform_host": map[string]any{
-					"type": "boolean",
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
 				},
-				"override_inform_host_location": map[string]any{
-					"type": "string",
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"store_enabled": map[string]any{
+				"expr": map[string]any{
 					"type":        "string",
-					"description": "One of: disabled|super-only|everyone",
-					"enum":        []any{"disabled", "super-only", "everyone"},
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"time_series_per_client_stats_enabled": map[string]any{
-					"type": "boolean",
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
 				},
-				"x_ssh_password": map[string]any{
-					"type": "string",
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
 				},
-				"x_ssh_username": map[string]any{
-					"type": "string",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -10856,11 +18662,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_super_sdn",
-		Description: "Get SettingSuperSdn",
+		Name:        "get_tag",
+		Description: "Get Tag by ID",
 		Category:    "get",
-		Resource:    "SettingSuperSdn",
-		IsSetting:   true,
+		Resource:    "Tag",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -10868,6 +18673,32 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Tag by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10876,11 +18707,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_super_sdn",
-		Description: "Update SettingSuperSdn",
-		Category:    "update",
-		Resource:    "SettingSuperSdn",
-		IsSetting:   true,
+		Name:        "create_tag",
+		Description: "Create new Tag",
+		Category:    "create",
+		Resource:    "Tag",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -10900,29 +18730,28 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"auth_token": map[string]any{
-					"type": "string",
-				},
-				"device_id": map[string]any{
-					"type": "string",
-				},
-				"enabled": map[string]any{
-					"type": "boolean",
+				"member_table": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
 				},
-				"key": map[string]any{
+				"name": map[string]any{
 					"type": "string",
 				},
-				"migrated": map[string]any{
-					"type": "boolean",
-				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"sso_login_enabled": map[string]any{
-					"type": "string",
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"ubic_uuid": map[string]any{
-					"type": "string",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -10932,11 +18761,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_super_smtp",
-		Description: "Get SettingSuperSmtp",
-		Category:    "get",
-		Resource:    "SettingSuperSmtp",
-		IsSetting:   true,
+		Name:        "update_tag",
+		Description: "Update Tag by ID",
+		Category:    "update",
+		Resource:    "Tag",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -10944,6 +18772,36 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"member_table": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -10952,11 +18810,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_setting_super_smtp",
-		Description: "Update SettingSuperSmtp",
-		Category:    "update",
-		Resource:    "SettingSuperSmtp",
-		IsSetting:   true,
+		Name:        "upsert_tag",
+		Description: "Create or update Tag matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "Tag",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -10964,6 +18821,11 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing Tag (default: [\"name\"]). One match is updated, none creates a new Tag, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -10976,39 +18838,19 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"enabled": map[string]any{
-					"type": "boolean",
-				},
-				"host": map[string]any{
-					"type": "string",
-				},
-				"key": map[string]any{
-					"type": "string",
-				},
-				"port": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]|^$",
+				"member_table": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
 				},
-				"sender": map[string]any{
+				"name": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"use_auth": map[string]any{
-					"type": "boolean",
-				},
-				"use_sender": map[string]any{
-					"type": "boolean",
-				},
-				"use_ssl": map[string]any{
-					"type": "boolean",
-				},
-				"username": map[string]any{
-					"type": "string",
-				},
-				"x_password": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -11018,11 +18860,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_setting_teleport",
-		Description: "Get SettingTeleport",
-		Category:    "get",
-		Resource:    "SettingTeleport",
-		IsSetting:   true,
+		Name:        "delete_tag",
+		Description: "Delete Tag by ID",
+		Category:    "delete",
+		Resource:    "Tag",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11030,19 +18871,22 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the Tag by name instead of id",
 				},
 			},
 		},
 	},
 	{
-		Name:        "update_setting_teleport",
-		Description: "Update SettingTeleport",
-		Category:    "update",
-		Resource:    "SettingTeleport",
-		IsSetting:   true,
+		Name:        "list_user",
+		Description: "List all User resources",
+		Category:    "list",
+		Resource:    "User",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11050,30 +18894,81 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each User (_id, name, hostname, mac, ip, fixed_ip, network_id, blocked, last_seen), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
 				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"attr_no_edit": map[string]any{
-					"type": "boolean",
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
 				},
-				"enabled": map[string]any{
-					"type": "boolean",
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"key": map[string]any{
-					"type": "string",
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
 				},
-				"subnet_cidr": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\/([8-9]|[1-2][0-9]|3[0-2])$|^$",
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -11081,13 +18976,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		SummaryFields: []string{"_id", "name", "hostname", "mac", "ip", "fixed_ip", "network_id", "blocked", "last_seen"},
 	},
 	{
-		Name:        "get_setting_usg",
-		Description: "Get SettingUsg",
+		Name:        "get_user",
+		Description: "Get User by ID",
 		Category:    "get",
-		Resource:    "SettingUsg",
-		IsSetting:   true,
+		Resource:    "User",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11095,19 +18990,54 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name or mac)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the User by name instead of id",
+				},
+				"mac": map[string]any{
+					"type":        "string",
+					"description": "Select the User by MAC address instead of id",
+				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the User (_id, name, hostname, mac, ip, fixed_ip, network_id, blocked, last_seen), full (default) for all of them. Ignored when fields is given.",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		SummaryFields: []string{"_id", "name", "hostname", "mac", "ip", "fixed_ip", "network_id", "blocked", "last_seen"},
 	},
 	{
-		Name:        "update_setting_usg",
-		Description: "Update SettingUsg",
-		Category:    "update",
-		Resource:    "SettingUsg",
-		IsSetting:   true,
+		Name:        "create_user",
+		Description: "Create new User",
+		Category:    "create",
+		Resource:    "User",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11115,15 +19045,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"arp_cache_base_reachable": map[string]any{
-					"type":    "integer",
-					"pattern": "^$|^[1-9]{1}[0-9]{0,4}$",
-				},
-				"arp_cache_timeout": map[string]any{
-					"type":        "string",
-					"description": "One of: normal|min-dhcp-lease|custom",
-					"enum":        []any{"normal", "min-dhcp-lease", "custom"},
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -11136,211 +19057,199 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"broadcast_ping": map[string]any{
+				"blocked": map[string]any{
 					"type": "boolean",
 				},
-				"dhcp_relay_agents_packets": map[string]any{
-					"type":    "string",
-					"pattern": "append|discard|forward|replace|^$",
-				},
-				"dhcp_relay_hop_count": map[string]any{
-					"type":    "integer",
-					"pattern": "([1-9]|[1-8][0-9]|9[0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])|^$",
-				},
-				"dhcp_relay_max_size": map[string]any{
-					"type":    "integer",
-					"pattern": "(6[4-9]|[7-9][0-9]|[1-8][0-9]{2}|9[0-8][0-9]|99[0-9]|1[0-3][0-9]{2}|1400)|^$",
-				},
-				"dhcp_relay_port": map[string]any{
+				"dev_id_override": map[string]any{
 					"type":    "integer",
-					"pattern": "[1-9][0-9]{0,3}|[1-5][0-9]{4}|[6][0-4][0-9]{3}|[6][5][0-4][0-9]{2}|[6][5][5][0-2][0-9]|[6][5][5][3][0-5]|^$",
+					"pattern": "non-generated field",
 				},
-				"dhcp_relay_server_1": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"fixed_ap_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"dhcp_relay_server_2": map[string]any{
+				"fixed_ap_mac": map[string]any{
 					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
 				},
-				"dhcp_relay_server_3": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"fixed_ip": map[string]any{
+					"type": "string",
 				},
-				"dhcp_relay_server_4": map[string]any{
-					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				"hostname": map[string]any{
+					"type": "string",
 				},
-				"dhcp_relay_server_5": map[string]any{
+				"ip": map[string]any{
 					"type":    "string",
-					"pattern": "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
-				},
-				"dhcpd_hostfile_update": map[string]any{
-					"type": "boolean",
+					"pattern": "non-generated field",
 				},
-				"dhcpd_use_dnsmasq": map[string]any{
-					"type": "boolean",
+				"last_seen": map[string]any{
+					"type": "integer",
 				},
-				"dns_verification": map[string]any{
-					"type": "object",
+				"local_dns_record": map[string]any{
+					"type": "string",
 				},
-				"dnsmasq_all_servers": map[string]any{
+				"local_dns_record_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"echo_server": map[string]any{
+				"mac": map[string]any{
 					"type":    "string",
-					"pattern": "[^\\\"\\' ]{1,255}",
-				},
-				"ftp_module": map[string]any{
-					"type": "boolean",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
 				},
-				"geo_ip_filtering_block": map[string]any{
-					"type":        "string",
-					"description": "One of: block|allow",
-					"enum":        []any{"block", "allow"},
+				"name": map[string]any{
+					"type": "string",
 				},
-				"geo_ip_filtering_countries": map[string]any{
-					"type":    "string",
-					"pattern": "^([A-Z]{2})?(,[A-Z]{2}){0,149}$",
+				"network_id": map[string]any{
+					"type": "string",
 				},
-				"geo_ip_filtering_enabled": map[string]any{
-					"type": "boolean",
+				"network_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for network_id, in place of its ID",
 				},
-				"geo_ip_filtering_traffic_direction": map[string]any{
-					"type":    "string",
-					"pattern": "^(both|ingress|egress)$",
+				"note": map[string]any{
+					"type": "string",
 				},
-				"gre_module": map[string]any{
-					"type": "boolean",
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"h323_module": map[string]any{
+				"use_fixedip": map[string]any{
 					"type": "boolean",
 				},
-				"icmp_timeout": map[string]any{
-					"type": "integer",
-				},
-				"key": map[string]any{
+				"usergroup_id": map[string]any{
 					"type": "string",
 				},
-				"lldp_enable_all": map[string]any{
-					"type": "boolean",
+				"usergroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the UserGroup for usergroup_id, in place of its ID",
 				},
-				"mdns_enabled": map[string]any{
+				"virtual_network_override_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"mss_clamp": map[string]any{
+				"virtual_network_override_id": map[string]any{
+					"type": "string",
+				},
+				"idempotency_key": map[string]any{
 					"type":        "string",
-					"description": "One of: auto|custom|disabled",
-					"enum":        []any{"auto", "custom", "disabled"},
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"mss_clamp_mss": map[string]any{
-					"type":    "integer",
-					"pattern": "[1-9][0-9]{2,3}",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
 				},
-				"offload_accounting": map[string]any{
-					"type": "boolean",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
-				"offload_l2_blocking": map[string]any{
-					"type": "boolean",
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
 				},
-				"offload_sch": map[string]any{
-					"type": "boolean",
+			},
+		},
+		NameInputs: map[string]string{
+			"network_name":   "network_id",
+			"usergroup_name": "usergroup_id",
+		},
+	},
+	{
+		Name:        "update_user",
+		Description: "Update User by ID",
+		Category:    "update",
+		Resource:    "User",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
 				},
-				"other_timeout": map[string]any{
-					"type": "integer",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name or mac)",
 				},
-				"pptp_module": map[string]any{
+				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
-				"receive_redirects": map[string]any{
-					"type": "boolean",
+				"attr_hidden_id": map[string]any{
+					"type": "string",
 				},
-				"send_redirects": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"sip_module": map[string]any{
+				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"site_id": map[string]any{
-					"type": "string",
-				},
-				"syn_cookies": map[string]any{
+				"blocked": map[string]any{
 					"type": "boolean",
 				},
-				"tcp_close_timeout": map[string]any{
-					"type": "integer",
-				},
-				"tcp_close_wait_timeout": map[string]any{
-					"type": "integer",
-				},
-				"tcp_established_timeout": map[string]any{
-					"type": "integer",
-				},
-				"tcp_fin_wait_timeout": map[string]any{
-					"type": "integer",
-				},
-				"tcp_last_ack_timeout": map[string]any{
-					"type": "integer",
+				"dev_id_override": map[string]any{
+					"type":    "integer",
+					"pattern": "non-generated field",
 				},
-				"tcp_syn_recv_timeout": map[string]any{
-					"type": "integer",
+				"fixed_ap_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"tcp_syn_sent_timeout": map[string]any{
-					"type": "integer",
+				"fixed_ap_mac": map[string]any{
+					"type":    "string",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
 				},
-				"tcp_time_wait_timeout": map[string]any{
-					"type": "integer",
+				"fixed_ip": map[string]any{
+					"type": "string",
 				},
-				"tftp_module": map[string]any{
-					"type": "boolean",
+				"hostname": map[string]any{
+					"type": "string",
 				},
-				"timeout_setting_preference": map[string]any{
-					"type":        "string",
-					"description": "One of: auto|manual",
-					"enum":        []any{"auto", "manual"},
+				"ip": map[string]any{
+					"type":    "string",
+					"pattern": "non-generated field",
 				},
-				"udp_other_timeout": map[string]any{
+				"last_seen": map[string]any{
 					"type": "integer",
 				},
-				"udp_stream_timeout": map[string]any{
-					"type": "integer",
+				"local_dns_record": map[string]any{
+					"type": "string",
 				},
-				"unbind_wan_monitors": map[string]any{
+				"local_dns_record_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"upnp_enabled": map[string]any{
-					"type": "boolean",
+				"mac": map[string]any{
+					"type":    "string",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
 				},
-				"upnp_nat_pmp_enabled": map[string]any{
-					"type": "boolean",
+				"name": map[string]any{
+					"type": "string",
 				},
-				"upnp_secure_mode": map[string]any{
-					"type": "boolean",
+				"network_id": map[string]any{
+					"type": "string",
 				},
-				"upnp_wan_interface": map[string]any{
+				"network_name": map[string]any{
 					"type":        "string",
-					"description": "One of: WAN|WAN2",
-					"enum":        []any{"WAN", "WAN2"},
+					"description": "Name of the Network for network_id, in place of its ID",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"note": map[string]any{
+					"type": "string",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_setting_usw",
-		Description: "Get SettingUsw",
-		Category:    "get",
-		Resource:    "SettingUsw",
-		IsSetting:   true,
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"use_fixedip": map[string]any{
+					"type": "boolean",
+				},
+				"usergroup_id": map[string]any{
+					"type": "string",
+				},
+				"usergroup_name": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "Name of the UserGroup for usergroup_id, in place of its ID",
+				},
+				"virtual_network_override_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"virtual_network_override_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -11348,13 +19257,16 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"network_name":   "network_id",
+			"usergroup_name": "usergroup_id",
+		},
 	},
 	{
-		Name:        "update_setting_usw",
-		Description: "Update SettingUsw",
-		Category:    "update",
-		Resource:    "SettingUsw",
-		IsSetting:   true,
+		Name:        "upsert_user",
+		Description: "Create or update User matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "User",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11362,6 +19274,11 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing User (default: [\"name\"]). One match is updated, none creates a new User, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -11374,27 +19291,95 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"dhcp_snoop": map[string]any{
+				"blocked": map[string]any{
 					"type": "boolean",
 				},
-				"key": map[string]any{
+				"dev_id_override": map[string]any{
+					"type":    "integer",
+					"pattern": "non-generated field",
+				},
+				"fixed_ap_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"fixed_ap_mac": map[string]any{
+					"type":    "string",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
+				},
+				"fixed_ip": map[string]any{
+					"type": "string",
+				},
+				"hostname": map[string]any{
+					"type": "string",
+				},
+				"ip": map[string]any{
+					"type":    "string",
+					"pattern": "non-generated field",
+				},
+				"last_seen": map[string]any{
+					"type": "integer",
+				},
+				"local_dns_record": map[string]any{
+					"type": "string",
+				},
+				"local_dns_record_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"mac": map[string]any{
+					"type":    "string",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
+				},
+				"name": map[string]any{
+					"type": "string",
+				},
+				"network_id": map[string]any{
+					"type": "string",
+				},
+				"network_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for network_id, in place of its ID",
+				},
+				"note": map[string]any{
 					"type": "string",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"use_fixedip": map[string]any{
+					"type": "boolean",
+				},
+				"usergroup_id": map[string]any{
+					"type": "string",
+				},
+				"usergroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the UserGroup for usergroup_id, in place of its ID",
+				},
+				"virtual_network_override_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"virtual_network_override_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"network_name":   "network_id",
+			"usergroup_name": "usergroup_id",
+		},
 	},
 	{
-		Name:        "list_spatial_record",
-		Description: "List all SpatialRecord resources",
-		Category:    "list",
-		Resource:    "SpatialRecord",
+		Name:        "delete_user",
+		Description: "Delete User by ID",
+		Category:    "delete",
+		Resource:    "User",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11402,32 +19387,26 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
-				},
-				"fields": map[string]any{
-					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
-					"items":       map[string]any{"type": "string"},
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID to delete (omit to select by name or mac)",
 				},
-				"search": map[string]any{
+				"name": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Select the User by name instead of id",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"mac": map[string]any{
+					"type":        "string",
+					"description": "Select the User by MAC address instead of id",
 				},
 			},
 		},
 	},
 	{
-		Name:        "get_spatial_record",
-		Description: "Get SpatialRecord by ID",
-		Category:    "get",
-		Resource:    "SpatialRecord",
+		Name:        "list_user_group",
+		Description: "List all UserGroup resources",
+		Category:    "list",
+		Resource:    "UserGroup",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11435,23 +19414,95 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
+				"filter": map[string]any{
+					"type":                 "object",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
+					"additionalProperties": true,
+				},
+				"view": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each UserGroup (_id, name, qos_rate_max_down, qos_rate_max_up), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"search": map[string]any{
+					"type":        "string",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
+		SummaryFields: []string{"_id", "name", "qos_rate_max_down", "qos_rate_max_up"},
 	},
 	{
-		Name:        "create_spatial_record",
-		Description: "Create new SpatialRecord",
-		Category:    "create",
-		Resource:    "SpatialRecord",
+		Name:        "get_user_group",
+		Description: "Get UserGroup by ID",
+		Category:    "get",
+		Resource:    "UserGroup",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11459,28 +19510,36 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"attr_hidden": map[string]any{
-					"type": "boolean",
+				"id": map[string]any{
+					"type":        "string",
+					"description": "Resource ID (omit to select by name)",
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the UserGroup by name instead of id",
 				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the UserGroup (_id, name, qos_rate_max_down, qos_rate_max_up), full (default) for all of them. Ignored when fields is given.",
 				},
-				"attr_no_edit": map[string]any{
-					"type": "boolean",
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
 				},
-				"devices": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
 				},
-				"name": map[string]any{
-					"type":    "string",
-					"pattern": ".{1,128}",
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
 				},
-				"site_id": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -11488,12 +19547,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		SummaryFields: []string{"_id", "name", "qos_rate_max_down", "qos_rate_max_up"},
 	},
 	{
-		Name:        "update_spatial_record",
-		Description: "Update SpatialRecord by ID",
-		Category:    "update",
-		Resource:    "SpatialRecord",
+		Name:        "create_user_group",
+		Description: "Create new UserGroup",
+		Category:    "create",
+		Resource:    "UserGroup",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11501,10 +19561,6 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
-				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -11517,70 +19573,33 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"devices": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "object"},
-				},
 				"name": map[string]any{
 					"type":    "string",
 					"pattern": ".{1,128}",
 				},
-				"site_id": map[string]any{
-					"type": "string",
-				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
-				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "delete_spatial_record",
-		Description: "Delete SpatialRecord by ID",
-		Category:    "delete",
-		Resource:    "SpatialRecord",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
-				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID to delete",
+				"qos_rate_max_down": map[string]any{
+					"type":    "integer",
+					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "list_tag",
-		Description: "List all Tag resources",
-		Category:    "list",
-		Resource:    "Tag",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"qos_rate_max_up": map[string]any{
+					"type":    "integer",
+					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
 				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"fields": map[string]any{
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
 					"items":       map[string]any{"type": "string"},
 				},
-				"search": map[string]any{
-					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -11590,10 +19609,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_tag",
-		Description: "Get Tag by ID",
-		Category:    "get",
-		Resource:    "Tag",
+		Name:        "update_user_group",
+		Description: "Update UserGroup by ID",
+		Category:    "update",
+		Resource:    "UserGroup",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11603,27 +19622,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
-				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
-				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "create_tag",
-		Description: "Create new Tag",
-		Category:    "create",
-		Resource:    "Tag",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -11637,16 +19636,25 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"member_table": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
-				},
 				"name": map[string]any{
-					"type": "string",
+					"type":    "string",
+					"pattern": ".{1,128}",
+				},
+				"qos_rate_max_down": map[string]any{
+					"type":    "integer",
+					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
+				},
+				"qos_rate_max_up": map[string]any{
+					"type":    "integer",
+					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -11655,10 +19663,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "update_tag",
-		Description: "Update Tag by ID",
-		Category:    "update",
-		Resource:    "Tag",
+		Name:        "upsert_user_group",
+		Description: "Create or update UserGroup matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "UserGroup",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11666,9 +19674,10 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing UserGroup (default: [\"name\"]). One match is updated, none creates a new UserGroup, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -11682,29 +19691,37 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"member_table": map[string]any{
-					"type":  "array",
-					"items": map[string]any{"type": "string"},
-				},
 				"name": map[string]any{
-					"type": "string",
+					"type":    "string",
+					"pattern": ".{1,128}",
+				},
+				"qos_rate_max_down": map[string]any{
+					"type":    "integer",
+					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
+				},
+				"qos_rate_max_up": map[string]any{
+					"type":    "integer",
+					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "delete_tag",
-		Description: "Delete Tag by ID",
+		Name:        "delete_user_group",
+		Description: "Delete UserGroup by ID",
 		Category:    "delete",
-		Resource:    "Tag",
+		Resource:    "UserGroup",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11714,17 +19731,20 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the UserGroup by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
-		Name:        "list_user",
-		Description: "List all User resources",
+		Name:        "list_virtual_device",
+		Description: "List all VirtualDevice resources",
 		Category:    "list",
-		Resource:    "User",
+		Resource:    "VirtualDevice",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11734,17 +19754,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -11754,10 +19831,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "get_user",
-		Description: "Get User by ID",
+		Name:        "get_virtual_device",
+		Description: "Get VirtualDevice by ID",
 		Category:    "get",
-		Resource:    "User",
+		Resource:    "VirtualDevice",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11769,6 +19846,24 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "Resource ID",
 				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -11778,10 +19873,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "create_user",
-		Description: "Create new User",
+		Name:        "create_virtual_device",
+		Description: "Create new VirtualDevice",
 		Category:    "create",
-		Resource:    "User",
+		Resource:    "VirtualDevice",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11801,66 +19896,45 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"blocked": map[string]any{
-					"type": "boolean",
-				},
-				"dev_id_override": map[string]any{
-					"type":    "integer",
-					"pattern": "non-generated field",
+				"heightInMeters": map[string]any{
+					"type": "number",
 				},
-				"fixed_ap_enabled": map[string]any{
+				"locked": map[string]any{
 					"type": "boolean",
 				},
-				"fixed_ap_mac": map[string]any{
-					"type":    "string",
-					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
-				},
-				"fixed_ip": map[string]any{
-					"type": "string",
-				},
-				"hostname": map[string]any{
-					"type": "string",
-				},
-				"ip": map[string]any{
-					"type":    "string",
-					"pattern": "non-generated field",
-				},
-				"last_seen": map[string]any{
-					"type": "integer",
-				},
-				"local_dns_record": map[string]any{
+				"map_id": map[string]any{
 					"type": "string",
 				},
-				"local_dns_record_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"mac": map[string]any{
-					"type":    "string",
-					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
+				"map_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Map for map_id, in place of its ID",
 				},
-				"name": map[string]any{
+				"site_id": map[string]any{
 					"type": "string",
 				},
-				"network_id": map[string]any{
-					"type": "string",
+				"type": map[string]any{
+					"type":        "string",
+					"description": "One of: uap|usg|usw",
+					"enum":        []any{"uap", "usg", "usw"},
 				},
-				"note": map[string]any{
+				"x": map[string]any{
 					"type": "string",
 				},
-				"site_id": map[string]any{
+				"y": map[string]any{
 					"type": "string",
 				},
-				"use_fixedip": map[string]any{
-					"type": "boolean",
-				},
-				"usergroup_id": map[string]any{
-					"type": "string",
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"virtual_network_override_enabled": map[string]any{
-					"type": "boolean",
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
 				},
-				"virtual_network_override_id": map[string]any{
-					"type": "string",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -11868,12 +19942,15 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"map_name": "map_id",
+		},
 	},
 	{
-		Name:        "update_user",
-		Description: "Update User by ID",
+		Name:        "update_virtual_device",
+		Description: "Update VirtualDevice by ID",
 		Category:    "update",
-		Resource:    "User",
+		Resource:    "VirtualDevice",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11897,80 +19974,123 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"blocked": map[string]any{
+				"heightInMeters": map[string]any{
+					"type": "number",
+				},
+				"locked": map[string]any{
 					"type": "boolean",
 				},
-				"dev_id_override": map[string]any{
-					"type":    "integer",
-					"pattern": "non-generated field",
+				"map_id": map[string]any{
+					"type": "string",
 				},
-				"fixed_ap_enabled": map[string]any{
-					"type": "boolean",
+				"map_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Map for map_id, in place of its ID",
 				},
-				"fixed_ap_mac": map[string]any{
-					"type":    "string",
-					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
+				"site_id": map[string]any{
+					"type": "string",
 				},
-				"fixed_ip": map[string]any{
+				"type": map[string]any{
+					"type":        "string",
+					"description": "One of: uap|usg|usw",
+					"enum":        []any{"uap", "usg", "usw"},
+				},
+				"x": map[string]any{
 					"type": "string",
 				},
-				"hostname": map[string]any{
+				"y": map[string]any{
 					"type": "string",
 				},
-				"ip": map[string]any{
-					"type":    "string",
-					"pattern": "non-generated field",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+			"required": []any{"id"},
+		},
+		NameInputs: map[string]string{
+			"map_name": "map_id",
+		},
+	},
+	{
+		Name:        "upsert_virtual_device",
+		Description: "Create or update VirtualDevice matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "VirtualDevice",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing VirtualDevice (default: [\"name\"]). One match is updated, none creates a new VirtualDevice, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
-				"last_seen": map[string]any{
-					"type": "integer",
+				"attr_hidden": map[string]any{
+					"type": "boolean",
 				},
-				"local_dns_record": map[string]any{
+				"attr_hidden_id": map[string]any{
 					"type": "string",
 				},
-				"local_dns_record_enabled": map[string]any{
+				"attr_no_delete": map[string]any{
 					"type": "boolean",
 				},
-				"mac": map[string]any{
-					"type":    "string",
-					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
 				},
-				"name": map[string]any{
-					"type": "string",
+				"heightInMeters": map[string]any{
+					"type": "number",
 				},
-				"network_id": map[string]any{
-					"type": "string",
+				"locked": map[string]any{
+					"type": "boolean",
 				},
-				"note": map[string]any{
+				"map_id": map[string]any{
 					"type": "string",
 				},
+				"map_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Map for map_id, in place of its ID",
+				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"use_fixedip": map[string]any{
-					"type": "boolean",
+				"type": map[string]any{
+					"type":        "string",
+					"description": "One of: uap|usg|usw",
+					"enum":        []any{"uap", "usg", "usw"},
 				},
-				"usergroup_id": map[string]any{
+				"x": map[string]any{
 					"type": "string",
 				},
-				"virtual_network_override_enabled": map[string]any{
-					"type": "boolean",
-				},
-				"virtual_network_override_id": map[string]any{
+				"y": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
+		},
+		NameInputs: map[string]string{
+			"map_name": "map_id",
 		},
 	},
 	{
-		Name:        "delete_user",
-		Description: "Delete User by ID",
+		Name:        "delete_virtual_device",
+		Description: "Delete VirtualDevice by ID",
 		Category:    "delete",
-		Resource:    "User",
+		Resource:    "VirtualDevice",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -11987,10 +20107,10 @@ var AllToolMetadata = []ToolMetadata{
 		},
 	},
 	{
-		Name:        "list_user_group",
-		Description: "List all UserGroup resources",
+		Name:        "list_wlan",
+		Description: "List all WLAN resources",
 		Category:    "list",
-		Resource:    "UserGroup",
+		Resource:    "WLAN",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -12000,17 +20120,79 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of each WLAN (_id, name, enabled, security, wpa_mode, networkconf_id, is_guest, hide_ssid, wlan_bands), full (default) for all of them. Ignored when fields is given, and with group_by or facets.",
+				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -12018,12 +20200,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		SummaryFields: []string{"_id", "name", "enabled", "security", "wpa_mode", "networkconf_id", "is_guest", "hide_ssid", "wlan_bands"},
 	},
 	{
-		Name:        "get_user_group",
-		Description: "Get UserGroup by ID",
+		Name:        "get_wlan",
+		Description: "Get WLAN by ID",
 		Category:    "get",
-		Resource:    "UserGroup",
+		Resource:    "WLAN",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -12033,21 +20216,48 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the WLAN by name instead of id",
+				},
+				"view": map[string]any{
+					"type":        "string",
+					"enum":        []any{"summary", "full"},
+					"description": "Fields to return: summary for the key fields of the WLAN (_id, name, enabled, security, wpa_mode, networkconf_id, is_guest, hide_ssid, wlan_bands), full (default) for all of them. Ignored when fields is given.",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
+		SummaryFields: []string{"_id", "name", "enabled", "security", "wpa_mode", "networkconf_id", "is_guest", "hide_ssid", "wlan_bands"},
 	},
 	{
-		Name:        "create_user_group",
-		Description: "Create new UserGroup",
+		Name:        "create_wlan",
+		Description: "Create new WLAN",
 		Category:    "create",
-		Resource:    "UserGroup",
+		Resource:    "WLAN",
 		InputSchema: map[string]any{
 			"type": "object",
 			"properties": map[string]any{
@@ -12055,6 +20265,20 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
+				"ap_group_ids": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+				"ap_group_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the APGroup objects for ap_group_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
+				"ap_group_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: all|groups|devices",
+					"enum":        []any{"all", "groups", "devices"},
+				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
 				},
@@ -12067,314 +20291,361 @@ var AllToolMetadata = []ToolMetadata{
 				"attr_no_edit": map[string]any{
 					"type": "boolean",
 				},
-				"name": map[string]any{
+				"auth_cache": map[string]any{
+					"type": "boolean",
+				},
+				"b_supported": map[string]any{
+					"type": "boolean",
+				},
+				"bc_filter_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"bc_filter_list": map[string]any{
+					"type":    "array",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
+					"items":   map[string]any{"type": "string"},
+				},
+				"bss_transition": map[string]any{
+					"type": "boolean",
+				},
+				"country_beacon": map[string]any{
+					"type": "boolean",
+				},
+				"dpi_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"dpigroup_id": map[string]any{
 					"type":    "string",
-					"pattern": ".{1,128}",
+					"pattern": "[\\d\\w]+|^$",
 				},
-				"qos_rate_max_down": map[string]any{
+				"dtim_6e": map[string]any{
 					"type":    "integer",
-					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
+					"pattern": "^([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
 				},
-				"qos_rate_max_up": map[string]any{
+				"dtim_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: default|custom",
+					"enum":        []any{"default", "custom"},
+				},
+				"dtim_na": map[string]any{
 					"type":    "integer",
-					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
+					"pattern": "^([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
 				},
-				"site_id": map[string]any{
+				"dtim_ng": map[string]any{
+					"type":    "integer",
+					"pattern": "^([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$|^$",
+				},
+				"element_adopt": map[string]any{
+					"type": "boolean",
+				},
+				"enabled": map[string]any{
+					"type": "boolean",
+				},
+				"enhanced_iot": map[string]any{
+					"type": "boolean",
+				},
+				"fast_roaming_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"group_rekey": map[string]any{
+					"type":    "integer",
+					"pattern": "^(0|[6-9][0-9]|[1-9][0-9]{2,3}|[1-7][0-9]{4}|8[0-5][0-9]{3}|86[0-3][0-9][0-9]|86400)$",
+				},
+				"hide_ssid": map[string]any{
+					"type": "boolean",
+				},
+				"hotspot2": map[string]any{
+					"type": "object",
+				},
+				"hotspot2conf_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"iapp_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"is_guest": map[string]any{
+					"type": "boolean",
+				},
+				"l2_isolation": map[string]any{
+					"type": "boolean",
+				},
+				"log_level": map[string]any{
 					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"mac_filter_enabled": map[string]any{
+					"type": "boolean",
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_user_group",
-		Description: "Update UserGroup by ID",
-		Category:    "update",
-		Resource:    "UserGroup",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"mac_filter_list": map[string]any{
+					"type":    "array",
+					"pattern": "^([0-9A-Fa-f]{2}:){5}([0-9A-Fa-f]{2})$",
+					"items":   map[string]any{"type": "string"},
 				},
-				"id": map[string]any{
+				"mac_filter_policy": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "One of: allow|deny",
+					"enum":        []any{"allow", "deny"},
+				},
+				"mcastenhance_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"minrate_na_advertising_rates": map[string]any{
+					"type": "boolean",
+				},
+				"minrate_na_data_rate_kbps": map[string]any{
+					"type": "integer",
+				},
+				"minrate_na_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"minrate_ng_advertising_rates": map[string]any{
+					"type": "boolean",
+				},
+				"minrate_ng_data_rate_kbps": map[string]any{
+					"type": "integer",
 				},
-				"attr_hidden": map[string]any{
+				"minrate_ng_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
+				"minrate_setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
 				},
-				"attr_no_delete": map[string]any{
+				"mlo_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"attr_no_edit": map[string]any{
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,32}",
+				},
+				"name_combine_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"name": map[string]any{
+				"name_combine_suffix": map[string]any{
 					"type":    "string",
-					"pattern": ".{1,128}",
+					"pattern": ".{0,8}",
 				},
-				"qos_rate_max_down": map[string]any{
-					"type":    "integer",
-					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
+				"nas_identifier": map[string]any{
+					"type":    "string",
+					"pattern": ".{0,48}",
 				},
-				"qos_rate_max_up": map[string]any{
-					"type":    "integer",
-					"pattern": "-1|[2-9]|[1-9][0-9]{1,4}|100000",
+				"nas_identifier_type": map[string]any{
+					"type":        "string",
+					"description": "One of: ap_name|ap_mac|bssid|site_name|custom",
+					"enum":        []any{"ap_name", "ap_mac", "bssid", "site_name", "custom"},
 				},
-				"site_id": map[string]any{
+				"networkconf_id": map[string]any{
 					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for networkconf_id, in place of its ID",
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "delete_user_group",
-		Description: "Delete UserGroup by ID",
-		Category:    "delete",
-		Resource:    "UserGroup",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"no2ghz_oui": map[string]any{
+					"type": "boolean",
+				},
+				"optimize_iot_wifi_connectivity": map[string]any{
+					"type": "boolean",
+				},
+				"p2p": map[string]any{
+					"type": "boolean",
+				},
+				"p2p_cross_connect": map[string]any{
+					"type": "boolean",
+				},
+				"pmf_cipher": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "One of: auto|aes-128-cmac|bip-gmac-256",
+					"enum":        []any{"auto", "aes-128-cmac", "bip-gmac-256"},
 				},
-				"id": map[string]any{
+				"pmf_mode": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "One of: disabled|optional|required",
+					"enum":        []any{"disabled", "optional", "required"},
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "list_virtual_device",
-		Description: "List all VirtualDevice resources",
-		Category:    "list",
-		Resource:    "VirtualDevice",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"priority": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "One of: medium|high|low",
+					"enum":        []any{"medium", "high", "low"},
 				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
+				"private_preshared_keys": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
 				},
-				"fields": map[string]any{
-					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
-					"items":       map[string]any{"type": "string"},
+				"private_preshared_keys_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"search": map[string]any{
-					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+				"proxy_arp": map[string]any{
+					"type": "boolean",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"radius_das_enabled": map[string]any{
+					"type": "boolean",
 				},
-			},
-		},
-	},
-	{
-		Name:        "get_virtual_device",
-		Description: "Get VirtualDevice by ID",
-		Category:    "get",
-		Resource:    "VirtualDevice",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"radius_mac_auth_enabled": map[string]any{
+					"type": "boolean",
 				},
-				"id": map[string]any{
+				"radius_macacl_empty_password": map[string]any{
+					"type": "boolean",
+				},
+				"radius_macacl_format": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "One of: none_lower|hyphen_lower|colon_lower|none_upper|hyphen_upper|colon_upper",
+					"enum":        []any{"none_lower", "hyphen_lower", "colon_lower", "none_upper", "hyphen_upper", "colon_upper"},
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"radiusprofile_id": map[string]any{
+					"type": "string",
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "create_virtual_device",
-		Description: "Create new VirtualDevice",
-		Category:    "create",
-		Resource:    "VirtualDevice",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"radiusprofile_name": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "Name of the RADIUSProfile for radiusprofile_id, in place of its ID",
 				},
-				"attr_hidden": map[string]any{
+				"roam_cluster_id": map[string]any{
+					"type":    "integer",
+					"pattern": "[0-9]|[1-2][0-9]|[3][0-1]|^$",
+				},
+				"rrm_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
+				"sae_anti_clogging": map[string]any{
+					"type": "integer",
 				},
-				"attr_no_delete": map[string]any{
-					"type": "boolean",
+				"sae_groups": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "integer"},
 				},
-				"attr_no_edit": map[string]any{
+				"sae_psk": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"sae_psk_vlan_required": map[string]any{
 					"type": "boolean",
 				},
-				"heightInMeters": map[string]any{
-					"type": "number",
+				"sae_sync": map[string]any{
+					"type": "integer",
 				},
-				"locked": map[string]any{
+				"schedule": map[string]any{
+					"type":    "array",
+					"pattern": "(sun|mon|tue|wed|thu|fri|sat)(\\-(sun|mon|tue|wed|thu|fri|sat))?\\|([0-2][0-9][0-5][0-9])\\-([0-2][0-9][0-5][0-9])",
+					"items":   map[string]any{"type": "string"},
+				},
+				"schedule_enabled": map[string]any{
 					"type": "boolean",
 				},
-				"map_id": map[string]any{
-					"type": "string",
+				"schedule_reversed": map[string]any{
+					"type": "boolean",
+				},
+				"schedule_with_duration": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+				"security": map[string]any{
+					"type":        "string",
+					"description": "One of: open|wpapsk|wep|wpaeap|osen",
+					"enum":        []any{"open", "wpapsk", "wep", "wpaeap", "osen"},
+				},
+				"setting_preference": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|manual",
+					"enum":        []any{"auto", "manual"},
 				},
 				"site_id": map[string]any{
 					"type": "string",
 				},
-				"type": map[string]any{
-					"type":        "string",
-					"description": "One of: uap|usg|usw",
-					"enum":        []any{"uap", "usg", "usw"},
+				"tdls_prohibit": map[string]any{
+					"type": "boolean",
 				},
-				"x": map[string]any{
+				"uapsd_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"uid_workspace_url": map[string]any{
 					"type": "string",
 				},
-				"y": map[string]any{
+				"usergroup_id": map[string]any{
 					"type": "string",
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"usergroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the UserGroup for usergroup_id, in place of its ID",
 				},
-			},
-		},
-	},
-	{
-		Name:        "update_virtual_device",
-		Description: "Update VirtualDevice by ID",
-		Category:    "update",
-		Resource:    "VirtualDevice",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"vlan": map[string]any{
+					"type":    "integer",
+					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|40[0-8][0-9]|409[0-5]|^$",
+				},
+				"vlan_enabled": map[string]any{
+					"type": "boolean",
+				},
+				"wep_idx": map[string]any{
+					"type":    "integer",
+					"pattern": "[1-4]",
+				},
+				"wlan_band": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "One of: 2g|5g|both",
+					"enum":        []any{"2g", "5g", "both"},
+				},
+				"wlan_bands": map[string]any{
+					"type":        "array",
+					"description": "One of: 2g|5g|6g",
+					"enum":        []any{"2g", "5g", "6g"},
+					"items":       map[string]any{"type": "string"},
+				},
+				"wlangroup_id": map[string]any{
+					"type": "string",
 				},
-				"id": map[string]any{
+				"wlangroup_name": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Name of the WLANGroup for wlangroup_id, in place of its ID",
 				},
-				"attr_hidden": map[string]any{
+				"wpa3_enhanced_192": map[string]any{
 					"type": "boolean",
 				},
-				"attr_hidden_id": map[string]any{
-					"type": "string",
-				},
-				"attr_no_delete": map[string]any{
+				"wpa3_fast_roaming": map[string]any{
 					"type": "boolean",
 				},
-				"attr_no_edit": map[string]any{
+				"wpa3_support": map[string]any{
 					"type": "boolean",
 				},
-				"heightInMeters": map[string]any{
-					"type": "number",
-				},
-				"locked": map[string]any{
+				"wpa3_transition": map[string]any{
 					"type": "boolean",
 				},
-				"map_id": map[string]any{
-					"type": "string",
-				},
-				"site_id": map[string]any{
-					"type": "string",
-				},
-				"type": map[string]any{
+				"wpa_enc": map[string]any{
 					"type":        "string",
-					"description": "One of: uap|usg|usw",
-					"enum":        []any{"uap", "usg", "usw"},
+					"description": "One of: auto|ccmp|gcmp|ccmp-256|gcmp-256",
+					"enum":        []any{"auto", "ccmp", "gcmp", "ccmp-256", "gcmp-256"},
 				},
-				"x": map[string]any{
-					"type": "string",
+				"wpa_mode": map[string]any{
+					"type":        "string",
+					"description": "One of: auto|wpa1|wpa2",
+					"enum":        []any{"auto", "wpa1", "wpa2"},
 				},
-				"y": map[string]any{
-					"type": "string",
+				"wpa_psk_radius": map[string]any{
+					"type":        "string",
+					"description": "One of: disabled|optional|required",
+					"enum":        []any{"disabled", "optional", "required"},
 				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
+				"x_iapp_key": map[string]any{
+					"type":    "string",
+					"pattern": "[0-9A-Fa-f]{32}",
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "delete_virtual_device",
-		Description: "Delete VirtualDevice by ID",
-		Category:    "delete",
-		Resource:    "VirtualDevice",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+				"x_passphrase": map[string]any{
+					"type":    "string",
+					"pattern": "[\\x20-\\x7E]{8,255}|[0-9a-fA-F]{64}",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID to delete",
+				"x_wep": map[string]any{
+					"type": "string",
 				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "list_wlan",
-		Description: "List all WLAN resources",
-		Category:    "list",
-		Resource:    "WLAN",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
+				"idempotency_key": map[string]any{
 					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
-				},
-				"filter": map[string]any{
-					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
-					"additionalProperties": true,
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
 				},
-				"fields": map[string]any{
+				"match_existing": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
 					"items":       map[string]any{"type": "string"},
 				},
-				"search": map[string]any{
-					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -12382,11 +20653,18 @@ var AllToolMetadata = []ToolMetadata{
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"ap_group_names":     "ap_group_ids",
+			"networkconf_name":   "networkconf_id",
+			"radiusprofile_name": "radiusprofile_id",
+			"usergroup_name":     "usergroup_id",
+			"wlangroup_name":     "wlangroup_id",
+		},
 	},
 	{
-		Name:        "get_wlan",
-		Description: "Get WLAN by ID",
-		Category:    "get",
+		Name:        "update_wlan",
+		Description: "Update WLAN by ID",
+		Category:    "update",
 		Resource:    "WLAN",
 		InputSchema: map[string]any{
 			"type": "object",
@@ -12397,32 +20675,17 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
-				},
-				"resolve": map[string]any{
-					"type":        "boolean",
-					"description": "Resolve ID references to human-readable names (default: true)",
-				},
-			},
-			"required": []any{"id"},
-		},
-	},
-	{
-		Name:        "create_wlan",
-		Description: "Create new WLAN",
-		Category:    "create",
-		Resource:    "WLAN",
-		InputSchema: map[string]any{
-			"type": "object",
-			"properties": map[string]any{
-				"site": map[string]any{
-					"type":        "string",
-					"description": "UniFi site name (default: 'default')",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"ap_group_ids": map[string]any{
 					"type":  "array",
 					"items": map[string]any{"type": "string"},
 				},
+				"ap_group_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the APGroup objects for ap_group_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
 				"ap_group_mode": map[string]any{
 					"type":        "string",
 					"description": "One of: all|groups|devices",
@@ -12586,6 +20849,10 @@ var AllToolMetadata = []ToolMetadata{
 				"networkconf_id": map[string]any{
 					"type": "string",
 				},
+				"networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for networkconf_id, in place of its ID",
+				},
 				"no2ghz_oui": map[string]any{
 					"type": "boolean",
 				},
@@ -12640,6 +20907,10 @@ var AllToolMetadata = []ToolMetadata{
 				"radiusprofile_id": map[string]any{
 					"type": "string",
 				},
+				"radiusprofile_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the RADIUSProfile for radiusprofile_id, in place of its ID",
+				},
 				"roam_cluster_id": map[string]any{
 					"type":    "integer",
 					"pattern": "[0-9]|[1-2][0-9]|[3][0-1]|^$",
@@ -12704,6 +20975,10 @@ var AllToolMetadata = []ToolMetadata{
 				"usergroup_id": map[string]any{
 					"type": "string",
 				},
+				"usergroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the UserGroup for usergroup_id, in place of its ID",
+				},
 				"vlan": map[string]any{
 					"type":    "integer",
 					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|40[0-8][0-9]|409[0-5]|^$",
@@ -12729,6 +21004,10 @@ var AllToolMetadata = []ToolMetadata{
 				"wlangroup_id": map[string]any{
 					"type": "string",
 				},
+				"wlangroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the WLANGroup for wlangroup_id, in place of its ID",
+				},
 				"wpa3_enhanced_192": map[string]any{
 					"type": "boolean",
 				},
@@ -12767,17 +21046,28 @@ var AllToolMetadata = []ToolMetadata{
 				"x_wep": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
 		},
+		NameInputs: map[string]string{
+			"ap_group_names":     "ap_group_ids",
+			"networkconf_name":   "networkconf_id",
+			"radiusprofile_name": "radiusprofile_id",
+			"usergroup_name":     "usergroup_id",
+			"wlangroup_name":     "wlangroup_id",
+		},
 	},
 	{
-		Name:        "update_wlan",
-		Description: "Update WLAN by ID",
-		Category:    "update",
+		Name:        "upsert_wlan",
+		Description: "Create or update WLAN matched by natural key fields",
+		Category:    "upsert",
 		Resource:    "WLAN",
 		InputSchema: map[string]any{
 			"type": "object",
@@ -12786,14 +21076,20 @@ var AllToolMetadata = []ToolMetadata{
 					"type":        "string",
 					"description": "UniFi site name (default: 'default')",
 				},
-				"id": map[string]any{
-					"type":        "string",
-					"description": "Resource ID",
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing WLAN (default: [\"name\"]). One match is updated, none creates a new WLAN, several is an error",
+					"items":       map[string]any{"type": "string"},
 				},
 				"ap_group_ids": map[string]any{
 					"type":  "array",
 					"items": map[string]any{"type": "string"},
 				},
+				"ap_group_names": map[string]any{
+					"type":        "array",
+					"description": "Names of the APGroup objects for ap_group_ids, in place of their IDs",
+					"items":       map[string]any{"type": "string"},
+				},
 				"ap_group_mode": map[string]any{
 					"type":        "string",
 					"description": "One of: all|groups|devices",
@@ -12957,6 +21253,10 @@ var AllToolMetadata = []ToolMetadata{
 				"networkconf_id": map[string]any{
 					"type": "string",
 				},
+				"networkconf_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the Network for networkconf_id, in place of its ID",
+				},
 				"no2ghz_oui": map[string]any{
 					"type": "boolean",
 				},
@@ -13011,6 +21311,10 @@ var AllToolMetadata = []ToolMetadata{
 				"radiusprofile_id": map[string]any{
 					"type": "string",
 				},
+				"radiusprofile_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the RADIUSProfile for radiusprofile_id, in place of its ID",
+				},
 				"roam_cluster_id": map[string]any{
 					"type":    "integer",
 					"pattern": "[0-9]|[1-2][0-9]|[3][0-1]|^$",
@@ -13075,6 +21379,10 @@ var AllToolMetadata = []ToolMetadata{
 				"usergroup_id": map[string]any{
 					"type": "string",
 				},
+				"usergroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the UserGroup for usergroup_id, in place of its ID",
+				},
 				"vlan": map[string]any{
 					"type":    "integer",
 					"pattern": "[2-9]|[1-9][0-9]{1,2}|[1-3][0-9]{3}|40[0-8][0-9]|409[0-5]|^$",
@@ -13100,6 +21408,10 @@ var AllToolMetadata = []ToolMetadata{
 				"wlangroup_id": map[string]any{
 					"type": "string",
 				},
+				"wlangroup_name": map[string]any{
+					"type":        "string",
+					"description": "Name of the WLANGroup for wlangroup_id, in place of its ID",
+				},
 				"wpa3_enhanced_192": map[string]any{
 					"type": "boolean",
 				},
@@ -13138,12 +21450,22 @@ var AllToolMetadata = []ToolMetadata{
 				"x_wep": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
+		},
+		NameInputs: map[string]string{
+			"ap_group_names":     "ap_group_ids",
+			"networkconf_name":   "networkconf_id",
+			"radiusprofile_name": "radiusprofile_id",
+			"usergroup_name":     "usergroup_id",
+			"wlangroup_name":     "wlangroup_id",
 		},
 	},
 	{
@@ -13160,10 +21482,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the WLAN by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -13180,17 +21505,74 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"filter": map[string]any{
 					"type":                 "object",
-					"description":          "Filter by field values. Exact match: {\"field\": \"value\"}, substring: {\"field\": {\"contains\": \"substr\"}}, regex: {\"field\": {\"regex\": \"pattern\"}}",
+					"description":          "Filter by field values; all top-level conditions must match. Fields may be nested paths such as \"uplink.speed\" or \"port_table[*].poe_mode\"; a condition on several values (array elements) matches if any value does. Exact match: {\"field\": \"value\"}, or an operator object: contains (case-insensitive substring), regex, eq, ne, gt/gte/lt/lte (numbers, timestamps such as \"2024-01-31\", or versions), in/not_in (array of values), exists (true/false), not (negates a condition), in_cidr/overlaps_cidr (CIDR or array of CIDRs) and contains_ip (address) on IPv4/IPv6 addresses, CIDRs and ranges such as \"10.0.0.1-10.0.0.9\". Example: {\"rx_bytes\": {\"gt\": 1e9}, \"vlan\": {\"in\": [10, 20]}, \"name\": {\"exists\": false}}. Group with {\"$or\": [filter, ...]} (any matches) and {\"$and\": [filter, ...]} (all match), which nest, e.g. {\"$or\": [{\"is_guest\": true}, {\"hide_ssid\": true}]}",
 					"additionalProperties": true,
 				},
 				"fields": map[string]any{
 					"type":        "array",
-					"description": "Field names to include in results. Omit for all fields.",
+					"description": "Field names to include in results. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value.",
 					"items":       map[string]any{"type": "string"},
 				},
 				"search": map[string]any{
 					"type":        "string",
-					"description": "Case-insensitive text search across top-level string field values",
+					"description": "Case-insensitive text search across string field values, including nested ones",
+				},
+				"sort": map[string]any{
+					"type":        "array",
+					"description": "Fields or nested paths to sort by, in order of precedence. Prefix a field with - for descending order, e.g. [\"-rx_bytes\", \"name\"]. Numbers, and digit runs in strings such as IPs, compare numerically.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"limit": map[string]any{
+					"type":        "integer",
+					"description": "Maximum number of items to return. With limit or offset, the response reports total, returned and next_offset (null on the last page) alongside the items in data.",
+					"minimum":     1,
+				},
+				"offset": map[string]any{
+					"type":        "integer",
+					"description": "Number of matching items to skip before returning results (default: 0). Use next_offset from the previous page.",
+					"minimum":     0,
+				},
+				"group_by": map[string]any{
+					"type":        "array",
+					"description": "Fields to group the matching items by. Returns one row per group instead of the items, with the group values, count and any aggregate values, largest groups first; sort, limit, offset and fields then apply to the rows. Example: [\"network_id\"] counts clients per network.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"aggregate": map[string]any{
+					"type":        "object",
+					"description": "Numeric aggregates per group (or over all matching items without group_by), mapping sum, min, max or avg to a field or array of fields. Each adds a <op>_<field> value to the rows, e.g. {\"sum\": [\"rx_bytes\"]} adds sum_rx_bytes.",
+					"additionalProperties": map[string]any{
+						"anyOf": []any{
+							map[string]any{"type": "string"},
+							map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+						},
+					},
+				},
+				"facets": map[string]any{
+					"type":        "array",
+					"description": "Fields to count the distinct values of among the matching items. Returns {field: [{field: value, count: n}, ...]} instead of the items, most frequent values first. Cannot be combined with group_by, aggregate, sort, limit, offset or fields.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after filtering and ID resolution, e.g. [.[] | select(.purpose == \"corporate\") | {name, vlan}]. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"max_tokens": map[string]any{
+					"type":        "integer",
+					"minimum":     0,
+					"description": "Approximate token budget (default: the server's, normally 25000; 0 for none). Longer results return the whole items that fit plus a 'truncated' summary of what was omitted and how to narrow the query.",
+				},
+				"store_result": map[string]any{
+					"type":        "boolean",
+					"description": "Keep the full result on the server and return a result_id with the item count, field names and a short preview instead of the items. Query it with the query_result tool, which takes the same filter, sort, fields, paging and aggregation arguments, without calling the controller again. Stored results are kept per session, least recently used evicted first.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
@@ -13213,14 +21595,35 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the WLANGroup by name instead of id",
+				},
+				"fields": map[string]any{
+					"type":        "array",
+					"description": "Field names to include in the result. Omit for all fields. Nested paths such as \"port_table[*].poe_mode\" keep the structure leading to the value; an unknown top-level field is an error listing the valid ones.",
+					"items":       map[string]any{"type": "string"},
+				},
+				"expr": map[string]any{
+					"type":        "string",
+					"description": "jq expression applied to the result after fields projection and ID resolution, e.g. {name, vlan, subnet: .ip_subnet}. Several outputs are returned as an array. Evaluation is limited to 2s.",
+				},
+				"format": map[string]any{
+					"type":        "string",
+					"enum":        []any{"json", "json_compact", "yaml", "csv", "markdown"},
+					"description": "Output format (default: the server's, normally json). csv and markdown render lists as tables, flattening nested objects into dotted columns.",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
 				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -13254,6 +21657,19 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"idempotency_key": map[string]any{
+					"type":        "string",
+					"description": "Client-chosen key. Repeating a create with the same key and arguments returns the original result instead of creating a duplicate",
+				},
+				"match_existing": map[string]any{
+					"type":        "array",
+					"description": "Field names forming a natural key, e.g. [\"name\"]. If an existing object has the same values, it is returned instead of creating a duplicate",
+					"items":       map[string]any{"type": "string"},
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
@@ -13275,7 +21691,7 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID",
+					"description": "Resource ID (omit to select by name)",
 				},
 				"attr_hidden": map[string]any{
 					"type": "boolean",
@@ -13296,12 +21712,62 @@ var AllToolMetadata = []ToolMetadata{
 				"site_id": map[string]any{
 					"type": "string",
 				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
+				"resolve": map[string]any{
+					"type":        "boolean",
+					"description": "Resolve ID references to human-readable names (default: true)",
+				},
+			},
+		},
+	},
+	{
+		Name:        "upsert_wlan_group",
+		Description: "Create or update WLANGroup matched by natural key fields",
+		Category:    "upsert",
+		Resource:    "WLANGroup",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"site": map[string]any{
+					"type":        "string",
+					"description": "UniFi site name (default: 'default')",
+				},
+				"match_on": map[string]any{
+					"type":        "array",
+					"description": "Field names identifying an existing WLANGroup (default: [\"name\"]). One match is updated, none creates a new WLANGroup, several is an error",
+					"items":       map[string]any{"type": "string"},
+				},
+				"attr_hidden": map[string]any{
+					"type": "boolean",
+				},
+				"attr_hidden_id": map[string]any{
+					"type": "string",
+				},
+				"attr_no_delete": map[string]any{
+					"type": "boolean",
+				},
+				"attr_no_edit": map[string]any{
+					"type": "boolean",
+				},
+				"name": map[string]any{
+					"type":    "string",
+					"pattern": ".{1,128}",
+				},
+				"site_id": map[string]any{
+					"type": "string",
+				},
+				"humanize": map[string]any{
+					"type":        "boolean",
+					"description": "Add readable siblings to raw values (default: the server's, normally false): <field>_at with RFC 3339 times for epoch timestamps such as last_seen, and <field>_human for uptimes, byte counts and rates, and device state codes. Raw values are kept.",
+				},
 				"resolve": map[string]any{
 					"type":        "boolean",
 					"description": "Resolve ID references to human-readable names (default: true)",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 	{
@@ -13318,10 +21784,13 @@ var AllToolMetadata = []ToolMetadata{
 				},
 				"id": map[string]any{
 					"type":        "string",
-					"description": "Resource ID to delete",
+					"description": "Resource ID to delete (omit to select by name)",
+				},
+				"name": map[string]any{
+					"type":        "string",
+					"description": "Select the WLANGroup by name instead of id",
 				},
 			},
-			"required": []any{"id"},
 		},
 	},
 }
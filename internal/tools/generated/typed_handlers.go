@@ -0,0 +1,132 @@
+package generated
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// The Typed handlers take a client and method expressions of its type, e.g.
+// TypedList(client, unifi.Client.ListNetwork), so the generated handler
+// registry is checked against the go-unifi client at compile time and calls
+// it without reflection. Methods are bound to the client when a tool is
+// called, so handlers can be built for a nil client, as registration tests
+// do. The Typed handlers share their request handling with the Generic
+// handlers, which remain as the reflection-based fallback for clients that do
+// not implement unifi.Client.
+
+// TypedList creates a handler that calls list(client, ctx, site).
+func TypedList[C, T any](client C, list func(C, context.Context, string) ([]T, error)) server.ToolHandlerFunc {
+	return listHandler(typedOps[C, T]{client: client, list: list}.ops())
+}
+
+// TypedGet creates a handler that calls get(client, ctx, site, id).
+func TypedGet[C, T any](client C, get func(C, context.Context, string, string) (*T, error)) server.ToolHandlerFunc {
+	return getHandler(typedOps[C, T]{client: client, get: get}.ops(), false)
+}
+
+// TypedGetSetting creates a handler that calls get(client, ctx, site) for a
+// settings resource, which has no ID.
+func TypedGetSetting[C, T any](client C, get func(C, context.Context, string) (*T, error)) server.ToolHandlerFunc {
+	return getHandler(typedOps[C, T]{client: client, get: settingGet(get)}.ops(), true)
+}
+
+// TypedCreate creates a handler that calls create(client, ctx, site, &input).
+// list is used for match_existing and may be nil when the resource cannot be
+// listed.
+func TypedCreate[C, T any](
+	client C,
+	create func(C, context.Context, string, *T) (*T, error),
+	list func(C, context.Context, string) ([]T, error),
+) server.ToolHandlerFunc {
+	return createHandler(typedOps[C, T]{client: client, list: list, create: create}.ops())
+}
+
+// TypedUpdate creates a handler that merges the arguments into the object
+// returned by get and calls update(client, ctx, site, &input).
+func TypedUpdate[C, T any](
+	client C,
+	get func(C, context.Context, string, string) (*T, error),
+	update func(C, context.Context, string, *T) (*T, error),
+) server.ToolHandlerFunc {
+	return updateHandler(typedOps[C, T]{client: client, get: get, update: update}.ops(), false)
+}
+
+// TypedUpdateSetting is TypedUpdate for a settings resource, which has no ID.
+func TypedUpdateSetting[C, T any](
+	client C,
+	get func(C, context.Context, string) (*T, error),
+	update func(C, context.Context, string, *T) (*T, error),
+) server.ToolHandlerFunc {
+	return updateHandler(typedOps[C, T]{client: client, get: settingGet(get), update: update}.ops(), true)
+}
+
+// TypedUpsert creates a handler that finds an object by its match_on fields
+// through list and updates it, or creates it when nothing matches.
+func TypedUpsert[C, T any](
+	client C,
+	list func(C, context.Context, string) ([]T, error),
+	get func(C, context.Context, string, string) (*T, error),
+	create func(C, context.Context, string, *T) (*T, error),
+	update func(C, context.Context, string, *T) (*T, error),
+) server.ToolHandlerFunc {
+	return upsertHandler(typedOps[C, T]{client: client, list: list, get: get, create: create, update: update}.ops())
+}
+
+// TypedDelete creates a handler that calls del(client, ctx, site, id).
+func TypedDelete[C any](client C, del func(C, context.Context, string, string) error) server.ToolHandlerFunc {
+	return deleteHandler(resourceOps{delete: func(ctx context.Context, site, id string) error {
+		return del(client, ctx, site, id)
+	}})
+}
+
+// typedOps holds a client and the methods of one resource type T.
+type typedOps[C, T any] struct {
+	client C
+	list   func(C, context.Context, string) ([]T, error)
+	get    func(C, context.Context, string, string) (*T, error)
+	create func(C, context.Context, string, *T) (*T, error)
+	update func(C, context.Context, string, *T) (*T, error)
+}
+
+// settingGet adapts a settings getter to the (ctx, site, id) form.
+func settingGet[C, T any](get func(C, context.Context, string) (*T, error)) func(C, context.Context, string, string) (*T, error) {
+	return func(client C, ctx context.Context, site, _ string) (*T, error) {
+		return get(client, ctx, site)
+	}
+}
+
+// ops binds the typed methods to the client as resourceOps. The resource
+// name, used in error messages, is the name of T.
+func (t typedOps[C, T]) ops() resourceOps {
+	ops := resourceOps{
+		resource: reflect.TypeFor[T]().Name(),
+		newType:  func() any { return new(T) },
+	}
+	if t.list != nil {
+		ops.list = func(ctx context.Context, site string) (any, error) {
+			return t.list(t.client, ctx, site)
+		}
+	}
+	if t.get != nil {
+		ops.get = func(ctx context.Context, site, id string) (any, error) {
+			result, err := t.get(t.client, ctx, site, id)
+			if err != nil || result == nil {
+				return nil, err
+			}
+			return result, nil
+		}
+	}
+	if t.create != nil {
+		ops.create = func(ctx context.Context, site string, input any) (any, error) {
+			return t.create(t.client, ctx, site, input.(*T))
+		}
+	}
+	if t.update != nil {
+		ops.update = func(ctx context.Context, site string, input any) (any, error) {
+			return t.update(t.client, ctx, site, input.(*T))
+		}
+	}
+	return ops
+}
@@ -0,0 +1,250 @@
+package generated
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Widget struct {
+	ID      string `json:"_id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+// widgetClient has typed methods in the shape of the go-unifi client.
+type widgetClient struct {
+	widgets []Widget
+	updated *Widget
+	deleted string
+	err     error
+}
+
+func (c *widgetClient) ListWidget(_ context.Context, _ string) ([]Widget, error) {
+	return c.widgets, c.err
+}
+
+func (c *widgetClient) GetWidget(_ context.Context, _, id string) (*Widget, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	for _, w := range c.widgets {
+		if w.ID == id {
+			return &w, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (c *widgetClient) GetWidgetSetting(_ context.Context, _ string) (*Widget, error) {
+	return &Widget{Name: "setting", Enabled: true}, c.err
+}
+
+func (c *widgetClient) CreateWidget(_ context.Context, _ string, d *Widget) (*Widget, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	created := *d
+	created.ID = "new"
+	return &created, nil
+}
+
+func (c *widgetClient) UpdateWidget(_ context.Context, _ string, d *Widget) (*Widget, error) {
+	c.updated = d
+	return d, c.err
+}
+
+func (c *widgetClient) DeleteWidget(_ context.Context, _, id string) error {
+	c.deleted = id
+	return c.err
+}
+
+func newWidgetClient() *widgetClient {
+	return &widgetClient{widgets: []Widget{
+		{ID: "w1", Name: "alpha", Enabled: true},
+		{ID: "w2", Name: "beta"},
+	}}
+}
+
+func callTool(t *testing.T, handler server.ToolHandlerFunc, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	return result
+}
+
+func resultText(result *mcp.CallToolResult) string {
+	return result.Content[0].(mcp.TextContent).Text
+}
+
+func TestTypedList(t *testing.T) {
+	client := newWidgetClient()
+	handler := TypedList(client, (*widgetClient).ListWidget)
+
+	result := callTool(t, handler, map[string]any{})
+	require.False(t, result.IsError)
+	assert.Contains(t, resultText(result), `"name": "alpha"`)
+
+	result = callTool(t, handler, map[string]any{"filter": map[string]any{"name": "beta"}})
+	assert.NotContains(t, resultText(result), "alpha")
+	assert.Contains(t, resultText(result), "beta")
+
+	client.err = errors.New("list error")
+	result = callTool(t, handler, map[string]any{})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "list error", resultText(result))
+
+	// Invalid queries are rejected before calling the controller
+	result = callTool(t, handler, map[string]any{"limit": float64(-1)})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "invalid limit: must be a non-negative integer, got -1", resultText(result))
+
+	result = callTool(t, handler, map[string]any{"filter": map[string]any{"name": map[string]any{"regex": "[unclosed"}}})
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), `invalid filter for field "name": invalid regex`)
+
+	result = callTool(t, handler, map[string]any{"fields": []any{"name["}})
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "invalid fields: invalid path")
+}
+
+func TestTypedGet(t *testing.T) {
+	handler := TypedGet(newWidgetClient(), (*widgetClient).GetWidget)
+
+	result := callTool(t, handler, map[string]any{"id": "w2"})
+	require.False(t, result.IsError)
+	assert.Contains(t, resultText(result), `"name": "beta"`)
+
+	result = callTool(t, handler, map[string]any{})
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "'id' is missing")
+
+	setting := TypedGetSetting(newWidgetClient(), (*widgetClient).GetWidgetSetting)
+	result = callTool(t, setting, map[string]any{})
+	require.False(t, result.IsError)
+	assert.Contains(t, resultText(result), `"name": "setting"`)
+}
+
+func TestTypedGet_Fields(t *testing.T) {
+	handler := TypedGet(newWidgetClient(), (*widgetClient).GetWidget)
+
+	result := callTool(t, handler, map[string]any{"id": "w1", "fields": []any{"name"}})
+	require.False(t, result.IsError)
+	assert.Equal(t, "{\n  \"name\": \"alpha\"\n}", resultText(result))
+
+	// Fields of the type that the result omits when empty are valid
+	result = callTool(t, handler, map[string]any{"id": "w1", "fields": []any{"_id", "name", "enabled"}})
+	require.False(t, result.IsError)
+	assert.Contains(t, resultText(result), `"enabled": true`)
+
+	result = callTool(t, handler, map[string]any{"id": "w1", "fields": []any{"nmae"}})
+	assert.True(t, result.IsError)
+	assert.Equal(t, `invalid fields: unknown field "nmae" (valid fields: _id, enabled, name)`, resultText(result))
+
+	// Invalid fields are rejected before calling the controller
+	client := newWidgetClient()
+	client.err = errors.New("get error")
+	failing := TypedGet(client, (*widgetClient).GetWidget)
+	result = callTool(t, failing, map[string]any{"id": "w1", "fields": []any{"nmae"}})
+	assert.True(t, result.IsError)
+	assert.Equal(t, `invalid fields: unknown field "nmae" (valid fields: _id, enabled, name)`, resultText(result))
+	result = callTool(t, failing, map[string]any{"id": "w1", "fields": []any{"name["}})
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "invalid fields: invalid path")
+
+	setting := TypedGetSetting(newWidgetClient(), (*widgetClient).GetWidgetSetting)
+	result = callTool(t, setting, map[string]any{"fields": []any{"enabled"}})
+	require.False(t, result.IsError)
+	assert.Equal(t, "{\n  \"enabled\": true\n}", resultText(result))
+}
+
+func TestTypedCreate(t *testing.T) {
+	client := newWidgetClient()
+	handler := TypedCreate(client, (*widgetClient).CreateWidget, (*widgetClient).ListWidget)
+
+	result := callTool(t, handler, map[string]any{"name": "gamma"})
+	require.False(t, result.IsError)
+	assert.Contains(t, resultText(result), `"_id": "new"`)
+
+	result = callTool(t, handler, map[string]any{"name": "alpha", "match_existing": []any{"name"}})
+	require.False(t, result.IsError)
+	assert.Contains(t, resultText(result), `"_id": "w1"`)
+
+	result = callTool(t, handler, map[string]any{"nope": true})
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "unexpected parameters: nope")
+
+	// Without a list method, match_existing cannot be used.
+	noList := TypedCreate(client, (*widgetClient).CreateWidget, nil)
+	result = callTool(t, noList, map[string]any{"name": "alpha", "match_existing": []any{"name"}})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "missing client method: ListWidget (required for matching existing objects)", resultText(result))
+}
+
+func TestTypedUpdate(t *testing.T) {
+	client := newWidgetClient()
+	handler := TypedUpdate(client, (*widgetClient).GetWidget, (*widgetClient).UpdateWidget)
+
+	result := callTool(t, handler, map[string]any{"id": "w1", "name": "renamed"})
+	require.False(t, result.IsError)
+	require.NotNil(t, client.updated)
+	assert.Equal(t, Widget{ID: "w1", Name: "renamed", Enabled: true}, *client.updated)
+
+	result = callTool(t, handler, map[string]any{"id": "missing", "name": "x"})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "not found", resultText(result))
+
+	setting := TypedUpdateSetting(client, (*widgetClient).GetWidgetSetting, (*widgetClient).UpdateWidget)
+	result = callTool(t, setting, map[string]any{"enabled": false})
+	require.False(t, result.IsError)
+	assert.Equal(t, Widget{Name: "setting"}, *client.updated)
+}
+
+func TestTypedUpsert(t *testing.T) {
+	client := newWidgetClient()
+	handler := TypedUpsert(client, (*widgetClient).ListWidget, (*widgetClient).GetWidget,
+		(*widgetClient).CreateWidget, (*widgetClient).UpdateWidget)
+
+	result := callTool(t, handler, map[string]any{"name": "beta", "enabled": true})
+	require.False(t, result.IsError)
+	require.NotNil(t, client.updated)
+	assert.Equal(t, "w2", client.updated.ID)
+
+	client.updated = nil
+	result = callTool(t, handler, map[string]any{"name": "delta"})
+	require.False(t, result.IsError)
+	assert.Nil(t, client.updated)
+	assert.Contains(t, resultText(result), `"_id": "new"`)
+}
+
+func TestTypedDelete(t *testing.T) {
+	client := newWidgetClient()
+	handler := TypedDelete(client, (*widgetClient).DeleteWidget)
+
+	result := callTool(t, handler, map[string]any{"id": "w1"})
+	require.False(t, result.IsError)
+	assert.Equal(t, "w1", client.deleted)
+
+	client.err = errors.New("delete error")
+	result = callTool(t, handler, map[string]any{"id": "w1"})
+	assert.True(t, result.IsError)
+	assert.Equal(t, "delete error", resultText(result))
+}
+
+func TestTypedHandlers_NilClient(t *testing.T) {
+	// Building handlers must not touch the client; registration does this
+	// with a nil client.
+	var client *widgetClient
+	assert.NotPanics(t, func() {
+		TypedList(client, (*widgetClient).ListWidget)
+		TypedDelete(client, (*widgetClient).DeleteWidget)
+	})
+}
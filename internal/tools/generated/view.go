@@ -0,0 +1,56 @@
+package generated
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/claytono/go-unifi-mcp/internal/query"
+)
+
+// Views of list and get results, selected with the "view" argument.
+const (
+	ViewSummary = "summary" // the resource's SummaryFields
+	ViewFull    = "full"    // all fields, the default
+)
+
+// summaryFields indexes the fields of the summary view by resource.
+var summaryFields = sync.OnceValue(func() map[string][]string {
+	index := make(map[string][]string)
+	for _, meta := range AllToolMetadata {
+		if len(meta.SummaryFields) > 0 {
+			index[meta.Resource] = meta.SummaryFields
+		}
+	}
+	return index
+})
+
+// viewFields returns the fields selected by the "view" argument of a call on
+// resource, or nil for all fields.
+func viewFields(resource string, args map[string]any) ([]string, error) {
+	arg, ok := args["view"]
+	if !ok {
+		return nil, nil
+	}
+	switch arg {
+	case ViewFull:
+		return nil, nil
+	case ViewSummary:
+		fields := summaryFields()[resource]
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("view %q is not available for %s", ViewSummary, resource)
+		}
+		return fields, nil
+	}
+	return nil, fmt.Errorf("invalid view %q (supported views: %s, %s)", fmt.Sprint(arg), ViewSummary, ViewFull)
+}
+
+// applyView projects list results onto the fields of the view when the call
+// selects no fields itself. Grouped rows and facets are left alone.
+func applyView(resource string, args map[string]any, opts *query.Options) error {
+	fields, err := viewFields(resource, args)
+	if err != nil || len(opts.Fields) > 0 || opts.Grouped() || len(opts.Facets) > 0 {
+		return err
+	}
+	opts.Fields = fields
+	return nil
+}
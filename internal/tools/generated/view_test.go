@@ -0,0 +1,102 @@
+package generated
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/filipowm/go-unifi/unifi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummaryFields(t *testing.T) {
+	index := summaryFields()
+	for _, resource := range []string{"Device", "Network", "User", "WLAN"} {
+		assert.Contains(t, index, resource)
+	}
+
+	for _, meta := range AllToolMetadata {
+		props, _ := meta.InputSchema["properties"].(map[string]any)
+		if len(meta.SummaryFields) == 0 {
+			assert.NotContains(t, props, "view", meta.Name)
+			continue
+		}
+		assert.Contains(t, props, "view", meta.Name)
+		assert.Equal(t, "_id", meta.SummaryFields[0], meta.Name)
+		assert.Equal(t, index[meta.Resource], meta.SummaryFields, "list and get views of %s differ", meta.Resource)
+
+		// Every summary field is a field of the resource type. Read-only
+		// resources have no input type.
+		newType, ok := TypeRegistry[meta.Resource]
+		if !ok {
+			newType, ok = readOnlyTypes[meta.Resource]
+		}
+		require.True(t, ok, meta.Resource)
+		keys := allowedFieldKeys(newType())
+		for _, field := range meta.SummaryFields {
+			top, _, _ := strings.Cut(field, ".")
+			assert.Contains(t, keys, top, "%s summary field", meta.Resource)
+		}
+	}
+}
+
+var readOnlyTypes = map[string]func() any{
+	"Device": func() any { return new(unifi.Device) },
+}
+
+// withWidgetSummary gives the test Widget resource a summary view.
+func withWidgetSummary(t *testing.T) {
+	t.Helper()
+	orig := summaryFields
+	summaryFields = func() map[string][]string {
+		return map[string][]string{"Widget": {"_id", "name"}}
+	}
+	t.Cleanup(func() { summaryFields = orig })
+}
+
+func TestTypedList_View(t *testing.T) {
+	withWidgetSummary(t)
+	handler := TypedList(newWidgetClient(), (*widgetClient).ListWidget)
+
+	result := callTool(t, handler, map[string]any{"view": "summary"})
+	require.False(t, result.IsError)
+	assert.JSONEq(t, `[{"_id": "w1", "name": "alpha"}, {"_id": "w2", "name": "beta"}]`, resultText(result))
+
+	// Filters apply to all fields, explicit fields take precedence
+	result = callTool(t, handler, map[string]any{"view": "summary", "filter": map[string]any{"enabled": true}, "fields": []any{"enabled"}})
+	require.False(t, result.IsError)
+	assert.JSONEq(t, `[{"enabled": true}]`, resultText(result))
+
+	// Grouped rows keep their own columns
+	result = callTool(t, handler, map[string]any{"view": "summary", "group_by": []any{"enabled"}})
+	require.False(t, result.IsError)
+	var rows []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(resultText(result)), &rows))
+	assert.ElementsMatch(t, []map[string]any{{"enabled": true, "count": float64(1)}, {"enabled": false, "count": float64(1)}}, rows)
+
+	result = callTool(t, handler, map[string]any{"view": "full"})
+	require.False(t, result.IsError)
+	assert.Contains(t, resultText(result), `"enabled": true`)
+
+	result = callTool(t, handler, map[string]any{"view": "brief"})
+	assert.True(t, result.IsError)
+	assert.Equal(t, `invalid view "brief" (supported views: summary, full)`, resultText(result))
+}
+
+func TestTypedGet_View(t *testing.T) {
+	handler := TypedGet(newWidgetClient(), (*widgetClient).GetWidget)
+
+	result := callTool(t, handler, map[string]any{"id": "w1", "view": "summary"})
+	assert.True(t, result.IsError)
+	assert.Equal(t, `view "summary" is not available for Widget`, resultText(result))
+
+	withWidgetSummary(t)
+	result = callTool(t, handler, map[string]any{"id": "w1", "view": "summary"})
+	require.False(t, result.IsError)
+	assert.JSONEq(t, `{"_id": "w1", "name": "alpha"}`, resultText(result))
+
+	result = callTool(t, handler, map[string]any{"id": "w1", "view": "summary", "fields": []any{"enabled"}})
+	require.False(t, result.IsError)
+	assert.JSONEq(t, `{"enabled": true}`, resultText(result))
+}
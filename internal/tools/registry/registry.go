@@ -4,8 +4,18 @@ package registry
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 
+	"github.com/claytono/go-unifi-mcp/internal/annotate"
+	"github.com/claytono/go-unifi-mcp/internal/budget"
+	"github.com/claytono/go-unifi-mcp/internal/expr"
+	"github.com/claytono/go-unifi-mcp/internal/humanize"
+	"github.com/claytono/go-unifi-mcp/internal/idempotency"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
 	"github.com/claytono/go-unifi-mcp/internal/resolve"
+	"github.com/claytono/go-unifi-mcp/internal/results"
+	"github.com/claytono/go-unifi-mcp/internal/stale"
 	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
 	"github.com/filipowm/go-unifi/unifi"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -18,15 +28,26 @@ type ValidatorFunc func(client any, tools []generated.ToolMetadata, typeRegistry
 // defaultValidator is the production validator.
 var defaultValidator ValidatorFunc = generated.ValidateClientMethods
 
+// Middleware holds the shared state used to wrap tool handlers. A nil
+// *Middleware applies only the stateless middleware.
+type Middleware struct {
+	Resolver    *resolve.Resolver   // ID reference resolution
+	Idempotency *idempotency.Store  // idempotency keys for create tools
+	Format      payload.Format      // default output format of list and get tools
+	MaxTokens   int                 // default token budget of list tools, 0 for none
+	Results     *results.Store      // stored list results, nil when disabled
+	Humanizer   *humanize.Humanizer // readable siblings of raw values
+}
+
 // RegisterAllTools registers all generated UniFi MCP tools with the server.
 // It builds tools dynamically from the metadata and maps each to its
 // corresponding handler from the handler registry.
-func RegisterAllTools(s *server.MCPServer, client unifi.Client, resolver *resolve.Resolver) error {
-	return registerAllToolsWithValidator(s, client, resolver, defaultValidator)
+func RegisterAllTools(s *server.MCPServer, client unifi.Client, mw *Middleware) error {
+	return registerAllToolsWithValidator(s, client, mw, defaultValidator)
 }
 
 // registerAllToolsWithValidator is the internal implementation that allows testing with custom validators.
-func registerAllToolsWithValidator(s *server.MCPServer, client unifi.Client, resolver *resolve.Resolver, validator ValidatorFunc) error {
+func registerAllToolsWithValidator(s *server.MCPServer, client unifi.Client, mw *Middleware, validator ValidatorFunc) error {
 	// Validate all client methods exist with correct signatures before registration.
 	// Skip validation for nil client (used only in tests).
 	if client != nil {
@@ -34,11 +55,11 @@ func registerAllToolsWithValidator(s *server.MCPServer, client unifi.Client, res
 			return fmt.Errorf("client validation failed: %w", err)
 		}
 	}
-	return registerTools(s, client, resolver, generated.AllToolMetadata, generated.GetHandlerRegistry())
+	return registerTools(s, client, mw, generated.AllToolMetadata, generated.GetHandlerRegistry())
 }
 
 // registerTools is the internal implementation that allows testing with custom metadata.
-func registerTools(s *server.MCPServer, client unifi.Client, resolver *resolve.Resolver, tools []generated.ToolMetadata, handlers map[string]generated.HandlerFunc) error {
+func registerTools(s *server.MCPServer, client unifi.Client, mw *Middleware, tools []generated.ToolMetadata, handlers map[string]generated.HandlerFunc) error {
 	for _, meta := range tools {
 		tool, err := buildToolFromMetadata(meta)
 		if err != nil {
@@ -50,16 +71,117 @@ func registerTools(s *server.MCPServer, client unifi.Client, resolver *resolve.R
 			return fmt.Errorf("no handler for tool %s", meta.Name)
 		}
 
-		handler := handlerFactory(client)
-		if meta.Category != "delete" {
-			handler = resolve.WrapHandler(handler, resolver)
-		}
-		s.AddTool(tool, handler)
+		s.AddTool(tool, mw.Wrap(handlerFactory(client), meta.Name))
 	}
 
 	return nil
 }
 
+// Wrap applies the standard middleware for the named tool: ID resolution for
+// everything except deletes, name/mac selectors for get, update and delete,
+// names in place of ID references for create, update and upsert, expr
+// evaluation and last-known-good fallback for reads, stored results and
+// token budgets for lists, idempotency keys for
+// creates, and result annotations. The output is marshalled to text once, after all of them,
+// in the format requested by list and get calls or m.Format.
+func (m *Middleware) Wrap(handler server.ToolHandlerFunc, toolName string) server.ToolHandlerFunc {
+	return payload.RenderFormat(m.WrapStructured(handler, toolName), m.format(CategoryForTool(toolName)))
+}
+
+// format returns the default output format of tools in category.
+func (m *Middleware) format(category string) payload.Format {
+	switch category {
+	case "list", "get":
+		if m != nil && m.Format != "" {
+			return m.Format
+		}
+	}
+	return payload.FormatJSON
+}
+
+// WrapStructured applies the same middleware as Wrap without rendering the
+// output. Called with a context from payload.WithStructured, the handler
+// returns structured results for callers that embed them in their own output.
+func (m *Middleware) WrapStructured(handler server.ToolHandlerFunc, toolName string) server.ToolHandlerFunc {
+	if m == nil {
+		m = &Middleware{}
+	}
+	category := CategoryForTool(toolName)
+	meta, known := toolMetadataByName()[toolName]
+	if category != "delete" {
+		handler = resolve.WrapHandler(handler, m.Resolver)
+		handler = m.Humanizer.WrapHandler(handler, meta.Resource)
+	}
+	if known {
+		handler = resolve.WrapSelector(handler, m.Resolver, meta.Resource, selectorFields(meta))
+		switch category {
+		case "create", "update", "upsert":
+			handler = resolve.WrapInput(handler, m.Resolver, inputFields(meta))
+		}
+	}
+	switch category {
+	case "list", "get":
+		handler = expr.WrapHandler(handler)
+		if category == "list" {
+			handler = m.Results.WrapHandler(handler)
+			handler = budget.WrapHandler(handler, m.MaxTokens, m.format(category))
+		}
+		handler = stale.WrapHandler(handler)
+	case "create":
+		handler = m.Idempotency.WrapHandler(handler)
+	}
+	return annotate.WrapHandler(handler)
+}
+
+// toolMetadataByName indexes the generated tool metadata by tool name.
+var toolMetadataByName = sync.OnceValue(func() map[string]generated.ToolMetadata {
+	index := make(map[string]generated.ToolMetadata, len(generated.AllToolMetadata))
+	for _, meta := range generated.AllToolMetadata {
+		index[meta.Name] = meta
+	}
+	return index
+})
+
+// selectorFields returns the arguments that may select the target object in
+// place of its ID: "name" and "mac", where the tool's schema accepts them.
+func selectorFields(meta generated.ToolMetadata) []string {
+	if meta.IsSetting {
+		return nil
+	}
+	switch meta.Category {
+	case "get", "update", "delete":
+	default:
+		return nil
+	}
+	props, _ := meta.InputSchema["properties"].(map[string]any)
+	var selectors []string
+	for _, field := range []string{"name", "mac"} {
+		if _, ok := props[field]; ok {
+			selectors = append(selectors, field)
+		}
+	}
+	return selectors
+}
+
+// inputFields returns the set of parameters accepted by a tool, leaving out
+// the name arguments that stand for its ID fields.
+func inputFields(meta generated.ToolMetadata) map[string]bool {
+	props, _ := meta.InputSchema["properties"].(map[string]any)
+	fields := make(map[string]bool, len(props))
+	for field := range props {
+		if _, ok := meta.NameInputs[field]; !ok {
+			fields[field] = true
+		}
+	}
+	return fields
+}
+
+// CategoryForTool derives a tool's category from its name prefix (e.g., "list_network" -> "list").
+func CategoryForTool(toolName string) string {
+	category, _, _ := strings.Cut(toolName, "_")
+	return category
+}
+
 // buildToolFromMetadata creates an MCP tool from tool metadata.
 func buildToolFromMetadata(meta generated.ToolMetadata) (mcp.Tool, error) {
 	schemaBytes, err := json.Marshal(meta.InputSchema)
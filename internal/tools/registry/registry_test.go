@@ -1,10 +1,20 @@
 package registry
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/claytono/go-unifi-mcp/internal/humanize"
+	"github.com/claytono/go-unifi-mcp/internal/idempotency"
+	"github.com/claytono/go-unifi-mcp/internal/payload"
+	"github.com/claytono/go-unifi-mcp/internal/resolve"
+	"github.com/claytono/go-unifi-mcp/internal/results"
 	"github.com/claytono/go-unifi-mcp/internal/tools/generated"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -204,3 +214,261 @@ func TestBuildToolFromMetadata_PreservesSchema(t *testing.T) {
 	// Verify the schema is not nil (we can't easily unmarshal it back)
 	assert.NotNil(t, tool.InputSchema)
 }
+
+func TestCategoryForTool(t *testing.T) {
+	tests := map[string]string{
+		"list_network":     "list",
+		"get_setting_mgmt": "get",
+		"create_wlan":      "create",
+		"update_user":      "update",
+		"delete_tag":       "delete",
+		"unknown":          "unknown",
+	}
+	for toolName, expected := range tests {
+		assert.Equal(t, expected, CategoryForTool(toolName), toolName)
+	}
+}
+
+func TestMiddlewareWrap_NilMiddleware(t *testing.T) {
+	var mw *Middleware
+	handler := mw.Wrap(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(`{"_id":"pf1"}`), nil
+	}, "create_port_forward")
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "create_port_forward"
+	req.Params.Arguments = map[string]any{"idempotency_key": "k1"}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, `{"_id":"pf1"}`, result.Content[0].(mcp.TextContent).Text)
+}
+
+func TestMiddlewareWrap_IdempotencyOnlyForCreates(t *testing.T) {
+	mw := &Middleware{Idempotency: idempotency.NewStore(time.Hour)}
+	calls := 0
+	inner := func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		return mcp.NewToolResultText(`{"_id":"x"}`), nil
+	}
+
+	for _, toolName := range []string{"create_port_forward", "update_port_forward"} {
+		calls = 0
+		handler := mw.Wrap(inner, toolName)
+		req := mcp.CallToolRequest{}
+		req.Params.Name = toolName
+		req.Params.Arguments = map[string]any{"id": "x", "idempotency_key": "k1", "resolve": false}
+		for range 2 {
+			_, err := handler(context.Background(), req)
+			require.NoError(t, err)
+		}
+		if toolName == "create_port_forward" {
+			assert.Equal(t, 1, calls, toolName)
+		} else {
+			assert.Equal(t, 2, calls, toolName)
+		}
+	}
+}
+
+func TestSelectorFields(t *testing.T) {
+	meta := toolMetadataByName()
+	tests := map[string][]string{
+		"get_user":            {"name", "mac"},
+		"update_user":         {"name", "mac"},
+		"delete_network":      {"name"},
+		"get_device":          {"name", "mac"},
+		"get_dns_record":      nil,
+		"list_network":        nil,
+		"create_network":      nil,
+		"update_setting_mgmt": nil,
+	}
+	for toolName, expected := range tests {
+		m, ok := meta[toolName]
+		require.True(t, ok, toolName)
+		assert.Equal(t, expected, selectorFields(m), toolName)
+	}
+}
+
+type selectorTestClient struct{}
+
+func (selectorTestClient) ListNetwork(_ context.Context, _ string) ([]map[string]any, error) {
+	return []map[string]any{{"_id": "net1", "name": "LAN"}}, nil
+}
+
+func TestMiddlewareWrap_Selector(t *testing.T) {
+	mw := &Middleware{Resolver: resolve.New(selectorTestClient{}, map[string]string{"network": "Network"}, nil)}
+	var gotID any
+	handler := mw.Wrap(func(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		gotID = req.GetArguments()["id"]
+		return mcp.NewToolResultText(`{"success": true}`), nil
+	}, "delete_network")
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "delete_network"
+	req.Params.Arguments = map[string]any{"name": "LAN"}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, "net1", gotID)
+}
+
+func TestMiddlewareWrap_NameReferences(t *testing.T) {
+	mw := &Middleware{Resolver: resolve.New(selectorTestClient{}, map[string]string{"network": "Network"}, nil)}
+	var gotArgs map[string]any
+	handler := mw.Wrap(func(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		gotArgs = req.GetArguments()
+		return mcp.NewToolResultText(`{}`), nil
+	}, "create_wlan")
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "create_wlan"
+	req.Params.Arguments = map[string]any{"name": "Guest", "networkconf_name": "LAN", "resolve": false}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, map[string]any{"name": "Guest", "networkconf_id": "net1", "resolve": false}, gotArgs)
+}
+
+func TestNameInputs_MatchResolver(t *testing.T) {
+	resolver := resolve.New(nil, resolve.BuildResourceIndex(generated.AllToolMetadata), nil)
+	for _, meta := range generated.AllToolMetadata {
+		switch meta.Category {
+		case "create", "update", "upsert":
+		default:
+			assert.Empty(t, meta.NameInputs, meta.Name)
+			continue
+		}
+		props, _ := meta.InputSchema["properties"].(map[string]any)
+		fields := inputFields(meta)
+		for field := range fields {
+			if !strings.HasSuffix(field, "_id") && !strings.HasSuffix(field, "_ids") || field == "id" {
+				continue
+			}
+			if _, ok := resolver.ResourceForField(field); !ok {
+				continue
+			}
+			nameKey := strings.TrimSuffix(field, "_id") + "_name"
+			if strings.HasSuffix(field, "_ids") {
+				nameKey = strings.TrimSuffix(field, "_ids") + "_names"
+			}
+			if fields[nameKey] {
+				continue
+			}
+			assert.Equal(t, field, meta.NameInputs[nameKey], "%s: %s is not advertised", meta.Name, nameKey)
+			assert.Contains(t, props, nameKey, meta.Name)
+		}
+		for nameKey, field := range meta.NameInputs {
+			assert.Contains(t, props, nameKey, meta.Name)
+			assert.True(t, fields[field], "%s: %s is not a field", meta.Name, field)
+			assert.False(t, fields[nameKey], "%s: %s is treated as a field", meta.Name, nameKey)
+		}
+	}
+}
+
+func TestMiddlewareWrap_RendersStructuredOnce(t *testing.T) {
+	var mw *Middleware
+	inner := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		assert.True(t, payload.Structured(ctx))
+		v, err := payload.FromJSON([]byte(`[{"name":"a","_id":"1"}]`))
+		require.NoError(t, err)
+		return payload.NewResult(ctx, v), nil
+	}
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "list_network"
+
+	result, err := mw.Wrap(inner, "list_network")(context.Background(), req)
+	require.NoError(t, err)
+	assert.Nil(t, result.StructuredContent)
+	assert.Equal(t, "[\n  {\n    \"name\": \"a\",\n    \"_id\": \"1\"\n  }\n]", result.Content[0].(mcp.TextContent).Text)
+
+	result, err = mw.WrapStructured(inner, "list_network")(payload.WithStructured(context.Background()), req)
+	require.NoError(t, err)
+	v, ok := payload.FromResult(result)
+	require.True(t, ok)
+	assert.Len(t, v.Data, 1)
+}
+
+func TestMiddlewareWrap_ExprOnlyForReads(t *testing.T) {
+	var mw *Middleware
+	inner := func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(`[{"name":"LAN","vlan":1},{"name":"IoT","vlan":30}]`), nil
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "list_network"
+	req.Params.Arguments = map[string]any{"expr": "[.[] | select(.vlan > 1) | .name]", "resolve": false}
+	result, err := mw.Wrap(inner, "list_network")(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "[\n  \"IoT\"\n]", result.Content[0].(mcp.TextContent).Text)
+
+	req.Params.Name = "update_network"
+	result, err = mw.Wrap(inner, "update_network")(context.Background(), req)
+	require.NoError(t, err)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"name":"LAN"`)
+}
+
+func TestMiddlewareWrap_Format(t *testing.T) {
+	mw := &Middleware{Format: payload.FormatCSV}
+	inner := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		v, err := payload.FromJSON([]byte(`[{"name":"LAN","vlan":1}]`))
+		require.NoError(t, err)
+		return payload.NewResult(ctx, v), nil
+	}
+	call := func(toolName string, args map[string]any) string {
+		req := mcp.CallToolRequest{}
+		req.Params.Name = toolName
+		req.Params.Arguments = args
+		result, err := mw.Wrap(inner, toolName)(context.Background(), req)
+		require.NoError(t, err)
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	// The server default applies to reads, the format argument overrides it
+	assert.Equal(t, "name,vlan\nLAN,1\n", call("list_network", map[string]any{"resolve": false}))
+	assert.Equal(t, `[{"name":"LAN","vlan":1}]`, call("list_network", map[string]any{"resolve": false, "format": "json_compact"}))
+	assert.Contains(t, call("update_network", map[string]any{"id": "x", "resolve": false}), "[\n  {")
+}
+
+func TestMiddlewareWrap_StoreResult(t *testing.T) {
+	mw := &Middleware{Results: results.NewStore(4, 1<<20), MaxTokens: 1}
+	inner := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		v, err := payload.FromJSON([]byte(`[{"name":"LAN","vlan":1},{"name":"IoT","vlan":20}]`))
+		require.NoError(t, err)
+		return payload.NewResult(ctx, v), nil
+	}
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "list_network"
+	req.Params.Arguments = map[string]any{"resolve": false, "store_result": true, "format": "json_compact"}
+	result, err := mw.Wrap(inner, "list_network")(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	// The whole list is stored, ahead of the token budget
+	var out map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out))
+	assert.Contains(t, out, "result_id")
+	assert.Equal(t, float64(2), out["count"])
+}
+
+func TestMiddlewareWrap_Humanize(t *testing.T) {
+	mw := &Middleware{Humanizer: humanize.New(time.UTC, true)}
+	inner := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		v, err := payload.FromJSON([]byte(`[{"name":"ap","state":1,"uptime":60}]`))
+		require.NoError(t, err)
+		return payload.NewResult(ctx, v), nil
+	}
+	call := func(args map[string]any) string {
+		req := mcp.CallToolRequest{}
+		req.Params.Name = "list_device"
+		req.Params.Arguments = args
+		result, err := mw.Wrap(inner, "list_device")(context.Background(), req)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	// Siblings follow their source and are visible to expr
+	assert.Equal(t, `[{"name":"ap","state":1,"state_human":"connected","uptime":60,"uptime_human":"1m"}]`,
+		call(map[string]any{"format": "json_compact"}))
+	assert.Equal(t, `["connected"]`, call(map[string]any{"expr": "[.[] | .state_human]", "format": "json_compact"}))
+	assert.Equal(t, `[{"name":"ap","state":1,"uptime":60}]`, call(map[string]any{"humanize": false, "format": "json_compact"}))
+}